@@ -1,6 +1,8 @@
 package account
 
 import (
+	"sort"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -19,35 +21,131 @@ func Get(jaeger *v1.Jaeger) []*corev1.ServiceAccount {
 			accounts = append(accounts, OAuthProxy(jaeger))
 		}
 	}
-	return append(accounts, getMain(jaeger))
+	if jaeger.Spec.ServiceAccountPerComponent {
+		for _, c := range deployedComponents(jaeger) {
+			if len(configured(jaeger, c)) == 0 {
+				accounts = append(accounts, newServiceAccount(jaeger, JaegerServiceAccountFor(jaeger, c)))
+			}
+		}
+	} else if usesMain(jaeger) {
+		accounts = append(accounts, getMain(jaeger))
+	}
+	return accounts
+}
+
+// components lists the components that run with the service account returned by JaegerServiceAccountFor
+var components = []Component{
+	CollectorComponent,
+	QueryComponent,
+	IngesterComponent,
+	AllInOneComponent,
+	AgentComponent,
+	DependenciesComponent,
+	EsIndexCleanerComponent,
+	EsRolloverComponent,
+}
+
+// deployedComponents returns the components running with their own service account for the instance's strategy.
+// When the OAuth Proxy is used, the query runs with the OAuth Proxy's service account instead.
+func deployedComponents(jaeger *v1.Jaeger) []Component {
+	var deployed []Component
+	oauthProxy := jaeger.Spec.Ingress.Security == v1.IngressSecurityOAuthProxy
+	switch jaeger.Spec.Strategy {
+	case v1.DeploymentStrategyProduction, v1.DeploymentStrategyStreaming:
+		deployed = append(deployed, CollectorComponent)
+		if !oauthProxy {
+			deployed = append(deployed, QueryComponent)
+		}
+		if jaeger.Spec.Strategy == v1.DeploymentStrategyStreaming {
+			deployed = append(deployed, IngesterComponent)
+		}
+	default:
+		if !oauthProxy {
+			deployed = append(deployed, AllInOneComponent)
+		}
+	}
+	return append(deployed, AgentComponent, DependenciesComponent, EsIndexCleanerComponent, EsRolloverComponent)
+}
+
+// usesMain returns whether at least one of the components falls back to the service account managed by the operator
+func usesMain(jaeger *v1.Jaeger) bool {
+	for _, c := range components {
+		if JaegerServiceAccountFor(jaeger, c) == jaeger.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// Existing returns the names of the service accounts referenced by the components of this Jaeger instance that
+// are not managed by the operator, and are therefore expected to exist already
+func Existing(jaeger *v1.Jaeger) []string {
+	names := map[string]bool{}
+	for _, c := range components {
+		if sa := configured(jaeger, c); len(sa) > 0 && sa != jaeger.Name {
+			names[sa] = true
+		}
+	}
+
+	existing := []string{}
+	for name := range names {
+		existing = append(existing, name)
+	}
+	sort.Strings(existing)
+	return existing
 }
 
 func getMain(jaeger *v1.Jaeger) *corev1.ServiceAccount {
-	trueVar := true
+	return newServiceAccount(jaeger, jaeger.Name)
+}
+
+func newServiceAccount(jaeger *v1.Jaeger, name string) *corev1.ServiceAccount {
 	return &corev1.ServiceAccount{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "v1",
 			Kind:       "ServiceAccount",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      JaegerServiceAccountFor(jaeger, ""),
-			Namespace: jaeger.Namespace,
-			Labels:    util.Labels(JaegerServiceAccountFor(jaeger, ""), "service-account", *jaeger),
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: jaeger.APIVersion,
-					Kind:       jaeger.Kind,
-					Name:       jaeger.Name,
-					UID:        jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
+			Name:            name,
+			Namespace:       jaeger.Namespace,
+			Labels:          util.Labels(name, "service-account", *jaeger),
+			Annotations:     annotations(jaeger, nil),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 	}
 }
 
+// annotations returns the annotations for a service account created by the operator, combining the ones requested
+// via the Jaeger instance with the given ones, which take precedence
+func annotations(jaeger *v1.Jaeger, own map[string]string) map[string]string {
+	if len(jaeger.Spec.ServiceAccountAnnotations) == 0 && len(own) == 0 {
+		return nil
+	}
+
+	result := map[string]string{}
+	for k, v := range jaeger.Spec.ServiceAccountAnnotations {
+		result[k] = v
+	}
+	for k, v := range own {
+		result[k] = v
+	}
+	return result
+}
+
 // JaegerServiceAccountFor prints service name for Jaeger instance
 func JaegerServiceAccountFor(jaeger *v1.Jaeger, component Component) string {
+	if sa := configured(jaeger, component); len(sa) > 0 {
+		return sa
+	}
+
+	if jaeger.Spec.ServiceAccountPerComponent && len(component) > 0 {
+		return util.Truncate("%s-%s", 63, jaeger.Name, component)
+	}
+	return jaeger.Name
+}
+
+// configured returns the service account explicitly set for the given component, if any
+func configured(jaeger *v1.Jaeger, component Component) string {
 	sa := ""
 	switch component {
 	case CollectorComponent:
@@ -67,9 +165,5 @@ func JaegerServiceAccountFor(jaeger *v1.Jaeger, component Component) string {
 	case EsRolloverComponent:
 		sa = util.Merge([]v1.JaegerCommonSpec{jaeger.Spec.Storage.EsRollover.JaegerCommonSpec, jaeger.Spec.JaegerCommonSpec}).ServiceAccount
 	}
-
-	if sa == "" {
-		return jaeger.Name
-	}
 	return sa
 }
@@ -53,3 +53,86 @@ func TestJaegerName(t *testing.T) {
 	assert.Equal(t, "esic-sa", JaegerServiceAccountFor(jaeger, EsIndexCleanerComponent))
 	assert.Equal(t, "esro-sa", JaegerServiceAccountFor(jaeger, EsRolloverComponent))
 }
+
+func TestMainNotCreatedWhenAllComponentsUseExistingAccount(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestMainNotCreatedWhenAllComponentsUseExistingAccount"})
+	jaeger.Spec.ServiceAccount = "irsa-sa"
+
+	assert.Len(t, Get(jaeger), 0)
+	assert.Equal(t, []string{"irsa-sa"}, Existing(jaeger))
+}
+
+func TestMainCreatedWhenSomeComponentUsesIt(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestMainCreatedWhenSomeComponentUsesIt"})
+	jaeger.Spec.Collector.ServiceAccount = "col-sa"
+	jaeger.Spec.Query.ServiceAccount = "query-sa"
+
+	sas := Get(jaeger)
+	assert.Len(t, sas, 1)
+	assert.Equal(t, getMain(jaeger), sas[0])
+	assert.Equal(t, []string{"col-sa", "query-sa"}, Existing(jaeger))
+}
+
+func TestExistingNone(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestExistingNone"})
+	assert.Len(t, Existing(jaeger), 0)
+}
+
+func TestServiceAccountAnnotations(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestServiceAccountAnnotations"})
+	jaeger.Spec.Ingress.Security = v1.IngressSecurityOAuthProxy
+	jaeger.Spec.ServiceAccountAnnotations = map[string]string{
+		"eks.amazonaws.com/role-arn":                                   "arn:aws:iam::123456789012:role/jaeger",
+		"serviceaccounts.openshift.io/oauth-redirectreference.primary": "overridden",
+	}
+
+	sas := Get(jaeger)
+	assert.Len(t, sas, 2)
+	for _, sa := range sas {
+		assert.Equal(t, "arn:aws:iam::123456789012:role/jaeger", sa.Annotations["eks.amazonaws.com/role-arn"])
+	}
+
+	// the annotations managed by the operator take precedence
+	assert.NotEqual(t, "overridden", sas[0].Annotations["serviceaccounts.openshift.io/oauth-redirectreference.primary"])
+}
+
+func TestServiceAccountPerComponent(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+	jaeger.Spec.ServiceAccountPerComponent = true
+	jaeger.Spec.Agent.ServiceAccount = "agent-sa"
+
+	names := []string{}
+	for _, sa := range Get(jaeger) {
+		names = append(names, sa.Name)
+		assert.Equal(t, "service-account", sa.Labels["app.kubernetes.io/component"])
+	}
+
+	assert.Equal(t, []string{
+		"my-instance-collector",
+		"my-instance-query",
+		"my-instance-ingester",
+		"my-instance-dependencies",
+		"my-instance-es-index-cleaner",
+		"my-instance-es-rollover",
+	}, names)
+	assert.Equal(t, "my-instance-collector", JaegerServiceAccountFor(jaeger, CollectorComponent))
+	assert.Equal(t, "agent-sa", JaegerServiceAccountFor(jaeger, AgentComponent))
+	assert.Equal(t, []string{"agent-sa"}, Existing(jaeger))
+}
+
+func TestServiceAccountPerComponentWithOAuthProxy(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.ServiceAccountPerComponent = true
+	jaeger.Spec.Ingress.Security = v1.IngressSecurityOAuthProxy
+
+	names := []string{}
+	for _, sa := range Get(jaeger) {
+		names = append(names, sa.Name)
+	}
+
+	// the all-in-one runs with the OAuth Proxy's service account
+	assert.Contains(t, names, OAuthProxyAccountNameFor(jaeger))
+	assert.NotContains(t, names, "my-instance-all-in-one")
+	assert.NotContains(t, names, "my-instance")
+}
@@ -12,7 +12,6 @@ import (
 
 // OAuthProxy returns a service account representing a client in the context of the OAuth Proxy
 func OAuthProxy(jaeger *v1.Jaeger) *corev1.ServiceAccount {
-	trueVar := true
 	return &corev1.ServiceAccount{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "v1",
@@ -22,18 +21,10 @@ func OAuthProxy(jaeger *v1.Jaeger) *corev1.ServiceAccount {
 			Name:      OAuthProxyAccountNameFor(jaeger),
 			Namespace: jaeger.Namespace,
 			Labels:    util.Labels(OAuthProxyAccountNameFor(jaeger), "service-account-oauth-proxy", *jaeger),
-			Annotations: map[string]string{
+			Annotations: annotations(jaeger, map[string]string{
 				"serviceaccounts.openshift.io/oauth-redirectreference.primary": getOAuthRedirectReference(jaeger),
-			},
-			OwnerReferences: []metav1.OwnerReference{
-				metav1.OwnerReference{
-					APIVersion: jaeger.APIVersion,
-					Kind:       jaeger.Kind,
-					Name:       jaeger.Name,
-					UID:        jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
+			}),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 	}
 }
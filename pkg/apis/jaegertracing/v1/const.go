@@ -16,6 +16,27 @@ const (
 	// ConfigOperatorScope is the configuration key holding the scope of the operator
 	ConfigOperatorScope string = "operator-scope"
 
+	// ConfigFieldManager is the configuration key holding the field manager used when creating or updating objects
+	ConfigFieldManager string = "field-manager"
+
+	// ConfigConflictPolicy is the configuration key holding the policy to apply when an update conflicts with a newer version of the object
+	ConfigConflictPolicy string = "conflict-policy"
+
+	// ConfigAuditLog is the configuration key holding the boolean, determining whether the objects created, updated or deleted by the operator are recorded in an audit log
+	ConfigAuditLog string = "audit-log"
+
+	// ConfigCRDWaitTimeout is the configuration key holding how long the operator waits on startup for the Jaeger CRD to be established
+	ConfigCRDWaitTimeout string = "crd-wait-timeout"
+
+	// ConfigInstanceSelector is the configuration key holding the label selector restricting the Jaeger instances the operator reconciles
+	ConfigInstanceSelector string = "instance-selector"
+
+	// ConfigImageRegistry is the configuration key holding the registry prepended to the default images of the components
+	ConfigImageRegistry string = "image-registry"
+
+	// DefaultFieldManager is the field manager used by default when creating or updating objects
+	DefaultFieldManager string = "jaeger-operator"
+
 	// WatchAllNamespaces is the value that the ConfigWatchNamespace holds to represent "all namespaces".
 	WatchAllNamespaces string = ""
 
@@ -1,6 +1,9 @@
 package v1
 
 import (
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -56,6 +59,18 @@ const (
 	// +k8s:openapi-gen=true
 	FlagProvisionKafkaNo = "no"
 
+	// FlagConflictPolicyFail represents the 'fail' value for the 'conflict-policy' flag: conflicting updates fail the reconciliation (default)
+	// +k8s:openapi-gen=true
+	FlagConflictPolicyFail = "fail"
+
+	// FlagConflictPolicyForce represents the 'force' value for the 'conflict-policy' flag: conflicting updates are re-applied on top of the latest version
+	// +k8s:openapi-gen=true
+	FlagConflictPolicyForce = "force"
+
+	// FlagConflictPolicySkip represents the 'skip' value for the 'conflict-policy' flag: conflicting updates are skipped
+	// +k8s:openapi-gen=true
+	FlagConflictPolicySkip = "skip"
+
 	// IngressSecurityNone disables any form of security for ingress objects (default)
 	// +k8s:openapi-gen=true
 	IngressSecurityNone IngressSecurityType = ""
@@ -116,6 +131,11 @@ func ValidStorageTypes() []JaegerStorageType {
 	}
 }
 
+// ArchiveStorageSupported returns whether the given storage type can serve the archive storage
+func ArchiveStorageSupported(storageType JaegerStorageType) bool {
+	return storageType == JaegerESStorage || storageType == JaegerCassandraStorage
+}
+
 // OptionsPrefix returns the options prefix associated with the storage type
 func (storageType JaegerStorageType) OptionsPrefix() string {
 	if storageType == JaegerESStorage {
@@ -158,10 +178,56 @@ type JaegerSpec struct {
 	// +optional
 	Ingress JaegerIngressSpec `json:"ingress,omitempty"`
 
+	// +optional
+	Upgrade JaegerUpgradeSpec `json:"upgrade,omitempty"`
+
+	// +optional
+	NetworkPolicy JaegerNetworkPolicySpec `json:"networkPolicy,omitempty"`
+
+	// ServiceAccountAnnotations are added to the service accounts created by the operator for this instance, such as
+	// the "eks.amazonaws.com/role-arn" annotation used by IAM roles for service accounts
+	// +optional
+	ServiceAccountAnnotations map[string]string `json:"serviceAccountAnnotations,omitempty"`
+
+	// ServiceAccountPerComponent makes the operator create a dedicated service account for each component, instead
+	// of a single one shared by all of them. Components with an explicit service account keep using it.
+	// +optional
+	ServiceAccountPerComponent bool `json:"serviceAccountPerComponent,omitempty"`
+
 	// +optional
 	JaegerCommonSpec `json:",inline,omitempty"`
 }
 
+// JaegerUpgradeSpec defines the options to be used when upgrading the instance to a new Jaeger version
+// +k8s:openapi-gen=true
+type JaegerUpgradeSpec struct {
+	// PreUpgradeJob is a Job to run before upgrading the instance to a new version, such as a backup.
+	// The upgrade is blocked until this Job succeeds.
+	// +optional
+	PreUpgradeJob *batchv1.JobSpec `json:"preUpgradeJob,omitempty"`
+}
+
+// JaegerNetworkPolicySpec defines the options for the network policies generated for this instance
+// +k8s:openapi-gen=true
+type JaegerNetworkPolicySpec struct {
+	// Enabled makes the operator create network policies allowing the traffic required by the Jaeger components,
+	// such as the spans reported to the collector and the connections to a self-provisioned Elasticsearch cluster.
+	// The egress of the components is restricted to the DNS, the pods of the instance, the storage backends and,
+	// for the OAuth Proxy, the API server.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// QueryIngressCIDRs are the IP blocks, in addition to the pods in the same namespace, allowed to reach the query UI
+	// +optional
+	// +listType=atomic
+	QueryIngressCIDRs []string `json:"queryIngressCIDRs,omitempty"`
+
+	// QueryIngressNamespaceSelector selects the namespaces, such as the ingress controller's, whose pods are allowed
+	// to reach the query UI. On OpenShift, the router is always allowed.
+	// +optional
+	QueryIngressNamespaceSelector *metav1.LabelSelector `json:"queryIngressNamespaceSelector,omitempty"`
+}
+
 // JaegerStatus defines the observed state of Jaeger
 // +k8s:openapi-gen=true
 type JaegerStatus struct {
@@ -222,11 +288,112 @@ type JaegerCommonSpec struct {
 	// +listType=atomic
 	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
 
+	// NodeSelector restricts the nodes the component's pods are scheduled on
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
 	// +optional
 	SecurityContext *v1.PodSecurityContext `json:"securityContext,omitempty"`
 
+	// ServiceAccount is the name of an existing service account to be used by the component. The operator doesn't
+	// create nor manage service accounts referenced here.
 	// +optional
 	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// LogLevel is the log level for the component. Possible values: debug, info, warn, error. The cron jobs don't
+	// support it.
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// StartupProbe is the startup probe for the component's main container. Liveness and readiness probes
+	// are suspended until it succeeds.
+	// +optional
+	StartupProbe *v1.Probe `json:"startupProbe,omitempty"`
+
+	// Capabilities are the Linux capabilities to add to or drop from the component's main container.
+	// Defaults to dropping all capabilities.
+	// +optional
+	Capabilities *v1.Capabilities `json:"capabilities,omitempty"`
+
+	// AllowPrivilegeEscalation controls whether the component's main container can gain more privileges than its
+	// parent process. Defaults to false.
+	// +optional
+	AllowPrivilegeEscalation *bool `json:"allowPrivilegeEscalation,omitempty"`
+
+	// RuntimeClassName is the name of the RuntimeClass used to run the component's pods, such as a sandboxed runtime
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+
+	// Sidecars are extra containers added to the component's pod, like log shippers. Their names must not collide
+	// with the containers managed by the operator.
+	// +optional
+	// +listType=atomic
+	Sidecars []v1.Container `json:"sidecars,omitempty"`
+
+	// InternalTracing makes the component report the spans about its own operations to another Jaeger instance.
+	// Only the query and the all-in-one report such spans, and not when their tracingEnabled is false.
+	// Disabled by default.
+	// +optional
+	InternalTracing *JaegerInternalTracingSpec `json:"internalTracing,omitempty"`
+
+	// SidecarResources are the resources for the sidecars injected by the operator, like the agent and the OAuth
+	// proxy. When no resources are set for a sidecar, it gets small requests.
+	// +optional
+	SidecarResources *JaegerSidecarResourcesSpec `json:"sidecarResources,omitempty"`
+
+	// Env are extra environment variables for the component's main container, like HTTP_PROXY and NO_PROXY. They
+	// also reach the containers of the cron jobs. Variables set by the operator take precedence.
+	// +optional
+	// +listType=atomic
+	Env []v1.EnvVar `json:"env,omitempty"`
+
+	// TerminationMessagePolicy indicates how the termination message of the component's main container is populated.
+	// Defaults to FallbackToLogsOnError, so that the last log lines are kept when the container crashes.
+	// +optional
+	TerminationMessagePolicy v1.TerminationMessagePolicy `json:"terminationMessagePolicy,omitempty"`
+
+	// ImagePullPolicy is the pull policy for the image of the component's main container, including the containers
+	// of the cron jobs. When not set, the Kubernetes default applies.
+	// +optional
+	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+}
+
+// JaegerSidecarResourcesSpec defines the resources for the sidecars injected by the operator. The resources for the
+// sidecar's type take precedence, followed by the resources set on the agent and ingress specs, the default ones and
+// the top-level resources.
+// +k8s:openapi-gen=true
+type JaegerSidecarResourcesSpec struct {
+	// Default applies to all the sidecars injected by the operator
+	// +nullable
+	// +optional
+	Default v1.ResourceRequirements `json:"default,omitempty"`
+
+	// Agent applies to the injected agent sidecars, but not to the agent's DaemonSet
+	// +nullable
+	// +optional
+	Agent v1.ResourceRequirements `json:"agent,omitempty"`
+
+	// OAuthProxy applies to the OAuth proxy sidecar of the query
+	// +nullable
+	// +optional
+	OAuthProxy v1.ResourceRequirements `json:"oauthProxy,omitempty"`
+}
+
+// JaegerInternalTracingSpec defines where the Jaeger components report the spans about themselves
+// +k8s:openapi-gen=true
+type JaegerInternalTracingSpec struct {
+	// Endpoint is the HTTP endpoint of the collector receiving the spans, like
+	// http://jaeger-collector.observability:14268/api/traces. It must not be the collector of the same instance.
+	Endpoint string `json:"endpoint"`
+
+	// SamplerType is the type of the sampler: const, probabilistic, ratelimiting or remote. Defaults to probabilistic.
+	// +optional
+	SamplerType string `json:"samplerType,omitempty"`
+
+	// SamplerParam is the parameter of the sampler, like the sampling rate for the probabilistic sampler.
+	// Defaults to 0.001 for the probabilistic sampler.
+	// +optional
+	SamplerParam string `json:"samplerParam,omitempty"`
 }
 
 // JaegerQuerySpec defines the options to be used when deploying the query
@@ -257,6 +424,82 @@ type JaegerQuerySpec struct {
 	// agent container from the query component to disable tracing requests to the query service.
 	// The default, if ommited, is true
 	TracingEnabled *bool `json:"tracingEnabled,omitempty"`
+
+	// MaxClockSkewAdjustment is the maximum delta by which the query adjusts the timestamps of spans affected by
+	// clock skew, as a duration like "500ms". Setting it to "0s" disables the adjustment. Rendered as the
+	// --query.max-clock-skew-adjustment flag, unless the options have an explicit value.
+	// +optional
+	MaxClockSkewAdjustment string `json:"maxClockSkewAdjustment,omitempty"`
+
+	// StorageTimeout is the timeout of the query's requests to the storage, as a duration like "30s". Rendered as
+	// the --es.timeout or --cassandra.timeout flag, unless the options have an explicit value. Only valid with the
+	// Elasticsearch and Cassandra storages.
+	// +optional
+	StorageTimeout string `json:"storageTimeout,omitempty"`
+
+	// MaxSpanAge is how far back in time the query looks for spans in Elasticsearch, as a duration like "168h".
+	// Rendered as the --es.max-span-age flag, unless the options have an explicit value.
+	// +optional
+	MaxSpanAge string `json:"maxSpanAge,omitempty"`
+
+	// Zones pins the query pods to the nodes in the given availability zones, as per their
+	// topology.kubernetes.io/zone label. It's only applied when no affinity has been set for the query.
+	// +optional
+	// +listType=atomic
+	Zones []string `json:"zones,omitempty"`
+
+	// Storage overrides parts of the storage configuration for the query only, for instance to keep reading from the
+	// previous Elasticsearch cluster while the collector already writes to a new one. It doesn't apply to the
+	// all-in-one strategy.
+	// +optional
+	Storage *JaegerQueryStorageSpec `json:"storage,omitempty"`
+
+	// SessionAffinity makes the query service send all the requests of a client to the same pod when set to
+	// "ClientIP". Defaults to "None".
+	// +optional
+	SessionAffinity v1.ServiceAffinity `json:"sessionAffinity,omitempty"`
+
+	// SessionAffinityTimeoutSeconds is how long a client sticks to the same pod. Only applicable when the session
+	// affinity is "ClientIP". Defaults to 10800, as per Kubernetes.
+	// +optional
+	SessionAffinityTimeoutSeconds *int32 `json:"sessionAffinityTimeoutSeconds,omitempty"`
+}
+
+// JaegerQueryStorageSpec defines the storage configuration overrides for the query
+// +k8s:openapi-gen=true
+type JaegerQueryStorageSpec struct {
+	// Type is the storage type the query reads from. When set, it has to match the type of the main storage.
+	// +optional
+	Type JaegerStorageType `json:"type,omitempty"`
+
+	// SecretName replaces the storage secret for the query
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// Options are applied on top of the storage options for the query, replacing the entries with the same key,
+	// such as "es.server-urls"
+	// +optional
+	Options Options `json:"options,omitempty"`
+
+	// TLS sets the TLS material the query uses to connect to the Elasticsearch or Cassandra storage, distinct from
+	// the one used by the collector. It replaces the TLS settings from the storage options: only the query options
+	// and the options above take precedence over it
+	// +optional
+	TLS *JaegerQueryStorageTLSSpec `json:"tls,omitempty"`
+}
+
+// JaegerQueryStorageTLSSpec defines the TLS material the query uses to connect to the storage
+// +k8s:openapi-gen=true
+type JaegerQueryStorageTLSSpec struct {
+	// CASecretName is the name of a secret in the instance's namespace holding, under the ca.crt entry, the CA used
+	// to verify the storage's certificate
+	// +optional
+	CASecretName string `json:"caSecretName,omitempty"`
+
+	// SecretName is the name of a secret in the instance's namespace holding the client certificate and key the
+	// query presents to the storage, under the tls.crt and tls.key entries
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
 }
 
 // JaegerUISpec defines the options to be used to configure the UI
@@ -264,6 +507,12 @@ type JaegerQuerySpec struct {
 type JaegerUISpec struct {
 	// +optional
 	Options FreeForm `json:"options,omitempty"`
+
+	// DependenciesMenuEnabled shows or hides the dependencies (system architecture) menu. It's set as
+	// "dependencies.menuEnabled" in the UI configuration, taking precedence over the options. By default, the menu is
+	// hidden when the dependencies job isn't enabled.
+	// +optional
+	DependenciesMenuEnabled *bool `json:"dependenciesMenuEnabled,omitempty"`
 }
 
 // JaegerSamplingSpec defines the options to be used to configure the UI
@@ -271,6 +520,21 @@ type JaegerUISpec struct {
 type JaegerSamplingSpec struct {
 	// +optional
 	Options FreeForm `json:"options,omitempty"`
+
+	// SecretName is the name of a secret holding the sampling strategies, in the JSON format. When set, it takes
+	// precedence over the options.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// SecretKey is the key within the secret holding the sampling strategies. Defaults to "sampling".
+	// +optional
+	SecretKey string `json:"secretKey,omitempty"`
+
+	// ReloadInterval is how often the collector reloads the sampling strategies file, like "30s", so that changes
+	// to the strategies are picked up without a restart. Rendered as the --sampling.strategies-reload-interval flag.
+	// Reloading is disabled by default.
+	// +optional
+	ReloadInterval string `json:"reloadInterval,omitempty"`
 }
 
 // JaegerIngressSpec defines the options to be used when deploying the query ingress
@@ -302,6 +566,49 @@ type JaegerIngressSpec struct {
 
 	// +optional
 	Options Options `json:"options,omitempty"`
+
+	// Collector exposes the all-in-one's collector, in addition to the query
+	// +optional
+	Collector JaegerIngressCollectorSpec `json:"collector,omitempty"`
+
+	// Zipkin exposes the collector's Zipkin endpoint on its own hosts
+	// +optional
+	Zipkin JaegerIngressZipkinSpec `json:"zipkin,omitempty"`
+}
+
+// JaegerIngressZipkinSpec defines the options for exposing the Zipkin endpoint of the collector
+// +k8s:openapi-gen=true
+type JaegerIngressZipkinSpec struct {
+	// Enabled determines whether the Zipkin endpoint is exposed. Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Hosts are the hosts routed to the Zipkin endpoint, which have to differ from the query hosts
+	// +optional
+	// +listType=atomic
+	Hosts []string `json:"hosts,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	TLS []JaegerIngressTLSSpec `json:"tls,omitempty"`
+}
+
+// JaegerIngressCollectorSpec defines the options for exposing the collector endpoint of an all-in-one instance
+// +k8s:openapi-gen=true
+type JaegerIngressCollectorSpec struct {
+	// Enabled determines whether the collector is exposed. Defaults to false, exposing only the query.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Port is the collector's service port to expose. Defaults to 14268, the HTTP endpoint for Thrift spans.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Hosts are the hosts for the collector rules, which have to differ from the query hosts. Without hosts, only
+	// the /api/traces path is routed to the collector, which requires the query to have hosts or a base path
+	// +optional
+	// +listType=atomic
+	Hosts []string `json:"hosts,omitempty"`
 }
 
 // JaegerIngressTLSSpec defines the TLS configuration to be used when deploying the query ingress
@@ -331,6 +638,12 @@ type JaegerIngressOpenShiftSpec struct {
 	// SkipLogout tells the operator to not automatically add a "Log Out" menu option to the custom Jaeger configuration
 	// +optional
 	SkipLogout *bool `json:"skipLogout,omitempty"`
+
+	// SkipRoute tells the operator to not create the routes for this instance, so that they can be managed
+	// by something else. The services are still created. When using the OAuth Proxy, the query route should
+	// be named after the instance, as that's the route the OAuth redirect reference points to.
+	// +optional
+	SkipRoute *bool `json:"skipRoute,omitempty"`
 }
 
 // JaegerAllInOneSpec defines the options to be used when deploying the query
@@ -353,6 +666,16 @@ type JaegerAllInOneSpec struct {
 	// agent container from the query component to disable tracing requests to the query service.
 	// The default, if ommited, is true
 	TracingEnabled *bool `json:"tracingEnabled,omitempty"`
+
+	// OTLPEnabled exposes the OTLP receivers on the ports 4317 (gRPC) and 4318 (HTTP), configuring them in the
+	// OpenTelemetry config. It requires the Config to be set, as the flag-based all-in-one has no OTLP receiver.
+	// +optional
+	OTLPEnabled bool `json:"otlpEnabled,omitempty"`
+
+	// ConfigFile references the config map entry holding a configuration file for the all-in-one, which is mounted
+	// and passed via the --config-file flag. An explicit option takes precedence.
+	// +optional
+	ConfigFile *v1.ConfigMapKeySelector `json:"configFile,omitempty"`
 }
 
 // AutoScaleSpec defines the common elements used for create HPAs
@@ -368,6 +691,13 @@ type AutoScaleSpec struct {
 	// MaxReplicas sets an upper bound to the autoscaling feature. When autoscaling is enabled and no value is provided, a default value is used.
 	// +optional
 	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// Metrics are the metrics the autoscaler uses to calculate the desired number of replicas, such as a custom
+	// Kafka lag metric alongside the CPU utilization. The highest number of replicas among all the metrics is used.
+	// When not provided, the autoscaler targets 90% of the CPU and memory utilization.
+	// +optional
+	// +listType=atomic
+	Metrics []autoscalingv2beta2.MetricSpec `json:"metrics,omitempty"`
 }
 
 // JaegerCollectorSpec defines the options to be used when deploying the collector
@@ -399,6 +729,140 @@ type JaegerCollectorSpec struct {
 	// The default, if omitted, is ClusterIP.
 	// See https://kubernetes.io/docs/concepts/services-networking/service/#publishing-services-service-types
 	ServiceType v1.ServiceType `json:"serviceType,omitempty"`
+
+	// Tags holds the tags to be added to all spans processed by the collector, rendered as the
+	// collector tags flag. Keys and values must not contain commas nor equals signs.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// KafkaTopic is the Kafka topic the collector writes spans to when using the streaming strategy.
+	// It has to match the topic the ingester consumes from.
+	// +optional
+	KafkaTopic string `json:"kafkaTopic,omitempty"`
+
+	// KafkaBrokersFrom references a config map or secret key holding the comma-separated list of Kafka brokers the
+	// collector writes spans to when using the streaming strategy. It can't be combined with the
+	// "kafka.producer.brokers" option.
+	// +optional
+	KafkaBrokersFrom *JaegerKafkaBrokersSource `json:"kafkaBrokersFrom,omitempty"`
+
+	// OTLPMaxConcurrentStreams limits the number of concurrent streams each client connection may open against
+	// the OTLP gRPC receiver. Only applied when the collector's OpenTelemetry config declares the OTLP gRPC protocol.
+	// +optional
+	OTLPMaxConcurrentStreams *uint32 `json:"otlpMaxConcurrentStreams,omitempty"`
+
+	// OTLPKeepalive configures the keepalive parameters of the OTLP gRPC receiver. Only applied when the
+	// collector's OpenTelemetry config declares the OTLP gRPC protocol.
+	// +optional
+	OTLPKeepalive JaegerCollectorOTLPKeepaliveSpec `json:"otlpKeepalive,omitempty"`
+
+	// OTLPCORS configures the CORS settings of the OTLP HTTP receiver, allowing browsers to send spans directly to the
+	// collector. Only applied when the collector's OpenTelemetry config has an OTLP HTTP receiver.
+	// +optional
+	OTLPCORS JaegerCollectorOTLPCORSSpec `json:"otlpCors,omitempty"`
+
+	// QueueSize is the number of spans the collector queues before dropping new ones. Rendered as the
+	// --collector.queue-size flag. Can't be used together with QueueSizeMemory.
+	// +optional
+	QueueSize *int32 `json:"queueSize,omitempty"`
+
+	// QueueSizeMemory is the memory in MiB the collector's queue may use, resizing the queue based on the observed
+	// span sizes. Spans are dropped once it's full. Rendered as the --collector.queue-size-memory flag. It should stay
+	// below the collector's memory limit. Can't be used together with QueueSize.
+	// +optional
+	QueueSizeMemory *int32 `json:"queueSizeMemory,omitempty"`
+
+	// ServiceRateLimits caps the number of traces per second each of the given services may send, keyed by service name.
+	// They are served by the collector as "ratelimiting" service strategies in the sampling configuration, unless
+	// the sampling options have an explicit strategy for the service.
+	// +optional
+	ServiceRateLimits map[string]int32 `json:"serviceRateLimits,omitempty"`
+
+	// DefaultAntiAffinity makes the scheduler prefer spreading the collector replicas across nodes. It's only
+	// applied when no affinity has been set for the collector.
+	// +optional
+	DefaultAntiAffinity bool `json:"defaultAntiAffinity,omitempty"`
+
+	// Canary runs a few collector replicas with a different image next to the stable ones, behind the same services.
+	// Only applied to the production and streaming strategies.
+	// +optional
+	Canary *JaegerCollectorCanarySpec `json:"canary,omitempty"`
+
+	// MetricsBackend is the backend the collector exposes its metrics with, rendered as the --metrics-backend flag.
+	// Possible values: prometheus, expvar and none. An explicit option takes precedence.
+	// +optional
+	MetricsBackend string `json:"metricsBackend,omitempty"`
+
+	// MetricsPort is the admin port serving the metrics and the health check, rendered as the --admin.http.host-port
+	// flag and exposed by the collector services. It must not collide with the other ports of the collector.
+	// An explicit option takes precedence.
+	// +optional
+	MetricsPort *int32 `json:"metricsPort,omitempty"`
+
+	// ThriftHTTPPort is the port of the HTTP endpoint accepting spans in the Thrift format, rendered as the
+	// --collector.http-server.host-port flag and exposed by the collector services. The spans are accepted on the
+	// /api/traces path, which can't be changed. An explicit option takes precedence. It only applies to the
+	// production and streaming strategies: the all-in-one keeps listening on the default port.
+	// +optional
+	ThriftHTTPPort *int32 `json:"thriftHttpPort,omitempty"`
+
+	// ConfigFile references the config map entry holding a configuration file for the collector, which is mounted
+	// and passed via the --config-file flag. An explicit option takes precedence.
+	// +optional
+	ConfigFile *v1.ConfigMapKeySelector `json:"configFile,omitempty"`
+}
+
+// JaegerCollectorCanarySpec defines the canary deployment of the collector
+// +k8s:openapi-gen=true
+type JaegerCollectorCanarySpec struct {
+	// Image is the collector image to validate on the canary replicas
+	Image string `json:"image"`
+
+	// Replicas is the number of canary replicas. Defaults to 1.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// JaegerCollectorOTLPCORSSpec defines the CORS settings of the collector's OTLP HTTP receiver. Values set explicitly
+// in the OpenTelemetry config take precedence.
+// +k8s:openapi-gen=true
+type JaegerCollectorOTLPCORSSpec struct {
+	// AllowedOrigins are the origins allowed to send cross-origin requests, like "https://app.example.com".
+	// An origin may contain a wildcard, like "https://*.example.com", or be "*" to allow any origin.
+	// +optional
+	// +listType=atomic
+	AllowedOrigins []string `json:"allowedOrigins,omitempty"`
+
+	// AllowedHeaders are the headers allowed in cross-origin requests, in addition to the simple request headers
+	// +optional
+	// +listType=atomic
+	AllowedHeaders []string `json:"allowedHeaders,omitempty"`
+}
+
+// JaegerCollectorOTLPKeepaliveSpec defines the keepalive server parameters of the collector's OTLP gRPC receiver.
+// All the values are durations, like "30s". Values set explicitly in the OpenTelemetry config take precedence.
+// +k8s:openapi-gen=true
+type JaegerCollectorOTLPKeepaliveSpec struct {
+	// MaxConnectionIdle is the duration after which an idle connection is closed
+	// +optional
+	MaxConnectionIdle string `json:"maxConnectionIdle,omitempty"`
+
+	// MaxConnectionAge is the maximum duration a connection may exist before it's closed, which makes long-lived
+	// clients reconnect and get balanced across the collector replicas
+	// +optional
+	MaxConnectionAge string `json:"maxConnectionAge,omitempty"`
+
+	// MaxConnectionAgeGrace is the additional duration given to pending RPCs after MaxConnectionAge
+	// +optional
+	MaxConnectionAgeGrace string `json:"maxConnectionAgeGrace,omitempty"`
+
+	// Time is the duration of inactivity after which the server pings the client
+	// +optional
+	Time string `json:"time,omitempty"`
+
+	// Timeout is the duration the server waits for a ping ack before closing the connection
+	// +optional
+	Timeout string `json:"timeout,omitempty"`
 }
 
 // JaegerIngesterSpec defines the options to be used when deploying the ingester
@@ -422,6 +886,63 @@ type JaegerIngesterSpec struct {
 
 	// +optional
 	Config FreeForm `json:"config,omitempty"`
+
+	// KafkaTopic is the Kafka topic the ingester consumes spans from. It has to match the topic the collector writes to.
+	// +optional
+	KafkaTopic string `json:"kafkaTopic,omitempty"`
+
+	// KafkaBrokersFrom references a config map or secret key holding the comma-separated list of Kafka brokers the
+	// ingester consumes spans from. It can't be combined with the "kafka.consumer.brokers" option.
+	// +optional
+	KafkaBrokersFrom *JaegerKafkaBrokersSource `json:"kafkaBrokersFrom,omitempty"`
+
+	// ConsumerGroup is the Kafka consumer group the ingester belongs to. Instances sharing a Kafka cluster should use distinct groups.
+	// +optional
+	ConsumerGroup string `json:"consumerGroup,omitempty"`
+
+	// ScaleDown controls how the autoscaler scales the ingester down. Defaults to removing at most one pod every
+	// five minutes, after a ten minute stabilization window, to avoid repeated Kafka consumer group rebalances.
+	// Only applied when the cluster supports the autoscaler's behavior field.
+	// +optional
+	ScaleDown *autoscalingv2beta2.HPAScalingRules `json:"scaleDown,omitempty"`
+
+	// DeadlockInterval is the interval after which the ingester is restarted when no messages are consumed
+	// from a partition, e.g. 5m. Defaults to the ingester's default, which disables the deadlock detector.
+	// +optional
+	DeadlockInterval string `json:"deadlockInterval,omitempty"`
+
+	// Parallelism is the number of messages the ingester processes in parallel.
+	// +optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
+
+	// DrainDelay is how long a terminating ingester pod keeps running before it's asked to stop, as a duration like
+	// "20s". This gives the Kafka consumer group time to rebalance while the consumed spans are written to the
+	// storage. It's rendered as a preStop hook. Not set by default.
+	// +optional
+	DrainDelay string `json:"drainDelay,omitempty"`
+
+	// TerminationGracePeriodSeconds is the time a terminating ingester pod gets, including the drain delay, before
+	// it's killed. When a drain delay is set, it defaults to the drain delay plus the Kubernetes default of 30 seconds.
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+
+	// KafkaPartitions is the number of partitions of the topic the ingester consumes from. Replicas beyond that
+	// number stay idle, so the autoscaler's maximum number of replicas defaults to it, and a warning is logged when
+	// the number of replicas exceeds it.
+	// +optional
+	KafkaPartitions *int32 `json:"kafkaPartitions,omitempty"`
+}
+
+// JaegerKafkaBrokersSource references the key holding the Kafka brokers. Exactly one of the references has to be set.
+// +k8s:openapi-gen=true
+type JaegerKafkaBrokersSource struct {
+	// ConfigMapKeyRef selects a key of a config map in the instance's namespace
+	// +optional
+	ConfigMapKeyRef *v1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef selects a key of a secret in the instance's namespace
+	// +optional
+	SecretKeyRef *v1.SecretKeySelector `json:"secretKeyRef,omitempty"`
 }
 
 // JaegerAgentSpec defines the options to be used when deploying the agent
@@ -452,6 +973,28 @@ type JaegerAgentSpec struct {
 
 	// +optional
 	HostNetwork *bool `json:"hostNetwork,omitempty"`
+
+	// ProcessorQueueSize is the length of the queue for the UDP server of each of the agent's processors. An
+	// explicit "processor.<name>.server-queue-size" option takes precedence for the given processor.
+	// +optional
+	ProcessorQueueSize *int32 `json:"processorQueueSize,omitempty"`
+
+	// ProcessorWorkers is the number of workers pulling items from the queue of each of the agent's processors. An
+	// explicit "processor.<name>.workers" option takes precedence for the given processor.
+	// +optional
+	ProcessorWorkers *int32 `json:"processorWorkers,omitempty"`
+
+	// AgentTags holds the process tags the agent adds to all the spans it reports. Values may reference environment
+	// variables of the agent container, like "${POD_NAME:}". For the sidecars, they are added to the default tags
+	// set by the operator. Keys and values must not contain commas nor equals signs. An explicit tags option takes
+	// precedence.
+	// +optional
+	AgentTags map[string]string `json:"agentTags,omitempty"`
+
+	// UpdateStrategy is the strategy replacing the agent pods when the DaemonSet changes, like a RollingUpdate with
+	// a maximum number of unavailable pods, or OnDelete. Defaults to a RollingUpdate. Only applied to the DaemonSet.
+	// +optional
+	UpdateStrategy *appsv1.DaemonSetUpdateStrategy `json:"updateStrategy,omitempty"`
 }
 
 // JaegerStorageSpec defines the common storage options to be used for the query and collector
@@ -466,6 +1009,39 @@ type JaegerStorageSpec struct {
 	// +optional
 	Options Options `json:"options,omitempty"`
 
+	// +optional
+	Cassandra JaegerCassandraSpec `json:"cassandra,omitempty"`
+
+	// CreateIndexTemplates controls whether the Jaeger components create the Elasticsearch index templates on
+	// startup. Set it to false when the templates are managed externally. When set, the value takes precedence over
+	// the "es.create-index-templates" storage option.
+	// +optional
+	CreateIndexTemplates *bool `json:"createIndexTemplates,omitempty"`
+
+	// EsNumShards is the number of primary shards of the Elasticsearch indices created by Jaeger, used by the index
+	// templates and by the rollover initialization. When set, the value takes precedence over the "es.num-shards"
+	// storage option.
+	// +optional
+	EsNumShards *int32 `json:"esNumShards,omitempty"`
+
+	// EsNumReplicas is the number of replica shards of the Elasticsearch indices created by Jaeger. When set, the
+	// value takes precedence over the "es.num-replicas" storage option.
+	// +optional
+	EsNumReplicas *int32 `json:"esNumReplicas,omitempty"`
+
+	// EsBulk tunes how the collector and the ingester write the spans to Elasticsearch. Only valid with the
+	// Elasticsearch storage.
+	// +optional
+	EsBulk *JaegerEsBulkSpec `json:"esBulk,omitempty"`
+
+	// ArchiveStorage enables the archive storage, used by the query to archive traces and to read the archived ones.
+	// Only valid with the Elasticsearch and Cassandra storages.
+	// +optional
+	ArchiveStorage *JaegerArchiveStorageSpec `json:"archiveStorage,omitempty"`
+
+	// +optional
+	AWSWebIdentity JaegerAWSWebIdentitySpec `json:"awsWebIdentity,omitempty"`
+
 	// +optional
 	CassandraCreateSchema JaegerCassandraCreateSchemaSpec `json:"cassandraCreateSchema,omitempty"`
 
@@ -491,6 +1067,11 @@ type ElasticsearchSpec struct {
 	// +optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
 
+	// ProxyResources are the resources of the proxy running next to each Elasticsearch node. Defaults to 64Mi of
+	// memory and a request of 100m CPU.
+	// +optional
+	ProxyResources *v1.ResourceRequirements `json:"proxyResources,omitempty"`
+
 	// +optional
 	NodeCount int32 `json:"nodeCount,omitempty"`
 
@@ -500,12 +1081,131 @@ type ElasticsearchSpec struct {
 	// +optional
 	Storage esv1.ElasticsearchStorageSpec `json:"storage,omitempty"`
 
+	// RedundancyPolicy is the replication policy of the indices, passed to the Elasticsearch CR. Possible values:
+	// FullRedundancy, MultipleRedundancy, SingleRedundancy and ZeroRedundancy. Defaults to ZeroRedundancy for a single
+	// node and SingleRedundancy otherwise.
 	// +optional
 	RedundancyPolicy esv1.RedundancyPolicyType `json:"redundancyPolicy,omitempty"`
 
 	// +optional
 	// +listType=atomic
 	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// MasterNodes configures a dedicated group of master nodes. When set, the NodeCount nodes hold only the data
+	// and client roles. When omitted, the nodes share all the roles.
+	// +optional
+	MasterNodes *ElasticsearchMasterNodesSpec `json:"masterNodes,omitempty"`
+
+	// Existing references an Elasticsearch CR that is managed outside of this Jaeger instance, such as the one from
+	// OpenShift cluster logging. When set, the operator doesn't create its own Elasticsearch CR and points the
+	// Jaeger components to the referenced cluster instead. The client certificates are signed by the CA from the
+	// <instance>-master-certs secret, which can be pre-created with the CA of the referenced cluster.
+	// +optional
+	Existing *ElasticsearchReference `json:"existing,omitempty"`
+}
+
+// ElasticsearchReference identifies an Elasticsearch CR that isn't managed by the operator
+// +k8s:openapi-gen=true
+type ElasticsearchReference struct {
+	// Name of the Elasticsearch CR
+	Name string `json:"name"`
+
+	// Namespace of the Elasticsearch CR. Defaults to the namespace of the Jaeger instance.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ElasticsearchMasterNodesSpec represents the dedicated master nodes of a self-provisioned ES cluster
+// +k8s:openapi-gen=true
+type ElasticsearchMasterNodesSpec struct {
+	// NodeCount is the number of master nodes. Defaults to 3.
+	// +optional
+	NodeCount int32 `json:"nodeCount,omitempty"`
+
+	// Resources for the master nodes. Defaults to the resources of the cluster.
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// NodeSelector for the master nodes. Defaults to the node selector of the cluster.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Storage for the master nodes. Defaults to the storage of the cluster.
+	// +optional
+	Storage *esv1.ElasticsearchStorageSpec `json:"storage,omitempty"`
+}
+
+// JaegerAWSWebIdentitySpec defines the IAM role the storage-related components assume to access AWS services, such
+// as Amazon OpenSearch, via IAM roles for service accounts
+// +k8s:openapi-gen=true
+type JaegerAWSWebIdentitySpec struct {
+	// RoleARN is the ARN of the IAM role to assume. When set, a service account token is projected into the pods
+	// and the AWS_ROLE_ARN and AWS_WEB_IDENTITY_TOKEN_FILE environment variables are set for the AWS SDK.
+	// +optional
+	RoleARN string `json:"roleArn,omitempty"`
+
+	// Audience is the intended audience of the projected token. Defaults to "sts.amazonaws.com".
+	// +optional
+	Audience string `json:"audience,omitempty"`
+}
+
+// JaegerCassandraSpec defines the Cassandra cluster to connect to and how the spans are written to it. When set, the
+// values take precedence over the matching "cassandra.*" storage options. Only valid with the cassandra storage.
+// +k8s:openapi-gen=true
+type JaegerCassandraSpec struct {
+	// Servers is the list of contact points of the Cassandra cluster
+	// +optional
+	// +listType=atomic
+	Servers []string `json:"servers,omitempty"`
+
+	// Port is the port the Cassandra contact points listen on
+	// +optional
+	Port *int `json:"port,omitempty"`
+
+	// ConnectionsPerHost is the number of Cassandra connections each Jaeger component opens to every host
+	// +optional
+	ConnectionsPerHost *int32 `json:"connectionsPerHost,omitempty"`
+
+	// MaxRetryAttempts is the number of times a failed query is retried. Set it to 0 to disable the retries.
+	// +optional
+	MaxRetryAttempts *int32 `json:"maxRetryAttempts,omitempty"`
+}
+
+// JaegerArchiveStorageSpec defines the archive storage. When set, the options take precedence over the matching
+// "<storage>-archive.*" storage options.
+// +k8s:openapi-gen=true
+type JaegerArchiveStorageSpec struct {
+	// Type is the storage type of the archive. The archive is served by the same storage plugin as the spans, so it
+	// has to match the storage type. Defaults to the storage type.
+	// +optional
+	Type JaegerStorageType `json:"type,omitempty"`
+
+	// Options are the archive storage options without the "<storage>-archive." prefix, like "server-urls" or
+	// "index-prefix" for Elasticsearch and "servers" or "keyspace" for Cassandra.
+	// +optional
+	Options Options `json:"options,omitempty"`
+}
+
+// JaegerEsBulkSpec defines how the spans are written to Elasticsearch in bulk. When set, the values take precedence
+// over the matching "es.bulk.*" storage options.
+// +k8s:openapi-gen=true
+type JaegerEsBulkSpec struct {
+	// Size is the number of bytes the bulk requests can take up before they're committed
+	// +optional
+	Size *int32 `json:"size,omitempty"`
+
+	// Workers is the number of workers committing the bulk requests to Elasticsearch
+	// +optional
+	Workers *int32 `json:"workers,omitempty"`
+
+	// Actions is the number of requests that can be enqueued before they're committed
+	// +optional
+	Actions *int32 `json:"actions,omitempty"`
+
+	// FlushInterval is the interval after which the pending requests are committed regardless of the other
+	// thresholds, as a duration like "200ms". Set it to "0s" to disable it.
+	// +optional
+	FlushInterval string `json:"flushInterval,omitempty"`
 }
 
 // JaegerCassandraCreateSchemaSpec holds the options related to the create-schema batch job
@@ -532,10 +1232,15 @@ type JaegerCassandraCreateSchemaSpec struct {
 	// +optional
 	Mode string `json:"mode,omitempty"`
 
-	// TraceTTL sets the TTL for your trace data
+	// TraceTTL sets the TTL for your trace data, either as a duration (e.g. 168h) or as a number of seconds
 	// +optional
 	TraceTTL string `json:"traceTTL,omitempty"`
 
+	// DependenciesTTL sets the TTL for the dependencies data, either as a duration (e.g. 168h) or as a number of seconds.
+	// Defaults to no TTL.
+	// +optional
+	DependenciesTTL string `json:"dependenciesTTL,omitempty"`
+
 	// Timeout controls the Job deadline, it defaults to 1 day.
 	// specify it with a value which can be parsed by time.ParseDuration, e.g. 24h or 120m.
 	// If the job does not succeed within that duration it transitions into a permanent error state.
@@ -569,6 +1274,21 @@ type JaegerDependenciesSpec struct {
 	// +optional
 	JavaOpts string `json:"javaOpts,omitempty"`
 
+	// DriverMemory is the heap size of the job's JVM, which hosts the Spark driver, such as "2g". It's rendered as
+	// -Xmx in the JAVA_OPTS, before the javaOpts, which take precedence.
+	// +optional
+	DriverMemory string `json:"driverMemory,omitempty"`
+
+	// ExecutorMemory is the memory of each Spark executor, such as "2g", used when the job runs against a Spark
+	// cluster. It's rendered as the spark.executor.memory property in the JAVA_OPTS.
+	// +optional
+	ExecutorMemory string `json:"executorMemory,omitempty"`
+
+	// Parallelism is the default number of partitions used by the job. It's rendered as the
+	// spark.default.parallelism property in the JAVA_OPTS.
+	// +optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
+
 	// +optional
 	CassandraClientAuthEnabled bool `json:"cassandraClientAuthEnabled,omitempty"`
 
@@ -606,6 +1326,16 @@ type JaegerEsIndexCleanerSpec struct {
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
 
+	// KeepLatest is the number of most recent daily indices the cleaner never deletes, regardless of their age.
+	// Not applicable when the indices are managed via rollover.
+	// +optional
+	KeepLatest *int `json:"keepLatest,omitempty"`
+
+	// SecretName is the name of the secret holding the ES_USERNAME and ES_PASSWORD used by the cleaner, allowing it
+	// to run with an account that has delete privileges. Defaults to the storage's secret.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
 	// +optional
 	JaegerCommonSpec `json:",inline,omitempty"`
 }
@@ -631,10 +1361,34 @@ type JaegerEsRolloverSpec struct {
 	// +optional
 	ReadTTL string `json:"readTTL,omitempty"`
 
+	// StepResources are the resources for the individual steps of the rollover, taking precedence over the
+	// rollover's resources
+	// +optional
+	StepResources JaegerEsRolloverStepResourcesSpec `json:"stepResources,omitempty"`
+
 	// +optional
 	JaegerCommonSpec `json:",inline,omitempty"`
 }
 
+// JaegerEsRolloverStepResourcesSpec defines the resources for each of the steps of the es-rollover
+// +k8s:openapi-gen=true
+type JaegerEsRolloverStepResourcesSpec struct {
+	// Init applies to the job creating the indices and aliases
+	// +nullable
+	// +optional
+	Init v1.ResourceRequirements `json:"init,omitempty"`
+
+	// Rollover applies to the cron job rolling the write alias over to a new index
+	// +nullable
+	// +optional
+	Rollover v1.ResourceRequirements `json:"rollover,omitempty"`
+
+	// Lookback applies to the cron job removing the old indices from the read alias
+	// +nullable
+	// +optional
+	Lookback v1.ResourceRequirements `json:"lookback,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // JaegerList contains a list of Jaeger
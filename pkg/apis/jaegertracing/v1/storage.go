@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"strconv"
+	"strings"
+)
+
+// EffectiveOptions returns the storage options with the structured storage settings applied on top of them.
+// The structured settings take precedence and are never written back to the options, which hold only the user's values.
+func (s JaegerStorageSpec) EffectiveOptions() Options {
+	opts := s.Options.GenericMap()
+	if s.Type == JaegerCassandraStorage {
+		if len(s.Cassandra.Servers) > 0 {
+			opts["cassandra.servers"] = strings.Join(s.Cassandra.Servers, ",")
+		}
+		if s.Cassandra.Port != nil {
+			opts["cassandra.port"] = strconv.Itoa(*s.Cassandra.Port)
+		}
+		if s.Cassandra.ConnectionsPerHost != nil {
+			opts["cassandra.connections-per-host"] = strconv.Itoa(int(*s.Cassandra.ConnectionsPerHost))
+		}
+		if s.Cassandra.MaxRetryAttempts != nil {
+			opts["cassandra.max-retry-attempts"] = strconv.Itoa(int(*s.Cassandra.MaxRetryAttempts))
+		}
+	}
+	if s.CreateIndexTemplates != nil && s.Type == JaegerESStorage {
+		opts["es.create-index-templates"] = strconv.FormatBool(*s.CreateIndexTemplates)
+	}
+	if s.EsNumShards != nil && s.Type == JaegerESStorage {
+		opts["es.num-shards"] = strconv.Itoa(int(*s.EsNumShards))
+	}
+	if s.EsNumReplicas != nil && s.Type == JaegerESStorage {
+		opts["es.num-replicas"] = strconv.Itoa(int(*s.EsNumReplicas))
+	}
+	if s.EsBulk != nil && s.Type == JaegerESStorage {
+		for option, value := range map[string]*int32{
+			"es.bulk.size":    s.EsBulk.Size,
+			"es.bulk.workers": s.EsBulk.Workers,
+			"es.bulk.actions": s.EsBulk.Actions,
+		} {
+			if value != nil {
+				opts[option] = strconv.Itoa(int(*value))
+			}
+		}
+		if len(s.EsBulk.FlushInterval) > 0 {
+			opts["es.bulk.flush-interval"] = s.EsBulk.FlushInterval
+		}
+	}
+	if s.ArchiveStorage != nil && ArchiveStorageSupported(s.Type) {
+		prefix := s.Type.OptionsPrefix() + "-archive."
+		opts[prefix+"enabled"] = "true"
+		for k, v := range s.ArchiveStorage.Options.GenericMap() {
+			opts[prefix+k] = v
+		}
+	}
+	return NewOptions(opts)
+}
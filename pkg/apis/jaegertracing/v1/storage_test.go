@@ -0,0 +1,229 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectiveOptionsCassandra(t *testing.T) {
+	port := 9043
+	connections := int32(4)
+	retries := int32(0)
+	tests := []struct {
+		name     string
+		spec     JaegerStorageSpec
+		expected map[string]string
+	}{
+		{
+			name:     "no structured fields",
+			spec:     JaegerStorageSpec{Options: NewOptions(map[string]interface{}{"cassandra.servers": "cassandra"})},
+			expected: map[string]string{"cassandra.servers": "cassandra"},
+		},
+		{
+			name: "servers and port",
+			spec: JaegerStorageSpec{
+				Type:      JaegerCassandraStorage,
+				Options:   NewOptions(map[string]interface{}{"cassandra.keyspace": "jaeger"}),
+				Cassandra: JaegerCassandraSpec{Servers: []string{"cassandra-0", "cassandra-1"}, Port: &port},
+			},
+			expected: map[string]string{"cassandra.keyspace": "jaeger", "cassandra.servers": "cassandra-0,cassandra-1", "cassandra.port": "9043"},
+		},
+		{
+			name: "structured fields take precedence",
+			spec: JaegerStorageSpec{
+				Type:      JaegerCassandraStorage,
+				Options:   NewOptions(map[string]interface{}{"cassandra.servers": "cassandra", "cassandra.port": "9042"}),
+				Cassandra: JaegerCassandraSpec{Servers: []string{"cassandra-0"}},
+			},
+			expected: map[string]string{"cassandra.servers": "cassandra-0", "cassandra.port": "9042"},
+		},
+		{
+			name: "write tuning",
+			spec: JaegerStorageSpec{
+				Type:      JaegerCassandraStorage,
+				Options:   NewOptions(map[string]interface{}{"cassandra.connections-per-host": "2"}),
+				Cassandra: JaegerCassandraSpec{ConnectionsPerHost: &connections, MaxRetryAttempts: &retries},
+			},
+			expected: map[string]string{"cassandra.connections-per-host": "4", "cassandra.max-retry-attempts": "0"},
+		},
+		{
+			name: "other storage",
+			spec: JaegerStorageSpec{
+				Type:      JaegerESStorage,
+				Options:   NewOptions(map[string]interface{}{"es.server-urls": "http://es:9200"}),
+				Cassandra: JaegerCassandraSpec{Servers: []string{"cassandra-0"}, Port: &port, ConnectionsPerHost: &connections, MaxRetryAttempts: &retries},
+			},
+			expected: map[string]string{"es.server-urls": "http://es:9200"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := test.spec.EffectiveOptions()
+			assert.Equal(t, test.expected, opts.Map())
+		})
+	}
+}
+
+func TestEffectiveOptionsIndexTemplates(t *testing.T) {
+	falseVar := false
+	tests := []struct {
+		name     string
+		spec     JaegerStorageSpec
+		expected map[string]string
+	}{
+		{
+			name:     "not set",
+			spec:     JaegerStorageSpec{Type: JaegerESStorage},
+			expected: map[string]string{},
+		},
+		{
+			name:     "disabled",
+			spec:     JaegerStorageSpec{Type: JaegerESStorage, CreateIndexTemplates: &falseVar},
+			expected: map[string]string{"es.create-index-templates": "false"},
+		},
+		{
+			name: "structured field takes precedence",
+			spec: JaegerStorageSpec{
+				Type:                 JaegerESStorage,
+				Options:              NewOptions(map[string]interface{}{"es.create-index-templates": "true"}),
+				CreateIndexTemplates: &falseVar,
+			},
+			expected: map[string]string{"es.create-index-templates": "false"},
+		},
+		{
+			name:     "ignored for other storage types",
+			spec:     JaegerStorageSpec{Type: JaegerCassandraStorage, CreateIndexTemplates: &falseVar},
+			expected: map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := test.spec.EffectiveOptions()
+			assert.Equal(t, test.expected, opts.Map())
+		})
+	}
+}
+
+func TestEffectiveOptionsIndexShards(t *testing.T) {
+	zero := int32(0)
+	three := int32(3)
+	tests := []struct {
+		name     string
+		spec     JaegerStorageSpec
+		expected map[string]string
+	}{
+		{
+			name:     "shards and replicas",
+			spec:     JaegerStorageSpec{Type: JaegerESStorage, EsNumShards: &three, EsNumReplicas: &zero},
+			expected: map[string]string{"es.num-shards": "3", "es.num-replicas": "0"},
+		},
+		{
+			name: "structured fields take precedence",
+			spec: JaegerStorageSpec{
+				Type:        JaegerESStorage,
+				Options:     NewOptions(map[string]interface{}{"es.num-shards": "5", "es.num-replicas": "2"}),
+				EsNumShards: &three,
+			},
+			expected: map[string]string{"es.num-shards": "3", "es.num-replicas": "2"},
+		},
+		{
+			name:     "ignored for other storage types",
+			spec:     JaegerStorageSpec{Type: JaegerCassandraStorage, EsNumShards: &three},
+			expected: map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := test.spec.EffectiveOptions()
+			assert.Equal(t, test.expected, opts.Map())
+		})
+	}
+}
+
+func TestEffectiveOptionsEsBulk(t *testing.T) {
+	workers := int32(4)
+	size := int32(10000000)
+	tests := []struct {
+		name     string
+		spec     JaegerStorageSpec
+		expected map[string]string
+	}{
+		{
+			name: "structured fields take precedence",
+			spec: JaegerStorageSpec{
+				Type:    JaegerESStorage,
+				Options: NewOptions(map[string]interface{}{"es.bulk.workers": "1", "es.bulk.actions": "500"}),
+				EsBulk:  &JaegerEsBulkSpec{Size: &size, Workers: &workers, FlushInterval: "1s"},
+			},
+			expected: map[string]string{
+				"es.bulk.size":           "10000000",
+				"es.bulk.workers":        "4",
+				"es.bulk.actions":        "500",
+				"es.bulk.flush-interval": "1s",
+			},
+		},
+		{
+			name:     "ignored for other storage types",
+			spec:     JaegerStorageSpec{Type: JaegerCassandraStorage, EsBulk: &JaegerEsBulkSpec{Workers: &workers}},
+			expected: map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := test.spec.EffectiveOptions()
+			assert.Equal(t, test.expected, opts.Map())
+		})
+	}
+}
+
+func TestEffectiveOptionsArchiveStorage(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     JaegerStorageSpec
+		expected map[string]string
+	}{
+		{
+			name: "elasticsearch",
+			spec: JaegerStorageSpec{
+				Type: JaegerESStorage,
+				ArchiveStorage: &JaegerArchiveStorageSpec{
+					Options: NewOptions(map[string]interface{}{"server-urls": "http://archive:9200"}),
+				},
+			},
+			expected: map[string]string{"es-archive.enabled": "true", "es-archive.server-urls": "http://archive:9200"},
+		},
+		{
+			name: "structured field takes precedence",
+			spec: JaegerStorageSpec{
+				Type:    JaegerCassandraStorage,
+				Options: NewOptions(map[string]interface{}{"cassandra-archive.keyspace": "other"}),
+				ArchiveStorage: &JaegerArchiveStorageSpec{
+					Options: NewOptions(map[string]interface{}{"keyspace": "jaeger_archive"}),
+				},
+			},
+			expected: map[string]string{"cassandra-archive.enabled": "true", "cassandra-archive.keyspace": "jaeger_archive"},
+		},
+		{
+			name:     "ignored for unsupported storage types",
+			spec:     JaegerStorageSpec{Type: JaegerMemoryStorage, ArchiveStorage: &JaegerArchiveStorageSpec{}},
+			expected: map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := test.spec.EffectiveOptions()
+			assert.Equal(t, test.expected, opts.Map())
+		})
+	}
+}
+
+func TestEffectiveOptionsLeavesOptionsUntouched(t *testing.T) {
+	port := 9043
+	spec := JaegerStorageSpec{
+		Options:   NewOptions(map[string]interface{}{"cassandra.keyspace": "jaeger"}),
+		Cassandra: JaegerCassandraSpec{Servers: []string{"cassandra-0"}, Port: &port},
+	}
+	spec.EffectiveOptions()
+	assert.Equal(t, map[string]string{"cassandra.keyspace": "jaeger"}, spec.Options.Map())
+}
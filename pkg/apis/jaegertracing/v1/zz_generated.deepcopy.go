@@ -5,7 +5,12 @@
 package v1
 
 import (
+	elasticsearchv1 "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	v2beta2 "k8s.io/api/autoscaling/v2beta2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -27,6 +32,13 @@ func (in *AutoScaleSpec) DeepCopyInto(out *AutoScaleSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2beta2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -40,6 +52,55 @@ func (in *AutoScaleSpec) DeepCopy() *AutoScaleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchMasterNodesSpec) DeepCopyInto(out *ElasticsearchMasterNodesSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(elasticsearchv1.ElasticsearchStorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchMasterNodesSpec.
+func (in *ElasticsearchMasterNodesSpec) DeepCopy() *ElasticsearchMasterNodesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchMasterNodesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchReference) DeepCopyInto(out *ElasticsearchReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchReference.
+func (in *ElasticsearchReference) DeepCopy() *ElasticsearchReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticsearchSpec) DeepCopyInto(out *ElasticsearchSpec) {
 	*out = *in
@@ -48,6 +109,11 @@ func (in *ElasticsearchSpec) DeepCopyInto(out *ElasticsearchSpec) {
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProxyResources != nil {
+		in, out := &in.ProxyResources, &out.ProxyResources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NodeSelector != nil {
 		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make(map[string]string, len(*in))
@@ -63,6 +129,16 @@ func (in *ElasticsearchSpec) DeepCopyInto(out *ElasticsearchSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MasterNodes != nil {
+		in, out := &in.MasterNodes, &out.MasterNodes
+		*out = new(ElasticsearchMasterNodesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Existing != nil {
+		in, out := &in.Existing, &out.Existing
+		*out = new(ElasticsearchReference)
+		**out = **in
+	}
 	return
 }
 
@@ -129,6 +205,22 @@ func (in *Jaeger) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerAWSWebIdentitySpec) DeepCopyInto(out *JaegerAWSWebIdentitySpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerAWSWebIdentitySpec.
+func (in *JaegerAWSWebIdentitySpec) DeepCopy() *JaegerAWSWebIdentitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerAWSWebIdentitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerAgentSpec) DeepCopyInto(out *JaegerAgentSpec) {
 	*out = *in
@@ -150,6 +242,28 @@ func (in *JaegerAgentSpec) DeepCopyInto(out *JaegerAgentSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ProcessorQueueSize != nil {
+		in, out := &in.ProcessorQueueSize, &out.ProcessorQueueSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ProcessorWorkers != nil {
+		in, out := &in.ProcessorWorkers, &out.ProcessorWorkers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AgentTags != nil {
+		in, out := &in.AgentTags, &out.AgentTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(appsv1.DaemonSetUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -174,6 +288,11 @@ func (in *JaegerAllInOneSpec) DeepCopyInto(out *JaegerAllInOneSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ConfigFile != nil {
+		in, out := &in.ConfigFile, &out.ConfigFile
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -187,6 +306,23 @@ func (in *JaegerAllInOneSpec) DeepCopy() *JaegerAllInOneSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerArchiveStorageSpec) DeepCopyInto(out *JaegerArchiveStorageSpec) {
+	*out = *in
+	in.Options.DeepCopyInto(&out.Options)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerArchiveStorageSpec.
+func (in *JaegerArchiveStorageSpec) DeepCopy() *JaegerArchiveStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerArchiveStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerCassandraCreateSchemaSpec) DeepCopyInto(out *JaegerCassandraCreateSchemaSpec) {
 	*out = *in
@@ -213,6 +349,105 @@ func (in *JaegerCassandraCreateSchemaSpec) DeepCopy() *JaegerCassandraCreateSche
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerCassandraSpec) DeepCopyInto(out *JaegerCassandraSpec) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.ConnectionsPerHost != nil {
+		in, out := &in.ConnectionsPerHost, &out.ConnectionsPerHost
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRetryAttempts != nil {
+		in, out := &in.MaxRetryAttempts, &out.MaxRetryAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerCassandraSpec.
+func (in *JaegerCassandraSpec) DeepCopy() *JaegerCassandraSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerCassandraSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerCollectorCanarySpec) DeepCopyInto(out *JaegerCollectorCanarySpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerCollectorCanarySpec.
+func (in *JaegerCollectorCanarySpec) DeepCopy() *JaegerCollectorCanarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerCollectorCanarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerCollectorOTLPCORSSpec) DeepCopyInto(out *JaegerCollectorOTLPCORSSpec) {
+	*out = *in
+	if in.AllowedOrigins != nil {
+		in, out := &in.AllowedOrigins, &out.AllowedOrigins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedHeaders != nil {
+		in, out := &in.AllowedHeaders, &out.AllowedHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerCollectorOTLPCORSSpec.
+func (in *JaegerCollectorOTLPCORSSpec) DeepCopy() *JaegerCollectorOTLPCORSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerCollectorOTLPCORSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerCollectorOTLPKeepaliveSpec) DeepCopyInto(out *JaegerCollectorOTLPKeepaliveSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerCollectorOTLPKeepaliveSpec.
+func (in *JaegerCollectorOTLPKeepaliveSpec) DeepCopy() *JaegerCollectorOTLPKeepaliveSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerCollectorOTLPKeepaliveSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerCollectorSpec) DeepCopyInto(out *JaegerCollectorSpec) {
 	*out = *in
@@ -225,6 +460,62 @@ func (in *JaegerCollectorSpec) DeepCopyInto(out *JaegerCollectorSpec) {
 	in.Options.DeepCopyInto(&out.Options)
 	in.JaegerCommonSpec.DeepCopyInto(&out.JaegerCommonSpec)
 	in.Config.DeepCopyInto(&out.Config)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.KafkaBrokersFrom != nil {
+		in, out := &in.KafkaBrokersFrom, &out.KafkaBrokersFrom
+		*out = new(JaegerKafkaBrokersSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OTLPMaxConcurrentStreams != nil {
+		in, out := &in.OTLPMaxConcurrentStreams, &out.OTLPMaxConcurrentStreams
+		*out = new(uint32)
+		**out = **in
+	}
+	out.OTLPKeepalive = in.OTLPKeepalive
+	in.OTLPCORS.DeepCopyInto(&out.OTLPCORS)
+	if in.QueueSize != nil {
+		in, out := &in.QueueSize, &out.QueueSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.QueueSizeMemory != nil {
+		in, out := &in.QueueSizeMemory, &out.QueueSizeMemory
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ServiceRateLimits != nil {
+		in, out := &in.ServiceRateLimits, &out.ServiceRateLimits
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(JaegerCollectorCanarySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricsPort != nil {
+		in, out := &in.MetricsPort, &out.MetricsPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ThriftHTTPPort != nil {
+		in, out := &in.ThriftHTTPPort, &out.ThriftHTTPPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConfigFile != nil {
+		in, out := &in.ConfigFile, &out.ConfigFile
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -282,11 +573,62 @@ func (in *JaegerCommonSpec) DeepCopyInto(out *JaegerCommonSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.SecurityContext != nil {
 		in, out := &in.SecurityContext, &out.SecurityContext
 		*out = new(corev1.PodSecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(corev1.Capabilities)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowPrivilegeEscalation != nil {
+		in, out := &in.AllowPrivilegeEscalation, &out.AllowPrivilegeEscalation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InternalTracing != nil {
+		in, out := &in.InternalTracing, &out.InternalTracing
+		*out = new(JaegerInternalTracingSpec)
+		**out = **in
+	}
+	if in.SidecarResources != nil {
+		in, out := &in.SidecarResources, &out.SidecarResources
+		*out = new(JaegerSidecarResourcesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -313,6 +655,11 @@ func (in *JaegerDependenciesSpec) DeepCopyInto(out *JaegerDependenciesSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ElasticsearchClientNodeOnly != nil {
 		in, out := &in.ElasticsearchClientNodeOnly, &out.ElasticsearchClientNodeOnly
 		*out = new(bool)
@@ -342,6 +689,37 @@ func (in *JaegerDependenciesSpec) DeepCopy() *JaegerDependenciesSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerEsBulkSpec) DeepCopyInto(out *JaegerEsBulkSpec) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerEsBulkSpec.
+func (in *JaegerEsBulkSpec) DeepCopy() *JaegerEsBulkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerEsBulkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerEsIndexCleanerSpec) DeepCopyInto(out *JaegerEsIndexCleanerSpec) {
 	*out = *in
@@ -365,6 +743,11 @@ func (in *JaegerEsIndexCleanerSpec) DeepCopyInto(out *JaegerEsIndexCleanerSpec)
 		*out = new(int32)
 		**out = **in
 	}
+	if in.KeepLatest != nil {
+		in, out := &in.KeepLatest, &out.KeepLatest
+		*out = new(int)
+		**out = **in
+	}
 	in.JaegerCommonSpec.DeepCopyInto(&out.JaegerCommonSpec)
 	return
 }
@@ -392,6 +775,7 @@ func (in *JaegerEsRolloverSpec) DeepCopyInto(out *JaegerEsRolloverSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	in.StepResources.DeepCopyInto(&out.StepResources)
 	in.JaegerCommonSpec.DeepCopyInto(&out.JaegerCommonSpec)
 	return
 }
@@ -406,6 +790,25 @@ func (in *JaegerEsRolloverSpec) DeepCopy() *JaegerEsRolloverSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerEsRolloverStepResourcesSpec) DeepCopyInto(out *JaegerEsRolloverStepResourcesSpec) {
+	*out = *in
+	in.Init.DeepCopyInto(&out.Init)
+	in.Rollover.DeepCopyInto(&out.Rollover)
+	in.Lookback.DeepCopyInto(&out.Lookback)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerEsRolloverStepResourcesSpec.
+func (in *JaegerEsRolloverStepResourcesSpec) DeepCopy() *JaegerEsRolloverStepResourcesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerEsRolloverStepResourcesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerIngesterSpec) DeepCopyInto(out *JaegerIngesterSpec) {
 	*out = *in
@@ -418,6 +821,31 @@ func (in *JaegerIngesterSpec) DeepCopyInto(out *JaegerIngesterSpec) {
 	in.Options.DeepCopyInto(&out.Options)
 	in.JaegerCommonSpec.DeepCopyInto(&out.JaegerCommonSpec)
 	in.Config.DeepCopyInto(&out.Config)
+	if in.KafkaBrokersFrom != nil {
+		in, out := &in.KafkaBrokersFrom, &out.KafkaBrokersFrom
+		*out = new(JaegerKafkaBrokersSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleDown != nil {
+		in, out := &in.ScaleDown, &out.ScaleDown
+		*out = new(v2beta2.HPAScalingRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KafkaPartitions != nil {
+		in, out := &in.KafkaPartitions, &out.KafkaPartitions
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -431,6 +859,32 @@ func (in *JaegerIngesterSpec) DeepCopy() *JaegerIngesterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerIngressCollectorSpec) DeepCopyInto(out *JaegerIngressCollectorSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerIngressCollectorSpec.
+func (in *JaegerIngressCollectorSpec) DeepCopy() *JaegerIngressCollectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerIngressCollectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerIngressOpenShiftSpec) DeepCopyInto(out *JaegerIngressOpenShiftSpec) {
 	*out = *in
@@ -439,6 +893,11 @@ func (in *JaegerIngressOpenShiftSpec) DeepCopyInto(out *JaegerIngressOpenShiftSp
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SkipRoute != nil {
+		in, out := &in.SkipRoute, &out.SkipRoute
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -475,6 +934,8 @@ func (in *JaegerIngressSpec) DeepCopyInto(out *JaegerIngressSpec) {
 	}
 	in.JaegerCommonSpec.DeepCopyInto(&out.JaegerCommonSpec)
 	in.Options.DeepCopyInto(&out.Options)
+	in.Collector.DeepCopyInto(&out.Collector)
+	in.Zipkin.DeepCopyInto(&out.Zipkin)
 	return
 }
 
@@ -509,6 +970,81 @@ func (in *JaegerIngressTLSSpec) DeepCopy() *JaegerIngressTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerIngressZipkinSpec) DeepCopyInto(out *JaegerIngressZipkinSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = make([]JaegerIngressTLSSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerIngressZipkinSpec.
+func (in *JaegerIngressZipkinSpec) DeepCopy() *JaegerIngressZipkinSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerIngressZipkinSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerInternalTracingSpec) DeepCopyInto(out *JaegerInternalTracingSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerInternalTracingSpec.
+func (in *JaegerInternalTracingSpec) DeepCopy() *JaegerInternalTracingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerInternalTracingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerKafkaBrokersSource) DeepCopyInto(out *JaegerKafkaBrokersSource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerKafkaBrokersSource.
+func (in *JaegerKafkaBrokersSource) DeepCopy() *JaegerKafkaBrokersSource {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerKafkaBrokersSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerList) DeepCopyInto(out *JaegerList) {
 	*out = *in
@@ -542,6 +1078,37 @@ func (in *JaegerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerNetworkPolicySpec) DeepCopyInto(out *JaegerNetworkPolicySpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.QueryIngressCIDRs != nil {
+		in, out := &in.QueryIngressCIDRs, &out.QueryIngressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryIngressNamespaceSelector != nil {
+		in, out := &in.QueryIngressNamespaceSelector, &out.QueryIngressNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerNetworkPolicySpec.
+func (in *JaegerNetworkPolicySpec) DeepCopy() *JaegerNetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerNetworkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerQuerySpec) DeepCopyInto(out *JaegerQuerySpec) {
 	*out = *in
@@ -557,6 +1124,21 @@ func (in *JaegerQuerySpec) DeepCopyInto(out *JaegerQuerySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(JaegerQueryStorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SessionAffinityTimeoutSeconds != nil {
+		in, out := &in.SessionAffinityTimeoutSeconds, &out.SessionAffinityTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -570,6 +1152,44 @@ func (in *JaegerQuerySpec) DeepCopy() *JaegerQuerySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerQueryStorageSpec) DeepCopyInto(out *JaegerQueryStorageSpec) {
+	*out = *in
+	in.Options.DeepCopyInto(&out.Options)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(JaegerQueryStorageTLSSpec)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerQueryStorageSpec.
+func (in *JaegerQueryStorageSpec) DeepCopy() *JaegerQueryStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerQueryStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerQueryStorageTLSSpec) DeepCopyInto(out *JaegerQueryStorageTLSSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerQueryStorageTLSSpec.
+func (in *JaegerQueryStorageTLSSpec) DeepCopy() *JaegerQueryStorageTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerQueryStorageTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerSamplingSpec) DeepCopyInto(out *JaegerSamplingSpec) {
 	*out = *in
@@ -587,6 +1207,25 @@ func (in *JaegerSamplingSpec) DeepCopy() *JaegerSamplingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerSidecarResourcesSpec) DeepCopyInto(out *JaegerSidecarResourcesSpec) {
+	*out = *in
+	in.Default.DeepCopyInto(&out.Default)
+	in.Agent.DeepCopyInto(&out.Agent)
+	in.OAuthProxy.DeepCopyInto(&out.OAuthProxy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerSidecarResourcesSpec.
+func (in *JaegerSidecarResourcesSpec) DeepCopy() *JaegerSidecarResourcesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerSidecarResourcesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerSpec) DeepCopyInto(out *JaegerSpec) {
 	*out = *in
@@ -599,6 +1238,15 @@ func (in *JaegerSpec) DeepCopyInto(out *JaegerSpec) {
 	in.Sampling.DeepCopyInto(&out.Sampling)
 	in.Storage.DeepCopyInto(&out.Storage)
 	in.Ingress.DeepCopyInto(&out.Ingress)
+	in.Upgrade.DeepCopyInto(&out.Upgrade)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	if in.ServiceAccountAnnotations != nil {
+		in, out := &in.ServiceAccountAnnotations, &out.ServiceAccountAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	in.JaegerCommonSpec.DeepCopyInto(&out.JaegerCommonSpec)
 	return
 }
@@ -633,6 +1281,33 @@ func (in *JaegerStatus) DeepCopy() *JaegerStatus {
 func (in *JaegerStorageSpec) DeepCopyInto(out *JaegerStorageSpec) {
 	*out = *in
 	in.Options.DeepCopyInto(&out.Options)
+	in.Cassandra.DeepCopyInto(&out.Cassandra)
+	if in.CreateIndexTemplates != nil {
+		in, out := &in.CreateIndexTemplates, &out.CreateIndexTemplates
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EsNumShards != nil {
+		in, out := &in.EsNumShards, &out.EsNumShards
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EsNumReplicas != nil {
+		in, out := &in.EsNumReplicas, &out.EsNumReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EsBulk != nil {
+		in, out := &in.EsBulk, &out.EsBulk
+		*out = new(JaegerEsBulkSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArchiveStorage != nil {
+		in, out := &in.ArchiveStorage, &out.ArchiveStorage
+		*out = new(JaegerArchiveStorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	out.AWSWebIdentity = in.AWSWebIdentity
 	in.CassandraCreateSchema.DeepCopyInto(&out.CassandraCreateSchema)
 	in.Dependencies.DeepCopyInto(&out.Dependencies)
 	in.EsIndexCleaner.DeepCopyInto(&out.EsIndexCleaner)
@@ -655,6 +1330,11 @@ func (in *JaegerStorageSpec) DeepCopy() *JaegerStorageSpec {
 func (in *JaegerUISpec) DeepCopyInto(out *JaegerUISpec) {
 	*out = *in
 	in.Options.DeepCopyInto(&out.Options)
+	if in.DependenciesMenuEnabled != nil {
+		in, out := &in.DependenciesMenuEnabled, &out.DependenciesMenuEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -668,6 +1348,27 @@ func (in *JaegerUISpec) DeepCopy() *JaegerUISpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerUpgradeSpec) DeepCopyInto(out *JaegerUpgradeSpec) {
+	*out = *in
+	if in.PreUpgradeJob != nil {
+		in, out := &in.PreUpgradeJob, &out.PreUpgradeJob
+		*out = new(batchv1.JobSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerUpgradeSpec.
+func (in *JaegerUpgradeSpec) DeepCopy() *JaegerUpgradeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerUpgradeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Options) DeepCopyInto(out *Options) {
 	*out = *in
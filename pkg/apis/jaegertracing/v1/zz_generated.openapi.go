@@ -13,26 +13,45 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"./pkg/apis/jaegertracing/v1.AutoScaleSpec":                   schema_pkg_apis_jaegertracing_v1_AutoScaleSpec(ref),
-		"./pkg/apis/jaegertracing/v1.ElasticsearchSpec":               schema_pkg_apis_jaegertracing_v1_ElasticsearchSpec(ref),
-		"./pkg/apis/jaegertracing/v1.Jaeger":                          schema_pkg_apis_jaegertracing_v1_Jaeger(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerAgentSpec":                 schema_pkg_apis_jaegertracing_v1_JaegerAgentSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerAllInOneSpec":              schema_pkg_apis_jaegertracing_v1_JaegerAllInOneSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerCassandraCreateSchemaSpec": schema_pkg_apis_jaegertracing_v1_JaegerCassandraCreateSchemaSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerCollectorSpec":             schema_pkg_apis_jaegertracing_v1_JaegerCollectorSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerCommonSpec":                schema_pkg_apis_jaegertracing_v1_JaegerCommonSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerDependenciesSpec":          schema_pkg_apis_jaegertracing_v1_JaegerDependenciesSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerEsIndexCleanerSpec":        schema_pkg_apis_jaegertracing_v1_JaegerEsIndexCleanerSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerIngesterSpec":              schema_pkg_apis_jaegertracing_v1_JaegerIngesterSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerIngressOpenShiftSpec":      schema_pkg_apis_jaegertracing_v1_JaegerIngressOpenShiftSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerIngressSpec":               schema_pkg_apis_jaegertracing_v1_JaegerIngressSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerIngressTLSSpec":            schema_pkg_apis_jaegertracing_v1_JaegerIngressTLSSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerQuerySpec":                 schema_pkg_apis_jaegertracing_v1_JaegerQuerySpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerSamplingSpec":              schema_pkg_apis_jaegertracing_v1_JaegerSamplingSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerSpec":                      schema_pkg_apis_jaegertracing_v1_JaegerSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerStatus":                    schema_pkg_apis_jaegertracing_v1_JaegerStatus(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerStorageSpec":               schema_pkg_apis_jaegertracing_v1_JaegerStorageSpec(ref),
-		"./pkg/apis/jaegertracing/v1.JaegerUISpec":                    schema_pkg_apis_jaegertracing_v1_JaegerUISpec(ref),
+		"./pkg/apis/jaegertracing/v1.AutoScaleSpec":                     schema_pkg_apis_jaegertracing_v1_AutoScaleSpec(ref),
+		"./pkg/apis/jaegertracing/v1.ElasticsearchMasterNodesSpec":      schema_pkg_apis_jaegertracing_v1_ElasticsearchMasterNodesSpec(ref),
+		"./pkg/apis/jaegertracing/v1.ElasticsearchReference":            schema_pkg_apis_jaegertracing_v1_ElasticsearchReference(ref),
+		"./pkg/apis/jaegertracing/v1.ElasticsearchSpec":                 schema_pkg_apis_jaegertracing_v1_ElasticsearchSpec(ref),
+		"./pkg/apis/jaegertracing/v1.Jaeger":                            schema_pkg_apis_jaegertracing_v1_Jaeger(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerAWSWebIdentitySpec":          schema_pkg_apis_jaegertracing_v1_JaegerAWSWebIdentitySpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerAgentSpec":                   schema_pkg_apis_jaegertracing_v1_JaegerAgentSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerAllInOneSpec":                schema_pkg_apis_jaegertracing_v1_JaegerAllInOneSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerArchiveStorageSpec":          schema_pkg_apis_jaegertracing_v1_JaegerArchiveStorageSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerCassandraCreateSchemaSpec":   schema_pkg_apis_jaegertracing_v1_JaegerCassandraCreateSchemaSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerCassandraSpec":               schema_pkg_apis_jaegertracing_v1_JaegerCassandraSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerCollectorCanarySpec":         schema_pkg_apis_jaegertracing_v1_JaegerCollectorCanarySpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerCollectorOTLPCORSSpec":       schema_pkg_apis_jaegertracing_v1_JaegerCollectorOTLPCORSSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerCollectorOTLPKeepaliveSpec":  schema_pkg_apis_jaegertracing_v1_JaegerCollectorOTLPKeepaliveSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerCollectorSpec":               schema_pkg_apis_jaegertracing_v1_JaegerCollectorSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerCommonSpec":                  schema_pkg_apis_jaegertracing_v1_JaegerCommonSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerDependenciesSpec":            schema_pkg_apis_jaegertracing_v1_JaegerDependenciesSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerEsBulkSpec":                  schema_pkg_apis_jaegertracing_v1_JaegerEsBulkSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerEsIndexCleanerSpec":          schema_pkg_apis_jaegertracing_v1_JaegerEsIndexCleanerSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerEsRolloverStepResourcesSpec": schema_pkg_apis_jaegertracing_v1_JaegerEsRolloverStepResourcesSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerIngesterSpec":                schema_pkg_apis_jaegertracing_v1_JaegerIngesterSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerIngressCollectorSpec":        schema_pkg_apis_jaegertracing_v1_JaegerIngressCollectorSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerIngressOpenShiftSpec":        schema_pkg_apis_jaegertracing_v1_JaegerIngressOpenShiftSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerIngressSpec":                 schema_pkg_apis_jaegertracing_v1_JaegerIngressSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerIngressTLSSpec":              schema_pkg_apis_jaegertracing_v1_JaegerIngressTLSSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerIngressZipkinSpec":           schema_pkg_apis_jaegertracing_v1_JaegerIngressZipkinSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec":         schema_pkg_apis_jaegertracing_v1_JaegerInternalTracingSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerKafkaBrokersSource":          schema_pkg_apis_jaegertracing_v1_JaegerKafkaBrokersSource(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerNetworkPolicySpec":           schema_pkg_apis_jaegertracing_v1_JaegerNetworkPolicySpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerQuerySpec":                   schema_pkg_apis_jaegertracing_v1_JaegerQuerySpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerQueryStorageSpec":            schema_pkg_apis_jaegertracing_v1_JaegerQueryStorageSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerQueryStorageTLSSpec":         schema_pkg_apis_jaegertracing_v1_JaegerQueryStorageTLSSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerSamplingSpec":                schema_pkg_apis_jaegertracing_v1_JaegerSamplingSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec":        schema_pkg_apis_jaegertracing_v1_JaegerSidecarResourcesSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerSpec":                        schema_pkg_apis_jaegertracing_v1_JaegerSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerStatus":                      schema_pkg_apis_jaegertracing_v1_JaegerStatus(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerStorageSpec":                 schema_pkg_apis_jaegertracing_v1_JaegerStorageSpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerUISpec":                      schema_pkg_apis_jaegertracing_v1_JaegerUISpec(ref),
+		"./pkg/apis/jaegertracing/v1.JaegerUpgradeSpec":                 schema_pkg_apis_jaegertracing_v1_JaegerUpgradeSpec(ref),
 	}
 }
 
@@ -64,7 +83,104 @@ func schema_pkg_apis_jaegertracing_v1_AutoScaleSpec(ref common.ReferenceCallback
 							Format:      "int32",
 						},
 					},
+					"metrics": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics are the metrics the autoscaler uses to calculate the desired number of replicas, such as a custom Kafka lag metric alongside the CPU utilization. The highest number of replicas among all the metrics is used. When not provided, the autoscaler targets 90% of the CPU and memory utilization.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/autoscaling/v2beta2.MetricSpec"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/autoscaling/v2beta2.MetricSpec"},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_ElasticsearchMasterNodesSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ElasticsearchMasterNodesSpec represents the dedicated master nodes of a self-provisioned ES cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeCount is the number of master nodes. Defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources for the master nodes. Defaults to the resources of the cluster.",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector for the master nodes. Defaults to the node selector of the cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage for the master nodes. Defaults to the storage of the cluster.",
+							Ref:         ref("github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1.ElasticsearchStorageSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1.ElasticsearchStorageSpec", "k8s.io/api/core/v1.ResourceRequirements"},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_ElasticsearchReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ElasticsearchReference identifies an Elasticsearch CR that isn't managed by the operator",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Elasticsearch CR",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the Elasticsearch CR. Defaults to the namespace of the Jaeger instance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
@@ -88,6 +204,12 @@ func schema_pkg_apis_jaegertracing_v1_ElasticsearchSpec(ref common.ReferenceCall
 							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
+					"proxyResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProxyResources are the resources of the proxy running next to each Elasticsearch node. Defaults to 64Mi of memory and a request of 100m CPU.",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
 					"nodeCount": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
@@ -115,8 +237,9 @@ func schema_pkg_apis_jaegertracing_v1_ElasticsearchSpec(ref common.ReferenceCall
 					},
 					"redundancyPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "RedundancyPolicy is the replication policy of the indices, passed to the Elasticsearch CR. Possible values: FullRedundancy, MultipleRedundancy, SingleRedundancy and ZeroRedundancy. Defaults to ZeroRedundancy for a single node and SingleRedundancy otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"tolerations": {
@@ -136,11 +259,23 @@ func schema_pkg_apis_jaegertracing_v1_ElasticsearchSpec(ref common.ReferenceCall
 							},
 						},
 					},
+					"masterNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MasterNodes configures a dedicated group of master nodes. When set, the NodeCount nodes hold only the data and client roles. When omitted, the nodes share all the roles.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.ElasticsearchMasterNodesSpec"),
+						},
+					},
+					"existing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Existing references an Elasticsearch CR that is managed outside of this Jaeger instance, such as the one from OpenShift cluster logging. When set, the operator doesn't create its own Elasticsearch CR and points the Jaeger components to the referenced cluster instead. The client certificates are signed by the CA from the <instance>-master-certs secret, which can be pre-created with the CA of the referenced cluster.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.ElasticsearchReference"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1.ElasticsearchStorageSpec", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+			"./pkg/apis/jaegertracing/v1.ElasticsearchMasterNodesSpec", "./pkg/apis/jaegertracing/v1.ElasticsearchReference", "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1.ElasticsearchStorageSpec", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
@@ -188,6 +323,33 @@ func schema_pkg_apis_jaegertracing_v1_Jaeger(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_jaegertracing_v1_JaegerAWSWebIdentitySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerAWSWebIdentitySpec defines the IAM role the storage-related components assume to access AWS services, such as Amazon OpenSearch, via IAM roles for service accounts",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"roleArn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleARN is the ARN of the IAM role to assume. When set, a service account token is projected into the pods and the AWS_ROLE_ARN and AWS_WEB_IDENTITY_TOKEN_FILE environment variables are set for the AWS SDK.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"audience": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Audience is the intended audience of the projected token. Defaults to \"sts.amazonaws.com\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_jaegertracing_v1_JaegerAgentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -319,6 +481,21 @@ func schema_pkg_apis_jaegertracing_v1_JaegerAgentSpec(ref common.ReferenceCallba
 							},
 						},
 					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the nodes the component's pods are scheduled on",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 					"securityContext": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
@@ -326,8 +503,104 @@ func schema_pkg_apis_jaegertracing_v1_JaegerAgentSpec(ref common.ReferenceCallba
 					},
 					"serviceAccount": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ServiceAccount is the name of an existing service account to be used by the component. The operator doesn't create nor manage service accounts referenced here.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"logLevel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogLevel is the log level for the component. Possible values: debug, info, warn, error. The cron jobs don't support it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startupProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartupProbe is the startup probe for the component's main container. Liveness and readiness probes are suspended until it succeeds.",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capabilities are the Linux capabilities to add to or drop from the component's main container. Defaults to dropping all capabilities.",
+							Ref:         ref("k8s.io/api/core/v1.Capabilities"),
+						},
+					},
+					"allowPrivilegeEscalation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPrivilegeEscalation controls whether the component's main container can gain more privileges than its parent process. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName is the name of the RuntimeClass used to run the component's pods, such as a sandboxed runtime",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sidecars": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Sidecars are extra containers added to the component's pod, like log shippers. Their names must not collide with the containers managed by the operator.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"internalTracing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InternalTracing makes the component report the spans about its own operations to another Jaeger instance. Only the query and the all-in-one report such spans, and not when their tracingEnabled is false. Disabled by default.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec"),
+						},
+					},
+					"sidecarResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarResources are the resources for the sidecars injected by the operator, like the agent and the OAuth proxy. When no resources are set for a sidecar, it gets small requests.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec"),
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Env are extra environment variables for the component's main container, like HTTP_PROXY and NO_PROXY. They also reach the containers of the cron jobs. Variables set by the operator take precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"terminationMessagePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TerminationMessagePolicy indicates how the termination message of the component's main container is populated. Defaults to FallbackToLogsOnError, so that the last log lines are kept when the container crashes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullPolicy is the pull policy for the image of the component's main container, including the containers of the cron jobs. When not set, the Kubernetes default applies.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"config": {
@@ -346,11 +619,46 @@ func schema_pkg_apis_jaegertracing_v1_JaegerAgentSpec(ref common.ReferenceCallba
 							Format: "",
 						},
 					},
+					"processorQueueSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProcessorQueueSize is the length of the queue for the UDP server of each of the agent's processors. An explicit \"processor.<name>.server-queue-size\" option takes precedence for the given processor.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"processorWorkers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProcessorWorkers is the number of workers pulling items from the queue of each of the agent's processors. An explicit \"processor.<name>.workers\" option takes precedence for the given processor.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"agentTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AgentTags holds the process tags the agent adds to all the spans it reports. Values may reference environment variables of the agent container, like \"${POD_NAME:}\". For the sidecars, they are added to the default tags set by the operator. Keys and values must not contain commas nor equals signs. An explicit tags option takes precedence.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"updateStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateStrategy is the strategy replacing the agent pods when the DaemonSet changes, like a RollingUpdate with a maximum number of unavailable pods, or OnDelete. Defaults to a RollingUpdate. Only applied to the DaemonSet.",
+							Ref:         ref("k8s.io/api/apps/v1.DaemonSetUpdateStrategy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./pkg/apis/jaegertracing/v1.FreeForm", "./pkg/apis/jaegertracing/v1.Options", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+			"./pkg/apis/jaegertracing/v1.FreeForm", "./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec", "./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec", "./pkg/apis/jaegertracing/v1.Options", "k8s.io/api/apps/v1.DaemonSetUpdateStrategy", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Capabilities", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -466,6 +774,21 @@ func schema_pkg_apis_jaegertracing_v1_JaegerAllInOneSpec(ref common.ReferenceCal
 							},
 						},
 					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the nodes the component's pods are scheduled on",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 					"securityContext": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
@@ -473,118 +796,1423 @@ func schema_pkg_apis_jaegertracing_v1_JaegerAllInOneSpec(ref common.ReferenceCal
 					},
 					"serviceAccount": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ServiceAccount is the name of an existing service account to be used by the component. The operator doesn't create nor manage service accounts referenced here.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tracingEnabled": {
+					"logLevel": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TracingEnabled if set to false adds the JAEGER_DISABLED environment flag and removes the injected agent container from the query component to disable tracing requests to the query service. The default, if ommited, is true",
-							Type:        []string{"boolean"},
+							Description: "LogLevel is the log level for the component. Possible values: debug, info, warn, error. The cron jobs don't support it.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"./pkg/apis/jaegertracing/v1.FreeForm", "./pkg/apis/jaegertracing/v1.Options", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
-	}
-}
-
-func schema_pkg_apis_jaegertracing_v1_JaegerCassandraCreateSchemaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "JaegerCassandraCreateSchemaSpec holds the options related to the create-schema batch job",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enabled": {
+					"startupProbe": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "StartupProbe is the startup probe for the component's main container. Liveness and readiness probes are suspended until it succeeds.",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
 						},
 					},
-					"image": {
+					"capabilities": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image specifies the container image to use to create the cassandra schema. The Image is used by a Kubernetes Job, defaults to the image provided through the cli flag \"jaeger-cassandra-schema-image\" (default: jaegertracing/jaeger-cassandra-schema). See here for the jaeger-provided image: https://github.com/jaegertracing/jaeger/tree/master/plugin/storage/cassandra",
+							Description: "Capabilities are the Linux capabilities to add to or drop from the component's main container. Defaults to dropping all capabilities.",
+							Ref:         ref("k8s.io/api/core/v1.Capabilities"),
+						},
+					},
+					"allowPrivilegeEscalation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPrivilegeEscalation controls whether the component's main container can gain more privileges than its parent process. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName is the name of the RuntimeClass used to run the component's pods, such as a sandboxed runtime",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sidecars": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Sidecars are extra containers added to the component's pod, like log shippers. Their names must not collide with the containers managed by the operator.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"internalTracing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InternalTracing makes the component report the spans about its own operations to another Jaeger instance. Only the query and the all-in-one report such spans, and not when their tracingEnabled is false. Disabled by default.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec"),
+						},
+					},
+					"sidecarResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarResources are the resources for the sidecars injected by the operator, like the agent and the OAuth proxy. When no resources are set for a sidecar, it gets small requests.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec"),
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Env are extra environment variables for the component's main container, like HTTP_PROXY and NO_PROXY. They also reach the containers of the cron jobs. Variables set by the operator take precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"terminationMessagePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TerminationMessagePolicy indicates how the termination message of the component's main container is populated. Defaults to FallbackToLogsOnError, so that the last log lines are kept when the container crashes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullPolicy is the pull policy for the image of the component's main container, including the containers of the cron jobs. When not set, the Kubernetes default applies.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tracingEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TracingEnabled if set to false adds the JAEGER_DISABLED environment flag and removes the injected agent container from the query component to disable tracing requests to the query service. The default, if ommited, is true",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"otlpEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OTLPEnabled exposes the OTLP receivers on the ports 4317 (gRPC) and 4318 (HTTP), configuring them in the OpenTelemetry config. It requires the Config to be set, as the flag-based all-in-one has no OTLP receiver.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"configFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigFile references the config map entry holding a configuration file for the all-in-one, which is mounted and passed via the --config-file flag. An explicit option takes precedence.",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/jaegertracing/v1.FreeForm", "./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec", "./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec", "./pkg/apis/jaegertracing/v1.Options", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Capabilities", "k8s.io/api/core/v1.ConfigMapKeySelector", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerArchiveStorageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerArchiveStorageSpec defines the archive storage. When set, the options take precedence over the matching \"<storage>-archive.*\" storage options.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the storage type of the archive. The archive is served by the same storage plugin as the spans, so it has to match the storage type. Defaults to the storage type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Options are the archive storage options without the \"<storage>-archive.\" prefix, like \"server-urls\" or \"index-prefix\" for Elasticsearch and \"servers\" or \"keyspace\" for Cassandra.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.Options"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/jaegertracing/v1.Options"},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerCassandraCreateSchemaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerCassandraCreateSchemaSpec holds the options related to the create-schema batch job",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image specifies the container image to use to create the cassandra schema. The Image is used by a Kubernetes Job, defaults to the image provided through the cli flag \"jaeger-cassandra-schema-image\" (default: jaegertracing/jaeger-cassandra-schema). See here for the jaeger-provided image: https://github.com/jaegertracing/jaeger/tree/master/plugin/storage/cassandra",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"datacenter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Datacenter is a collection of racks in the cassandra topology. defaults to \"test\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode controls the replication factor of your cassandra schema. Set it to \"prod\" (which is the default) to use the NetworkTopologyStrategy with a replication factor of 2, effectively meaning that at least 3 nodes are required in the cassandra cluster. When set to \"test\" the schema uses the SimpleStrategy with a replication factor of 1. You never want to do this in a production setup.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"traceTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TraceTTL sets the TTL for your trace data, either as a duration (e.g. 168h) or as a number of seconds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dependenciesTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependenciesTTL sets the TTL for the dependencies data, either as a duration (e.g. 168h) or as a number of seconds. Defaults to no TTL.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout controls the Job deadline, it defaults to 1 day. specify it with a value which can be parsed by time.ParseDuration, e.g. 24h or 120m. If the job does not succeed within that duration it transitions into a permanent error state. See https://github.com/jaegertracing/jaeger-kubernetes/issues/32 and https://github.com/jaegertracing/jaeger-kubernetes/pull/125",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ttlSecondsAfterFinished": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerCassandraSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerCassandraSpec defines the Cassandra cluster to connect to and how the spans are written to it. When set, the values take precedence over the matching \"cassandra.*\" storage options. Only valid with the cassandra storage.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"servers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Servers is the list of contact points of the Cassandra cluster",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port the Cassandra contact points listen on",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"connectionsPerHost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectionsPerHost is the number of Cassandra connections each Jaeger component opens to every host",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxRetryAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetryAttempts is the number of times a failed query is retried. Set it to 0 to disable the retries.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerCollectorCanarySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerCollectorCanarySpec defines the canary deployment of the collector",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the collector image to validate on the canary replicas",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the number of canary replicas. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"image"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerCollectorOTLPCORSSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerCollectorOTLPCORSSpec defines the CORS settings of the collector's OTLP HTTP receiver. Values set explicitly in the OpenTelemetry config take precedence.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allowedOrigins": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedOrigins are the origins allowed to send cross-origin requests, like \"https://app.example.com\". An origin may contain a wildcard, like \"https://*.example.com\", or be \"*\" to allow any origin.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"allowedHeaders": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedHeaders are the headers allowed in cross-origin requests, in addition to the simple request headers",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerCollectorOTLPKeepaliveSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerCollectorOTLPKeepaliveSpec defines the keepalive server parameters of the collector's OTLP gRPC receiver. All the values are durations, like \"30s\". Values set explicitly in the OpenTelemetry config take precedence.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxConnectionIdle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConnectionIdle is the duration after which an idle connection is closed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxConnectionAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConnectionAge is the maximum duration a connection may exist before it's closed, which makes long-lived clients reconnect and get balanced across the collector replicas",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxConnectionAgeGrace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConnectionAgeGrace is the additional duration given to pending RPCs after MaxConnectionAge",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the duration of inactivity after which the server pings the client",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the duration the server waits for a ping ack before closing the connection",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerCollectorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerCollectorSpec defines the options to be used when deploying the collector",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"autoscale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscale turns on/off the autoscale feature. By default, it's enabled if the Replicas field is not set.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas sets a lower bound to the autoscaling feature.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas sets an upper bound to the autoscaling feature. When autoscaling is enabled and no value is provided, a default value is used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"metrics": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics are the metrics the autoscaler uses to calculate the desired number of replicas, such as a custom Kafka lag metric alongside the CPU utilization. The highest number of replicas among all the metrics is used. When not provided, the autoscaler targets 90% of the CPU and memory utilization.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/autoscaling/v2beta2.MetricSpec"),
+									},
+								},
+							},
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas represents the number of replicas to create for this service.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/jaegertracing/v1.Options"),
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"tolerations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the nodes the component's pods are scheduled on",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"securityContext": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
+						},
+					},
+					"serviceAccount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccount is the name of an existing service account to be used by the component. The operator doesn't create nor manage service accounts referenced here.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"logLevel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogLevel is the log level for the component. Possible values: debug, info, warn, error. The cron jobs don't support it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startupProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartupProbe is the startup probe for the component's main container. Liveness and readiness probes are suspended until it succeeds.",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capabilities are the Linux capabilities to add to or drop from the component's main container. Defaults to dropping all capabilities.",
+							Ref:         ref("k8s.io/api/core/v1.Capabilities"),
+						},
+					},
+					"allowPrivilegeEscalation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPrivilegeEscalation controls whether the component's main container can gain more privileges than its parent process. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName is the name of the RuntimeClass used to run the component's pods, such as a sandboxed runtime",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sidecars": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Sidecars are extra containers added to the component's pod, like log shippers. Their names must not collide with the containers managed by the operator.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"internalTracing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InternalTracing makes the component report the spans about its own operations to another Jaeger instance. Only the query and the all-in-one report such spans, and not when their tracingEnabled is false. Disabled by default.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec"),
+						},
+					},
+					"sidecarResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarResources are the resources for the sidecars injected by the operator, like the agent and the OAuth proxy. When no resources are set for a sidecar, it gets small requests.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec"),
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Env are extra environment variables for the component's main container, like HTTP_PROXY and NO_PROXY. They also reach the containers of the cron jobs. Variables set by the operator take precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"terminationMessagePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TerminationMessagePolicy indicates how the termination message of the component's main container is populated. Defaults to FallbackToLogsOnError, so that the last log lines are kept when the container crashes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullPolicy is the pull policy for the image of the component's main container, including the containers of the cron jobs. When not set, the Kubernetes default applies.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/jaegertracing/v1.FreeForm"),
+						},
+					},
+					"serviceType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceType represents the type of Service to create. Valid values include: ClusterIP, NodePort, LoadBalancer, and ExternalName. The default, if omitted, is ClusterIP. See https://kubernetes.io/docs/concepts/services-networking/service/#publishing-services-service-types",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags holds the tags to be added to all spans processed by the collector, rendered as the collector tags flag. Keys and values must not contain commas nor equals signs.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"kafkaTopic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KafkaTopic is the Kafka topic the collector writes spans to when using the streaming strategy. It has to match the topic the ingester consumes from.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kafkaBrokersFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KafkaBrokersFrom references a config map or secret key holding the comma-separated list of Kafka brokers the collector writes spans to when using the streaming strategy. It can't be combined with the \"kafka.producer.brokers\" option.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerKafkaBrokersSource"),
+						},
+					},
+					"otlpMaxConcurrentStreams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OTLPMaxConcurrentStreams limits the number of concurrent streams each client connection may open against the OTLP gRPC receiver. Only applied when the collector's OpenTelemetry config declares the OTLP gRPC protocol.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"otlpKeepalive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OTLPKeepalive configures the keepalive parameters of the OTLP gRPC receiver. Only applied when the collector's OpenTelemetry config declares the OTLP gRPC protocol.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerCollectorOTLPKeepaliveSpec"),
+						},
+					},
+					"otlpCors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OTLPCORS configures the CORS settings of the OTLP HTTP receiver, allowing browsers to send spans directly to the collector. Only applied when the collector's OpenTelemetry config has an OTLP HTTP receiver.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerCollectorOTLPCORSSpec"),
+						},
+					},
+					"queueSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueueSize is the number of spans the collector queues before dropping new ones. Rendered as the --collector.queue-size flag. Can't be used together with QueueSizeMemory.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"queueSizeMemory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueueSizeMemory is the memory in MiB the collector's queue may use, resizing the queue based on the observed span sizes. Spans are dropped once it's full. Rendered as the --collector.queue-size-memory flag. It should stay below the collector's memory limit. Can't be used together with QueueSize.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"serviceRateLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceRateLimits caps the number of traces per second each of the given services may send, keyed by service name. They are served by the collector as \"ratelimiting\" service strategies in the sampling configuration, unless the sampling options have an explicit strategy for the service.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"integer"},
+										Format: "int32",
+									},
+								},
+							},
+						},
+					},
+					"defaultAntiAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultAntiAffinity makes the scheduler prefer spreading the collector replicas across nodes. It's only applied when no affinity has been set for the collector.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary runs a few collector replicas with a different image next to the stable ones, behind the same services. Only applied to the production and streaming strategies.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerCollectorCanarySpec"),
+						},
+					},
+					"metricsBackend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricsBackend is the backend the collector exposes its metrics with, rendered as the --metrics-backend flag. Possible values: prometheus, expvar and none. An explicit option takes precedence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metricsPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricsPort is the admin port serving the metrics and the health check, rendered as the --admin.http.host-port flag and exposed by the collector services. It must not collide with the other ports of the collector. An explicit option takes precedence.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"thriftHttpPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThriftHTTPPort is the port of the HTTP endpoint accepting spans in the Thrift format, rendered as the --collector.http-server.host-port flag and exposed by the collector services. The spans are accepted on the /api/traces path, which can't be changed. An explicit option takes precedence. It only applies to the production and streaming strategies: the all-in-one keeps listening on the default port.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"configFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigFile references the config map entry holding a configuration file for the collector, which is mounted and passed via the --config-file flag. An explicit option takes precedence.",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/jaegertracing/v1.FreeForm", "./pkg/apis/jaegertracing/v1.JaegerCollectorCanarySpec", "./pkg/apis/jaegertracing/v1.JaegerCollectorOTLPCORSSpec", "./pkg/apis/jaegertracing/v1.JaegerCollectorOTLPKeepaliveSpec", "./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec", "./pkg/apis/jaegertracing/v1.JaegerKafkaBrokersSource", "./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec", "./pkg/apis/jaegertracing/v1.Options", "k8s.io/api/autoscaling/v2beta2.MetricSpec", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Capabilities", "k8s.io/api/core/v1.ConfigMapKeySelector", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerCommonSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerCommonSpec defines the common elements used in multiple other spec structs",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"tolerations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the nodes the component's pods are scheduled on",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"securityContext": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
+						},
+					},
+					"serviceAccount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccount is the name of an existing service account to be used by the component. The operator doesn't create nor manage service accounts referenced here.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"logLevel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogLevel is the log level for the component. Possible values: debug, info, warn, error. The cron jobs don't support it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startupProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartupProbe is the startup probe for the component's main container. Liveness and readiness probes are suspended until it succeeds.",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capabilities are the Linux capabilities to add to or drop from the component's main container. Defaults to dropping all capabilities.",
+							Ref:         ref("k8s.io/api/core/v1.Capabilities"),
+						},
+					},
+					"allowPrivilegeEscalation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPrivilegeEscalation controls whether the component's main container can gain more privileges than its parent process. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName is the name of the RuntimeClass used to run the component's pods, such as a sandboxed runtime",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sidecars": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Sidecars are extra containers added to the component's pod, like log shippers. Their names must not collide with the containers managed by the operator.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"internalTracing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InternalTracing makes the component report the spans about its own operations to another Jaeger instance. Only the query and the all-in-one report such spans, and not when their tracingEnabled is false. Disabled by default.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec"),
+						},
+					},
+					"sidecarResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarResources are the resources for the sidecars injected by the operator, like the agent and the OAuth proxy. When no resources are set for a sidecar, it gets small requests.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec"),
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Env are extra environment variables for the component's main container, like HTTP_PROXY and NO_PROXY. They also reach the containers of the cron jobs. Variables set by the operator take precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"terminationMessagePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TerminationMessagePolicy indicates how the termination message of the component's main container is populated. Defaults to FallbackToLogsOnError, so that the last log lines are kept when the container crashes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullPolicy is the pull policy for the image of the component's main container, including the containers of the cron jobs. When not set, the Kubernetes default applies.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec", "./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Capabilities", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerDependenciesSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerDependenciesSpec defined options for running spark-dependencies.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"sparkMaster": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"successfulJobsHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"javaOpts": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"driverMemory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriverMemory is the heap size of the job's JVM, which hosts the Spark driver, such as \"2g\". It's rendered as -Xmx in the JAVA_OPTS, before the javaOpts, which take precedence.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"executorMemory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExecutorMemory is the memory of each Spark executor, such as \"2g\", used when the job runs against a Spark cluster. It's rendered as the spark.executor.memory property in the JAVA_OPTS.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parallelism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parallelism is the default number of partitions used by the job. It's rendered as the spark.default.parallelism property in the JAVA_OPTS.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"cassandraClientAuthEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"elasticsearchClientNodeOnly": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"elasticsearchNodesWanOnly": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"ttlSecondsAfterFinished": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"affinity": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.Affinity"),
+						},
+					},
+					"tolerations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the nodes the component's pods are scheduled on",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"securityContext": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
+						},
+					},
+					"serviceAccount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccount is the name of an existing service account to be used by the component. The operator doesn't create nor manage service accounts referenced here.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"datacenter": {
+					"logLevel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogLevel is the log level for the component. Possible values: debug, info, warn, error. The cron jobs don't support it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startupProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartupProbe is the startup probe for the component's main container. Liveness and readiness probes are suspended until it succeeds.",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capabilities are the Linux capabilities to add to or drop from the component's main container. Defaults to dropping all capabilities.",
+							Ref:         ref("k8s.io/api/core/v1.Capabilities"),
+						},
+					},
+					"allowPrivilegeEscalation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPrivilegeEscalation controls whether the component's main container can gain more privileges than its parent process. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName is the name of the RuntimeClass used to run the component's pods, such as a sandboxed runtime",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sidecars": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Sidecars are extra containers added to the component's pod, like log shippers. Their names must not collide with the containers managed by the operator.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"internalTracing": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Datacenter is a collection of racks in the cassandra topology. defaults to \"test\"",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "InternalTracing makes the component report the spans about its own operations to another Jaeger instance. Only the query and the all-in-one report such spans, and not when their tracingEnabled is false. Disabled by default.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec"),
 						},
 					},
-					"mode": {
+					"sidecarResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Mode controls the replication factor of your cassandra schema. Set it to \"prod\" (which is the default) to use the NetworkTopologyStrategy with a replication factor of 2, effectively meaning that at least 3 nodes are required in the cassandra cluster. When set to \"test\" the schema uses the SimpleStrategy with a replication factor of 1. You never want to do this in a production setup.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "SidecarResources are the resources for the sidecars injected by the operator, like the agent and the OAuth proxy. When no resources are set for a sidecar, it gets small requests.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec"),
 						},
 					},
-					"traceTTL": {
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "TraceTTL sets the TTL for your trace data",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Env are extra environment variables for the component's main container, like HTTP_PROXY and NO_PROXY. They also reach the containers of the cron jobs. Variables set by the operator take precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
 						},
 					},
-					"timeout": {
+					"terminationMessagePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout controls the Job deadline, it defaults to 1 day. specify it with a value which can be parsed by time.ParseDuration, e.g. 24h or 120m. If the job does not succeed within that duration it transitions into a permanent error state. See https://github.com/jaegertracing/jaeger-kubernetes/issues/32 and https://github.com/jaegertracing/jaeger-kubernetes/pull/125",
+							Description: "TerminationMessagePolicy indicates how the termination message of the component's main container is populated. Defaults to FallbackToLogsOnError, so that the last log lines are kept when the container crashes.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ttlSecondsAfterFinished": {
+					"imagePullPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "ImagePullPolicy is the pull policy for the image of the component's main container, including the containers of the cron jobs. When not set, the Kubernetes default applies.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec", "./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Capabilities", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_pkg_apis_jaegertracing_v1_JaegerCollectorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_jaegertracing_v1_JaegerEsBulkSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JaegerCollectorSpec defines the options to be used when deploying the collector",
+				Description: "JaegerEsBulkSpec defines how the spans are written to Elasticsearch in bulk. When set, the values take precedence over the matching \"es.bulk.*\" storage options.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"autoscale": {
+					"size": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Autoscale turns on/off the autoscale feature. By default, it's enabled if the Replicas field is not set.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Size is the number of bytes the bulk requests can take up before they're committed",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"minReplicas": {
+					"workers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MinReplicas sets a lower bound to the autoscaling feature.",
+							Description: "Workers is the number of workers committing the bulk requests to Elasticsearch",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"maxReplicas": {
+					"actions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxReplicas sets an upper bound to the autoscaling feature. When autoscaling is enabled and no value is provided, a default value is used.",
+							Description: "Actions is the number of requests that can be enqueued before they're committed",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"replicas": {
+					"flushInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Replicas represents the number of replicas to create for this service.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "FlushInterval is the interval after which the pending requests are committed regardless of the other thresholds, as a duration like \"200ms\". Set it to \"0s\" to disable it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerEsIndexCleanerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerEsIndexCleanerSpec holds the options related to es-index-cleaner",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"numberOfDays": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"successfulJobsHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
 					"image": {
@@ -593,9 +2221,24 @@ func schema_pkg_apis_jaegertracing_v1_JaegerCollectorSpec(ref common.ReferenceCa
 							Format: "",
 						},
 					},
-					"options": {
+					"ttlSecondsAfterFinished": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/jaegertracing/v1.Options"),
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"keepLatest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeepLatest is the number of most recent daily indices the cleaner never deletes, regardless of their age. Not applicable when the indices are managed via rollover.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of the secret holding the ES_USERNAME and ES_PASSWORD used by the cleaner, allowing it to run with an account that has delete privileges. Defaults to the storage's secret.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"volumes": {
@@ -687,6 +2330,21 @@ func schema_pkg_apis_jaegertracing_v1_JaegerCollectorSpec(ref common.ReferenceCa
 							},
 						},
 					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the nodes the component's pods are scheduled on",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 					"securityContext": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
@@ -694,18 +2352,102 @@ func schema_pkg_apis_jaegertracing_v1_JaegerCollectorSpec(ref common.ReferenceCa
 					},
 					"serviceAccount": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ServiceAccount is the name of an existing service account to be used by the component. The operator doesn't create nor manage service accounts referenced here.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"config": {
+					"logLevel": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/jaegertracing/v1.FreeForm"),
+							Description: "LogLevel is the log level for the component. Possible values: debug, info, warn, error. The cron jobs don't support it.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"serviceType": {
+					"startupProbe": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceType represents the type of Service to create. Valid values include: ClusterIP, NodePort, LoadBalancer, and ExternalName. The default, if omitted, is ClusterIP. See https://kubernetes.io/docs/concepts/services-networking/service/#publishing-services-service-types",
+							Description: "StartupProbe is the startup probe for the component's main container. Liveness and readiness probes are suspended until it succeeds.",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capabilities are the Linux capabilities to add to or drop from the component's main container. Defaults to dropping all capabilities.",
+							Ref:         ref("k8s.io/api/core/v1.Capabilities"),
+						},
+					},
+					"allowPrivilegeEscalation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPrivilegeEscalation controls whether the component's main container can gain more privileges than its parent process. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName is the name of the RuntimeClass used to run the component's pods, such as a sandboxed runtime",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sidecars": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Sidecars are extra containers added to the component's pod, like log shippers. Their names must not collide with the containers managed by the operator.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"internalTracing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InternalTracing makes the component report the spans about its own operations to another Jaeger instance. Only the query and the all-in-one report such spans, and not when their tracingEnabled is false. Disabled by default.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec"),
+						},
+					},
+					"sidecarResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarResources are the resources for the sidecars injected by the operator, like the agent and the OAuth proxy. When no resources are set for a sidecar, it gets small requests.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec"),
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Env are extra environment variables for the component's main container, like HTTP_PROXY and NO_PROXY. They also reach the containers of the cron jobs. Variables set by the operator take precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"terminationMessagePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TerminationMessagePolicy indicates how the termination message of the component's main container is populated. Defaults to FallbackToLogsOnError, so that the last log lines are kept when the container crashes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullPolicy is the pull policy for the image of the component's main container, including the containers of the cron jobs. When not set, the Kubernetes default applies.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -714,17 +2456,107 @@ func schema_pkg_apis_jaegertracing_v1_JaegerCollectorSpec(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"./pkg/apis/jaegertracing/v1.FreeForm", "./pkg/apis/jaegertracing/v1.Options", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+			"./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec", "./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Capabilities", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_pkg_apis_jaegertracing_v1_JaegerCommonSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_jaegertracing_v1_JaegerEsRolloverStepResourcesSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JaegerCommonSpec defines the common elements used in multiple other spec structs",
+				Description: "JaegerEsRolloverStepResourcesSpec defines the resources for each of the steps of the es-rollover",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"init": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Init applies to the job creating the indices and aliases",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"rollover": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollover applies to the cron job rolling the write alias over to a new index",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"lookback": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Lookback applies to the cron job removing the old indices from the read alias",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ResourceRequirements"},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerIngesterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerIngesterSpec defines the options to be used when deploying the ingester",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"autoscale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscale turns on/off the autoscale feature. By default, it's enabled if the Replicas field is not set.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas sets a lower bound to the autoscaling feature.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas sets an upper bound to the autoscaling feature. When autoscaling is enabled and no value is provided, a default value is used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"metrics": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics are the metrics the autoscaler uses to calculate the desired number of replicas, such as a custom Kafka lag metric alongside the CPU utilization. The highest number of replicas among all the metrics is used. When not provided, the autoscaler targets 90% of the CPU and memory utilization.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/autoscaling/v2beta2.MetricSpec"),
+									},
+								},
+							},
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas represents the number of replicas to create for this service.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/jaegertracing/v1.Options"),
+						},
+					},
 					"volumes": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -814,6 +2646,21 @@ func schema_pkg_apis_jaegertracing_v1_JaegerCommonSpec(ref common.ReferenceCallb
 							},
 						},
 					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the nodes the component's pods are scheduled on",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 					"securityContext": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
@@ -821,83 +2668,335 @@ func schema_pkg_apis_jaegertracing_v1_JaegerCommonSpec(ref common.ReferenceCallb
 					},
 					"serviceAccount": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ServiceAccount is the name of an existing service account to be used by the component. The operator doesn't create nor manage service accounts referenced here.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"logLevel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogLevel is the log level for the component. Possible values: debug, info, warn, error. The cron jobs don't support it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startupProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartupProbe is the startup probe for the component's main container. Liveness and readiness probes are suspended until it succeeds.",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capabilities are the Linux capabilities to add to or drop from the component's main container. Defaults to dropping all capabilities.",
+							Ref:         ref("k8s.io/api/core/v1.Capabilities"),
+						},
+					},
+					"allowPrivilegeEscalation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPrivilegeEscalation controls whether the component's main container can gain more privileges than its parent process. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName is the name of the RuntimeClass used to run the component's pods, such as a sandboxed runtime",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sidecars": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Sidecars are extra containers added to the component's pod, like log shippers. Their names must not collide with the containers managed by the operator.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"internalTracing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InternalTracing makes the component report the spans about its own operations to another Jaeger instance. Only the query and the all-in-one report such spans, and not when their tracingEnabled is false. Disabled by default.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec"),
+						},
+					},
+					"sidecarResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarResources are the resources for the sidecars injected by the operator, like the agent and the OAuth proxy. When no resources are set for a sidecar, it gets small requests.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec"),
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Env are extra environment variables for the component's main container, like HTTP_PROXY and NO_PROXY. They also reach the containers of the cron jobs. Variables set by the operator take precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"terminationMessagePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TerminationMessagePolicy indicates how the termination message of the component's main container is populated. Defaults to FallbackToLogsOnError, so that the last log lines are kept when the container crashes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullPolicy is the pull policy for the image of the component's main container, including the containers of the cron jobs. When not set, the Kubernetes default applies.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/jaegertracing/v1.FreeForm"),
+						},
+					},
+					"kafkaTopic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KafkaTopic is the Kafka topic the ingester consumes spans from. It has to match the topic the collector writes to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kafkaBrokersFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KafkaBrokersFrom references a config map or secret key holding the comma-separated list of Kafka brokers the ingester consumes spans from. It can't be combined with the \"kafka.consumer.brokers\" option.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerKafkaBrokersSource"),
+						},
+					},
+					"consumerGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsumerGroup is the Kafka consumer group the ingester belongs to. Instances sharing a Kafka cluster should use distinct groups.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scaleDown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDown controls how the autoscaler scales the ingester down. Defaults to removing at most one pod every five minutes, after a ten minute stabilization window, to avoid repeated Kafka consumer group rebalances. Only applied when the cluster supports the autoscaler's behavior field.",
+							Ref:         ref("k8s.io/api/autoscaling/v2beta2.HPAScalingRules"),
+						},
+					},
+					"deadlockInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadlockInterval is the interval after which the ingester is restarted when no messages are consumed from a partition, e.g. 5m. Defaults to the ingester's default, which disables the deadlock detector.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parallelism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parallelism is the number of messages the ingester processes in parallel.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"drainDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DrainDelay is how long a terminating ingester pod keeps running before it's asked to stop, as a duration like \"20s\". This gives the Kafka consumer group time to rebalance while the consumed spans are written to the storage. It's rendered as a preStop hook. Not set by default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"terminationGracePeriodSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TerminationGracePeriodSeconds is the time a terminating ingester pod gets, including the drain delay, before it's killed. When a drain delay is set, it defaults to the drain delay plus the Kubernetes default of 30 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"kafkaPartitions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KafkaPartitions is the number of partitions of the topic the ingester consumes from. Replicas beyond that number stay idle, so the autoscaler's maximum number of replicas defaults to it, and a warning is logged when the number of replicas exceeds it.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+			"./pkg/apis/jaegertracing/v1.FreeForm", "./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec", "./pkg/apis/jaegertracing/v1.JaegerKafkaBrokersSource", "./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec", "./pkg/apis/jaegertracing/v1.Options", "k8s.io/api/autoscaling/v2beta2.HPAScalingRules", "k8s.io/api/autoscaling/v2beta2.MetricSpec", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Capabilities", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_pkg_apis_jaegertracing_v1_JaegerDependenciesSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_jaegertracing_v1_JaegerIngressCollectorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JaegerDependenciesSpec defined options for running spark-dependencies.",
+				Description: "JaegerIngressCollectorSpec defines the options for exposing the collector endpoint of an all-in-one instance",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Enabled determines whether the collector is exposed. Defaults to false, exposing only the query.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"sparkMaster": {
+					"port": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Port is the collector's service port to expose. Defaults to 14268, the HTTP endpoint for Thrift spans.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"schedule": {
+					"hosts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Hosts are the hosts for the collector rules, which have to differ from the query hosts. Without hosts, only the /api/traces path is routed to the collector, which requires the query to have hosts or a base path",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"successfulJobsHistoryLimit": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerIngressOpenShiftSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerIngressOpenShiftSpec defines the OpenShift-specific options in the context of ingress connections, such as options for the OAuth Proxy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sar": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"image": {
+					"delegateUrls": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"javaOpts": {
+					"htpasswdFile": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"cassandraClientAuthEnabled": {
+					"skipLogout": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "SkipLogout tells the operator to not automatically add a \"Log Out\" menu option to the custom Jaeger configuration",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"elasticsearchClientNodeOnly": {
+					"skipRoute": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipRoute tells the operator to not create the routes for this instance, so that they can be managed by something else. The services are still created. When using the OAuth Proxy, the query route should be named after the instance, as that's the route the OAuth redirect reference points to.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerIngressSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerIngressSpec defines the options to be used when deploying the query ingress",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"elasticsearchNodesWanOnly": {
+					"security": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"ttlSecondsAfterFinished": {
+					"openshift": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Ref: ref("./pkg/apis/jaegertracing/v1.JaegerIngressOpenShiftSpec"),
+						},
+					},
+					"hosts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"tls": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/jaegertracing/v1.JaegerIngressTLSSpec"),
+									},
+								},
+							},
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deprecated in favor of the TLS property",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"volumes": {
@@ -989,141 +3088,161 @@ func schema_pkg_apis_jaegertracing_v1_JaegerDependenciesSpec(ref common.Referenc
 							},
 						},
 					},
-					"securityContext": {
+					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
+							Description: "NodeSelector restricts the nodes the component's pods are scheduled on",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"serviceAccount": {
+					"securityContext": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
-	}
-}
-
-func schema_pkg_apis_jaegertracing_v1_JaegerEsIndexCleanerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "JaegerEsIndexCleanerSpec holds the options related to es-index-cleaner",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enabled": {
+					"serviceAccount": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "ServiceAccount is the name of an existing service account to be used by the component. The operator doesn't create nor manage service accounts referenced here.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"numberOfDays": {
+					"logLevel": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "LogLevel is the log level for the component. Possible values: debug, info, warn, error. The cron jobs don't support it.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"schedule": {
+					"startupProbe": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "StartupProbe is the startup probe for the component's main container. Liveness and readiness probes are suspended until it succeeds.",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
 						},
 					},
-					"successfulJobsHistoryLimit": {
+					"capabilities": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Capabilities are the Linux capabilities to add to or drop from the component's main container. Defaults to dropping all capabilities.",
+							Ref:         ref("k8s.io/api/core/v1.Capabilities"),
 						},
 					},
-					"image": {
+					"allowPrivilegeEscalation": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "AllowPrivilegeEscalation controls whether the component's main container can gain more privileges than its parent process. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"ttlSecondsAfterFinished": {
+					"runtimeClassName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "RuntimeClassName is the name of the RuntimeClass used to run the component's pods, such as a sandboxed runtime",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"volumes": {
+					"sidecars": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Sidecars are extra containers added to the component's pod, like log shippers. Their names must not collide with the containers managed by the operator.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Volume"),
+										Ref: ref("k8s.io/api/core/v1.Container"),
 									},
 								},
 							},
 						},
 					},
-					"volumeMounts": {
+					"internalTracing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InternalTracing makes the component report the spans about its own operations to another Jaeger instance. Only the query and the all-in-one report such spans, and not when their tracingEnabled is false. Disabled by default.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec"),
+						},
+					},
+					"sidecarResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarResources are the resources for the sidecars injected by the operator, like the agent and the OAuth proxy. When no resources are set for a sidecar, it gets small requests.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec"),
+						},
+					},
+					"env": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Env are extra environment variables for the component's main container, like HTTP_PROXY and NO_PROXY. They also reach the containers of the cron jobs. Variables set by the operator take precedence.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.VolumeMount"),
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
 									},
 								},
 							},
 						},
 					},
-					"annotations": {
+					"terminationMessagePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "TerminationMessagePolicy indicates how the termination message of the component's main container is populated. Defaults to FallbackToLogsOnError, so that the last log lines are kept when the container crashes.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"imagePullPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "ImagePullPolicy is the pull policy for the image of the component's main container, including the containers of the cron jobs. When not set, the Kubernetes default applies.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"resources": {
+					"options": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
+							Ref: ref("./pkg/apis/jaegertracing/v1.Options"),
 						},
 					},
-					"affinity": {
+					"collector": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.Affinity"),
+							Description: "Collector exposes the all-in-one's collector, in addition to the query",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerIngressCollectorSpec"),
 						},
 					},
-					"tolerations": {
+					"zipkin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zipkin exposes the collector's Zipkin endpoint on its own hosts",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerIngressZipkinSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/jaegertracing/v1.JaegerIngressCollectorSpec", "./pkg/apis/jaegertracing/v1.JaegerIngressOpenShiftSpec", "./pkg/apis/jaegertracing/v1.JaegerIngressTLSSpec", "./pkg/apis/jaegertracing/v1.JaegerIngressZipkinSpec", "./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec", "./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec", "./pkg/apis/jaegertracing/v1.Options", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Capabilities", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerIngressTLSSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerIngressTLSSpec defines the TLS configuration to be used when deploying the query ingress",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hosts": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
@@ -1134,18 +3253,14 @@ func schema_pkg_apis_jaegertracing_v1_JaegerEsIndexCleanerSpec(ref common.Refere
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Toleration"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"securityContext": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
-						},
-					},
-					"serviceAccount": {
+					"secretName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
@@ -1154,110 +3269,33 @@ func schema_pkg_apis_jaegertracing_v1_JaegerEsIndexCleanerSpec(ref common.Refere
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_pkg_apis_jaegertracing_v1_JaegerIngesterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_jaegertracing_v1_JaegerIngressZipkinSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JaegerIngesterSpec defines the options to be used when deploying the ingester",
+				Description: "JaegerIngressZipkinSpec defines the options for exposing the Zipkin endpoint of the collector",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"autoscale": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Autoscale turns on/off the autoscale feature. By default, it's enabled if the Replicas field is not set.",
+							Description: "Enabled determines whether the Zipkin endpoint is exposed. Defaults to false.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"minReplicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MinReplicas sets a lower bound to the autoscaling feature.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"maxReplicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MaxReplicas sets an upper bound to the autoscaling feature. When autoscaling is enabled and no value is provided, a default value is used.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"replicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Replicas represents the number of replicas to create for this service.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"image": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"options": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/jaegertracing/v1.Options"),
-						},
-					},
-					"volumes": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
-						},
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Volume"),
-									},
-								},
-							},
-						},
-					},
-					"volumeMounts": {
+					"hosts": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Hosts are the hosts routed to the Zipkin endpoint, which have to differ from the query hosts",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.VolumeMount"),
-									},
-								},
-							},
-						},
-					},
-					"annotations": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
-					"labels": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Type:   []string{"string"},
@@ -1267,17 +3305,7 @@ func schema_pkg_apis_jaegertracing_v1_JaegerIngesterSpec(ref common.ReferenceCal
 							},
 						},
 					},
-					"resources": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
-						},
-					},
-					"affinity": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.Affinity"),
-						},
-					},
-					"tolerations": {
+					"tls": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
@@ -1288,106 +3316,105 @@ func schema_pkg_apis_jaegertracing_v1_JaegerIngesterSpec(ref common.ReferenceCal
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Toleration"),
+										Ref: ref("./pkg/apis/jaegertracing/v1.JaegerIngressTLSSpec"),
 									},
 								},
 							},
 						},
 					},
-					"securityContext": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/jaegertracing/v1.JaegerIngressTLSSpec"},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerInternalTracingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerInternalTracingSpec defines where the Jaeger components report the spans about themselves",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
+							Description: "Endpoint is the HTTP endpoint of the collector receiving the spans, like http://jaeger-collector.observability:14268/api/traces. It must not be the collector of the same instance.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"serviceAccount": {
+					"samplerType": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "SamplerType is the type of the sampler: const, probabilistic, ratelimiting or remote. Defaults to probabilistic.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"config": {
+					"samplerParam": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/jaegertracing/v1.FreeForm"),
+							Description: "SamplerParam is the parameter of the sampler, like the sampling rate for the probabilistic sampler. Defaults to 0.001 for the probabilistic sampler.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"endpoint"},
 			},
 		},
-		Dependencies: []string{
-			"./pkg/apis/jaegertracing/v1.FreeForm", "./pkg/apis/jaegertracing/v1.Options", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_pkg_apis_jaegertracing_v1_JaegerIngressOpenShiftSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_jaegertracing_v1_JaegerKafkaBrokersSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JaegerIngressOpenShiftSpec defines the OpenShift-specific options in the context of ingress connections, such as options for the OAuth Proxy",
+				Description: "JaegerKafkaBrokersSource references the key holding the Kafka brokers. Exactly one of the references has to be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"sar": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"delegateUrls": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"htpasswdFile": {
+					"configMapKeyRef": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ConfigMapKeyRef selects a key of a config map in the instance's namespace",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
 						},
 					},
-					"skipLogout": {
+					"secretKeyRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SkipLogout tells the operator to not automatically add a \"Log Out\" menu option to the custom Jaeger configuration",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "SecretKeyRef selects a key of a secret in the instance's namespace",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ConfigMapKeySelector", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_pkg_apis_jaegertracing_v1_JaegerIngressSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_jaegertracing_v1_JaegerNetworkPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JaegerIngressSpec defines the options to be used when deploying the query ingress",
+				Description: "JaegerNetworkPolicySpec defines the options for the network policies generated for this instance",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"security": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"openshift": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/jaegertracing/v1.JaegerIngressOpenShiftSpec"),
+							Description: "Enabled makes the operator create network policies allowing the traffic required by the Jaeger components, such as the spans reported to the collector and the connections to a self-provisioned Elasticsearch cluster. The egress of the components is restricted to the DNS, the pods of the instance, the storage backends and, for the OAuth Proxy, the API server.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"hosts": {
+					"queryIngressCIDRs": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "QueryIngressCIDRs are the IP blocks, in addition to the pods in the same namespace, allowed to reach the query UI",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -1398,28 +3425,43 @@ func schema_pkg_apis_jaegertracing_v1_JaegerIngressSpec(ref common.ReferenceCall
 							},
 						},
 					},
-					"tls": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+					"queryIngressNamespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryIngressNamespaceSelector selects the namespaces, such as the ingress controller's, whose pods are allowed to reach the query UI. On OpenShift, the router is always allowed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerQuerySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerQuerySpec defines the options to be used when deploying the query",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas represents the number of replicas to create for this service.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
+					},
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("./pkg/apis/jaegertracing/v1.JaegerIngressTLSSpec"),
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"secretName": {
+					"options": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Deprecated in favor of the TLS property",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref: ref("./pkg/apis/jaegertracing/v1.Options"),
 						},
 					},
 					"volumes": {
@@ -1511,6 +3553,21 @@ func schema_pkg_apis_jaegertracing_v1_JaegerIngressSpec(ref common.ReferenceCall
 							},
 						},
 					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the nodes the component's pods are scheduled on",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 					"securityContext": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
@@ -1518,196 +3575,286 @@ func schema_pkg_apis_jaegertracing_v1_JaegerIngressSpec(ref common.ReferenceCall
 					},
 					"serviceAccount": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ServiceAccount is the name of an existing service account to be used by the component. The operator doesn't create nor manage service accounts referenced here.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"options": {
+					"logLevel": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/jaegertracing/v1.Options"),
+							Description: "LogLevel is the log level for the component. Possible values: debug, info, warn, error. The cron jobs don't support it.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"./pkg/apis/jaegertracing/v1.JaegerIngressOpenShiftSpec", "./pkg/apis/jaegertracing/v1.JaegerIngressTLSSpec", "./pkg/apis/jaegertracing/v1.Options", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
-	}
-}
-
-func schema_pkg_apis_jaegertracing_v1_JaegerIngressTLSSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "JaegerIngressTLSSpec defines the TLS configuration to be used when deploying the query ingress",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"hosts": {
+					"startupProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartupProbe is the startup probe for the component's main container. Liveness and readiness probes are suspended until it succeeds.",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capabilities are the Linux capabilities to add to or drop from the component's main container. Defaults to dropping all capabilities.",
+							Ref:         ref("k8s.io/api/core/v1.Capabilities"),
+						},
+					},
+					"allowPrivilegeEscalation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPrivilegeEscalation controls whether the component's main container can gain more privileges than its parent process. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName is the name of the RuntimeClass used to run the component's pods, such as a sandboxed runtime",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sidecars": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Sidecars are extra containers added to the component's pod, like log shippers. Their names must not collide with the containers managed by the operator.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("k8s.io/api/core/v1.Container"),
 									},
 								},
 							},
 						},
 					},
-					"secretName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_jaegertracing_v1_JaegerQuerySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "JaegerQuerySpec defines the options to be used when deploying the query",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"replicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Replicas represents the number of replicas to create for this service.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"image": {
+					"internalTracing": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "InternalTracing makes the component report the spans about its own operations to another Jaeger instance. Only the query and the all-in-one report such spans, and not when their tracingEnabled is false. Disabled by default.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec"),
 						},
 					},
-					"options": {
+					"sidecarResources": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/jaegertracing/v1.Options"),
+							Description: "SidecarResources are the resources for the sidecars injected by the operator, like the agent and the OAuth proxy. When no resources are set for a sidecar, it gets small requests.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec"),
 						},
 					},
-					"volumes": {
+					"env": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Env are extra environment variables for the component's main container, like HTTP_PROXY and NO_PROXY. They also reach the containers of the cron jobs. Variables set by the operator take precedence.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Volume"),
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
 									},
 								},
 							},
 						},
 					},
-					"volumeMounts": {
+					"terminationMessagePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TerminationMessagePolicy indicates how the termination message of the component's main container is populated. Defaults to FallbackToLogsOnError, so that the last log lines are kept when the container crashes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullPolicy is the pull policy for the image of the component's main container, including the containers of the cron jobs. When not set, the Kubernetes default applies.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceType represents the type of Service to create. Valid values include: ClusterIP, NodePort, LoadBalancer, and ExternalName. The default, if omitted, is ClusterIP. See https://kubernetes.io/docs/concepts/services-networking/service/#publishing-services-service-types",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tracingEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TracingEnabled if set to false adds the JAEGER_DISABLED environment flag and removes the injected agent container from the query component to disable tracing requests to the query service. The default, if ommited, is true",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"maxClockSkewAdjustment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxClockSkewAdjustment is the maximum delta by which the query adjusts the timestamps of spans affected by clock skew, as a duration like \"500ms\". Setting it to \"0s\" disables the adjustment. Rendered as the --query.max-clock-skew-adjustment flag, unless the options have an explicit value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"storageTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageTimeout is the timeout of the query's requests to the storage, as a duration like \"30s\". Rendered as the --es.timeout or --cassandra.timeout flag, unless the options have an explicit value. Only valid with the Elasticsearch and Cassandra storages.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxSpanAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSpanAge is how far back in time the query looks for spans in Elasticsearch, as a duration like \"168h\". Rendered as the --es.max-span-age flag, unless the options have an explicit value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zones": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Zones pins the query pods to the nodes in the given availability zones, as per their topology.kubernetes.io/zone label. It's only applied when no affinity has been set for the query.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.VolumeMount"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"annotations": {
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage overrides parts of the storage configuration for the query only, for instance to keep reading from the previous Elasticsearch cluster while the collector already writes to a new one. It doesn't apply to the all-in-one strategy.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerQueryStorageSpec"),
+						},
+					},
+					"sessionAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SessionAffinity makes the query service send all the requests of a client to the same pod when set to \"ClientIP\". Defaults to \"None\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sessionAffinityTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SessionAffinityTimeoutSeconds is how long a client sticks to the same pod. Only applicable when the session affinity is \"ClientIP\". Defaults to 10800, as per Kubernetes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec", "./pkg/apis/jaegertracing/v1.JaegerQueryStorageSpec", "./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec", "./pkg/apis/jaegertracing/v1.Options", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Capabilities", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerQueryStorageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerQueryStorageSpec defines the storage configuration overrides for the query",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the storage type the query reads from. When set, it has to match the type of the main storage.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "SecretName replaces the storage secret for the query",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"options": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Options are applied on top of the storage options for the query, replacing the entries with the same key, such as \"es.server-urls\"",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.Options"),
 						},
 					},
-					"resources": {
+					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
+							Description: "TLS sets the TLS material the query uses to connect to the Elasticsearch or Cassandra storage, distinct from the one used by the collector. It replaces the TLS settings from the storage options: only the query options and the options above take precedence over it",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerQueryStorageTLSSpec"),
 						},
 					},
-					"affinity": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/jaegertracing/v1.JaegerQueryStorageTLSSpec", "./pkg/apis/jaegertracing/v1.Options"},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerQueryStorageTLSSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerQueryStorageTLSSpec defines the TLS material the query uses to connect to the storage",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"caSecretName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.Affinity"),
+							Description: "CASecretName is the name of a secret in the instance's namespace holding, under the ca.crt entry, the CA used to verify the storage's certificate",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tolerations": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
-						},
+					"secretName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Toleration"),
-									},
-								},
-							},
+							Description: "SecretName is the name of a secret in the instance's namespace holding the client certificate and key the query presents to the storage, under the tls.crt and tls.key entries",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"securityContext": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_jaegertracing_v1_JaegerSamplingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerSamplingSpec defines the options to be used to configure the UI",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"options": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
+							Ref: ref("./pkg/apis/jaegertracing/v1.FreeForm"),
 						},
 					},
-					"serviceAccount": {
+					"secretName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "SecretName is the name of a secret holding the sampling strategies, in the JSON format. When set, it takes precedence over the options.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"serviceType": {
+					"secretKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceType represents the type of Service to create. Valid values include: ClusterIP, NodePort, LoadBalancer, and ExternalName. The default, if omitted, is ClusterIP. See https://kubernetes.io/docs/concepts/services-networking/service/#publishing-services-service-types",
+							Description: "SecretKey is the key within the secret holding the sampling strategies. Defaults to \"sampling\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tracingEnabled": {
+					"reloadInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TracingEnabled if set to false adds the JAEGER_DISABLED environment flag and removes the injected agent container from the query component to disable tracing requests to the query service. The default, if ommited, is true",
-							Type:        []string{"boolean"},
+							Description: "ReloadInterval is how often the collector reloads the sampling strategies file, like \"30s\", so that changes to the strategies are picked up without a restart. Rendered as the --sampling.strategies-reload-interval flag. Reloading is disabled by default.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
@@ -1715,27 +3862,40 @@ func schema_pkg_apis_jaegertracing_v1_JaegerQuerySpec(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"./pkg/apis/jaegertracing/v1.Options", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+			"./pkg/apis/jaegertracing/v1.FreeForm"},
 	}
 }
 
-func schema_pkg_apis_jaegertracing_v1_JaegerSamplingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_jaegertracing_v1_JaegerSidecarResourcesSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JaegerSamplingSpec defines the options to be used to configure the UI",
+				Description: "JaegerSidecarResourcesSpec defines the resources for the sidecars injected by the operator. The resources for the sidecar's type take precedence, followed by the resources set on the agent and ingress specs, the default ones and the top-level resources.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"options": {
+					"default": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/jaegertracing/v1.FreeForm"),
+							Description: "Default applies to all the sidecars injected by the operator",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"agent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Agent applies to the injected agent sidecars, but not to the agent's DaemonSet",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"oauthProxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OAuthProxy applies to the OAuth proxy sidecar of the query",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./pkg/apis/jaegertracing/v1.FreeForm"},
+			"k8s.io/api/core/v1.ResourceRequirements"},
 	}
 }
 
@@ -1797,6 +3957,38 @@ func schema_pkg_apis_jaegertracing_v1_JaegerSpec(ref common.ReferenceCallback) c
 							Ref: ref("./pkg/apis/jaegertracing/v1.JaegerIngressSpec"),
 						},
 					},
+					"upgrade": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/jaegertracing/v1.JaegerUpgradeSpec"),
+						},
+					},
+					"networkPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/jaegertracing/v1.JaegerNetworkPolicySpec"),
+						},
+					},
+					"serviceAccountAnnotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountAnnotations are added to the service accounts created by the operator for this instance, such as the \"eks.amazonaws.com/role-arn\" annotation used by IAM roles for service accounts",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"serviceAccountPerComponent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountPerComponent makes the operator create a dedicated service account for each component, instead of a single one shared by all of them. Components with an explicit service account keep using it.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"volumes": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -1886,6 +4078,21 @@ func schema_pkg_apis_jaegertracing_v1_JaegerSpec(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the nodes the component's pods are scheduled on",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 					"securityContext": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("k8s.io/api/core/v1.PodSecurityContext"),
@@ -1893,15 +4100,111 @@ func schema_pkg_apis_jaegertracing_v1_JaegerSpec(ref common.ReferenceCallback) c
 					},
 					"serviceAccount": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ServiceAccount is the name of an existing service account to be used by the component. The operator doesn't create nor manage service accounts referenced here.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"logLevel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogLevel is the log level for the component. Possible values: debug, info, warn, error. The cron jobs don't support it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startupProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartupProbe is the startup probe for the component's main container. Liveness and readiness probes are suspended until it succeeds.",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capabilities are the Linux capabilities to add to or drop from the component's main container. Defaults to dropping all capabilities.",
+							Ref:         ref("k8s.io/api/core/v1.Capabilities"),
+						},
+					},
+					"allowPrivilegeEscalation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowPrivilegeEscalation controls whether the component's main container can gain more privileges than its parent process. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runtimeClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeClassName is the name of the RuntimeClass used to run the component's pods, such as a sandboxed runtime",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sidecars": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Sidecars are extra containers added to the component's pod, like log shippers. Their names must not collide with the containers managed by the operator.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"internalTracing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InternalTracing makes the component report the spans about its own operations to another Jaeger instance. Only the query and the all-in-one report such spans, and not when their tracingEnabled is false. Disabled by default.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec"),
+						},
+					},
+					"sidecarResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarResources are the resources for the sidecars injected by the operator, like the agent and the OAuth proxy. When no resources are set for a sidecar, it gets small requests.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec"),
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Env are extra environment variables for the component's main container, like HTTP_PROXY and NO_PROXY. They also reach the containers of the cron jobs. Variables set by the operator take precedence.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"terminationMessagePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TerminationMessagePolicy indicates how the termination message of the component's main container is populated. Defaults to FallbackToLogsOnError, so that the last log lines are kept when the container crashes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullPolicy is the pull policy for the image of the component's main container, including the containers of the cron jobs. When not set, the Kubernetes default applies.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./pkg/apis/jaegertracing/v1.JaegerAgentSpec", "./pkg/apis/jaegertracing/v1.JaegerAllInOneSpec", "./pkg/apis/jaegertracing/v1.JaegerCollectorSpec", "./pkg/apis/jaegertracing/v1.JaegerIngesterSpec", "./pkg/apis/jaegertracing/v1.JaegerIngressSpec", "./pkg/apis/jaegertracing/v1.JaegerQuerySpec", "./pkg/apis/jaegertracing/v1.JaegerSamplingSpec", "./pkg/apis/jaegertracing/v1.JaegerStorageSpec", "./pkg/apis/jaegertracing/v1.JaegerUISpec", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+			"./pkg/apis/jaegertracing/v1.JaegerAgentSpec", "./pkg/apis/jaegertracing/v1.JaegerAllInOneSpec", "./pkg/apis/jaegertracing/v1.JaegerCollectorSpec", "./pkg/apis/jaegertracing/v1.JaegerIngesterSpec", "./pkg/apis/jaegertracing/v1.JaegerIngressSpec", "./pkg/apis/jaegertracing/v1.JaegerInternalTracingSpec", "./pkg/apis/jaegertracing/v1.JaegerNetworkPolicySpec", "./pkg/apis/jaegertracing/v1.JaegerQuerySpec", "./pkg/apis/jaegertracing/v1.JaegerSamplingSpec", "./pkg/apis/jaegertracing/v1.JaegerSidecarResourcesSpec", "./pkg/apis/jaegertracing/v1.JaegerStorageSpec", "./pkg/apis/jaegertracing/v1.JaegerUISpec", "./pkg/apis/jaegertracing/v1.JaegerUpgradeSpec", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Capabilities", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -1955,6 +4258,49 @@ func schema_pkg_apis_jaegertracing_v1_JaegerStorageSpec(ref common.ReferenceCall
 							Ref: ref("./pkg/apis/jaegertracing/v1.Options"),
 						},
 					},
+					"cassandra": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/jaegertracing/v1.JaegerCassandraSpec"),
+						},
+					},
+					"createIndexTemplates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreateIndexTemplates controls whether the Jaeger components create the Elasticsearch index templates on startup. Set it to false when the templates are managed externally. When set, the value takes precedence over the \"es.create-index-templates\" storage option.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"esNumShards": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EsNumShards is the number of primary shards of the Elasticsearch indices created by Jaeger, used by the index templates and by the rollover initialization. When set, the value takes precedence over the \"es.num-shards\" storage option.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"esNumReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EsNumReplicas is the number of replica shards of the Elasticsearch indices created by Jaeger. When set, the value takes precedence over the \"es.num-replicas\" storage option.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"esBulk": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EsBulk tunes how the collector and the ingester write the spans to Elasticsearch. Only valid with the Elasticsearch storage.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerEsBulkSpec"),
+						},
+					},
+					"archiveStorage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ArchiveStorage enables the archive storage, used by the query to archive traces and to read the archived ones. Only valid with the Elasticsearch and Cassandra storages.",
+							Ref:         ref("./pkg/apis/jaegertracing/v1.JaegerArchiveStorageSpec"),
+						},
+					},
+					"awsWebIdentity": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/jaegertracing/v1.JaegerAWSWebIdentitySpec"),
+						},
+					},
 					"cassandraCreateSchema": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("./pkg/apis/jaegertracing/v1.JaegerCassandraCreateSchemaSpec"),
@@ -1984,7 +4330,7 @@ func schema_pkg_apis_jaegertracing_v1_JaegerStorageSpec(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"./pkg/apis/jaegertracing/v1.ElasticsearchSpec", "./pkg/apis/jaegertracing/v1.JaegerCassandraCreateSchemaSpec", "./pkg/apis/jaegertracing/v1.JaegerDependenciesSpec", "./pkg/apis/jaegertracing/v1.JaegerEsIndexCleanerSpec", "./pkg/apis/jaegertracing/v1.JaegerEsRolloverSpec", "./pkg/apis/jaegertracing/v1.Options"},
+			"./pkg/apis/jaegertracing/v1.ElasticsearchSpec", "./pkg/apis/jaegertracing/v1.JaegerAWSWebIdentitySpec", "./pkg/apis/jaegertracing/v1.JaegerArchiveStorageSpec", "./pkg/apis/jaegertracing/v1.JaegerCassandraCreateSchemaSpec", "./pkg/apis/jaegertracing/v1.JaegerCassandraSpec", "./pkg/apis/jaegertracing/v1.JaegerDependenciesSpec", "./pkg/apis/jaegertracing/v1.JaegerEsBulkSpec", "./pkg/apis/jaegertracing/v1.JaegerEsIndexCleanerSpec", "./pkg/apis/jaegertracing/v1.JaegerEsRolloverSpec", "./pkg/apis/jaegertracing/v1.Options"},
 	}
 }
 
@@ -2000,6 +4346,13 @@ func schema_pkg_apis_jaegertracing_v1_JaegerUISpec(ref common.ReferenceCallback)
 							Ref: ref("./pkg/apis/jaegertracing/v1.FreeForm"),
 						},
 					},
+					"dependenciesMenuEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependenciesMenuEnabled shows or hides the dependencies (system architecture) menu. It's set as \"dependencies.menuEnabled\" in the UI configuration, taking precedence over the options. By default, the menu is hidden when the dependencies job isn't enabled.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2007,3 +4360,24 @@ func schema_pkg_apis_jaegertracing_v1_JaegerUISpec(ref common.ReferenceCallback)
 			"./pkg/apis/jaegertracing/v1.FreeForm"},
 	}
 }
+
+func schema_pkg_apis_jaegertracing_v1_JaegerUpgradeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JaegerUpgradeSpec defines the options to be used when upgrading the instance to a new Jaeger version",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"preUpgradeJob": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreUpgradeJob is a Job to run before upgrading the instance to a new version, such as a backup. The upgrade is blocked until this Job succeeds.",
+							Ref:         ref("k8s.io/api/batch/v1.JobSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/batch/v1.JobSpec"},
+	}
+}
@@ -13,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -101,6 +102,7 @@ func (b *Background) autoDetectCapabilities() {
 			// the platform won't change during the execution of the operator, need to run it only once
 			b.detectPlatform(ctx, apiList)
 			b.detectIngressAPI()
+			b.detectHPABehavior()
 
 		})
 
@@ -154,6 +156,19 @@ func (b *Background) detectIngressAPI() {
 	}
 }
 
+func (b *Background) detectHPABehavior() {
+	// the 'behavior' field of the autoscaling/v2beta2 HPA is available from Kubernetes 1.18 onwards
+	available := false
+	if info, err := b.dcl.ServerVersion(); err == nil {
+		if v, err := version.ParseGeneric(info.GitVersion); err == nil {
+			available = v.AtLeast(version.MustParseGeneric("1.18.0"))
+		}
+	}
+
+	viper.Set("hpa-behavior-available", available)
+	log.WithField("hpa-behavior-available", available).Info("Auto-detected whether the autoscaler supports the behavior field")
+}
+
 func (b *Background) detectElasticsearch(ctx context.Context, apiList *metav1.APIGroupList) {
 	// detect whether the Elasticsearch operator is available
 	if b.retryDetectEs {
@@ -227,6 +242,8 @@ func (b *Background) detectClusterRoles(ctx context.Context) {
 func (b *Background) cleanDeployments(ctx context.Context) {
 	log.Trace("detecting orphaned deployments.")
 
+	// the instances aren't filtered by the instance selector: a sidecar pointing to an instance reconciled by another
+	// operator isn't orphaned, and removing it would undo the other operator's injection
 	instancesMap := make(map[string]*v1.Jaeger)
 	deployments := &appsv1.DeploymentList{}
 	deployOpts := []client.ListOption{
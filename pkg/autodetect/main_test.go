@@ -214,6 +214,39 @@ func TestExplicitPlatform(t *testing.T) {
 	assert.Equal(t, v1.FlagPlatformOpenShift, viper.GetString("platform"))
 }
 
+func TestAutoDetectHPABehavior(t *testing.T) {
+	for _, tt := range []struct {
+		gitVersion string
+		err        error
+		expected   bool
+	}{
+		{gitVersion: "v1.17.5", expected: false},
+		{gitVersion: "v1.18.2", expected: true},
+		{gitVersion: "v1.19.0-gke.1", expected: true},
+		{gitVersion: "", expected: false},
+		{err: fmt.Errorf("faked error"), expected: false},
+	} {
+		t.Run(tt.gitVersion, func(t *testing.T) {
+			// prepare
+			defer viper.Reset()
+
+			dcl := &fakeDiscoveryClient{}
+			cl := fake.NewFakeClient()
+			b := WithClients(cl, dcl, cl)
+
+			dcl.ServerVersionFunc = func() (*version.Info, error) {
+				return &version.Info{GitVersion: tt.gitVersion}, tt.err
+			}
+
+			// test
+			b.autoDetectCapabilities()
+
+			// verify
+			assert.Equal(t, tt.expected, viper.GetBool("hpa-behavior-available"))
+		})
+	}
+}
+
 func TestAutoDetectEsProvisionNoEsOperator(t *testing.T) {
 	// prepare
 	viper.Set("es-provision", v1.FlagProvisionElasticsearchAuto)
@@ -568,7 +601,8 @@ func (f *fakeClient) Create(ctx context.Context, obj runtime.Object, opts ...cli
 
 type fakeDiscoveryClient struct {
 	discovery.DiscoveryInterface
-	ServerGroupsFunc func() (apiGroupList *metav1.APIGroupList, err error)
+	ServerGroupsFunc  func() (apiGroupList *metav1.APIGroupList, err error)
+	ServerVersionFunc func() (*version.Info, error)
 }
 
 func (d *fakeDiscoveryClient) ServerGroups() (apiGroupList *metav1.APIGroupList, err error) {
@@ -595,7 +629,10 @@ func (d *fakeDiscoveryClient) ServerPreferredNamespacedResources() ([]*metav1.AP
 }
 
 func (d *fakeDiscoveryClient) ServerVersion() (*version.Info, error) {
-	return &version.Info{}, nil
+	if d.ServerVersionFunc == nil {
+		return &version.Info{}, nil
+	}
+	return d.ServerVersionFunc()
 }
 
 func (d *fakeDiscoveryClient) OpenAPISchema() (*openapi_v2.Document, error) {
@@ -27,21 +27,12 @@ func Get(jaeger *v1.Jaeger) []rbac.ClusterRoleBinding {
 
 func oauthProxyAuthDelegator(jaeger *v1.Jaeger) rbac.ClusterRoleBinding {
 	name := util.DNSName(fmt.Sprintf("%s-%s-oauth-proxy-auth-delegator", jaeger.Namespace, jaeger.Name))
-	trueVar := true
 
 	return rbac.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   name,
-			Labels: util.Labels(name, "service-account", *jaeger),
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: jaeger.APIVersion,
-					Kind:       jaeger.Kind,
-					Name:       jaeger.Name,
-					UID:        jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
+			Name:            name,
+			Labels:          util.Labels(name, "service-account", *jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 		Subjects: []rbac.Subject{{
 			Kind:      "ServiceAccount",
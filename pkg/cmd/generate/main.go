@@ -22,11 +22,12 @@ import (
 // NewGenerateCommand starts the Jaeger Operator
 func NewGenerateCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "generate",
-		Short: "(experimental) Generate YAML manifests from Jaeger CRD",
-		Long: `Generate YAML manifests from Jaeger CRD.
+		Use:     "generate",
+		Aliases: []string{"render"},
+		Short:   "(experimental) Generate YAML manifests from Jaeger CRD",
+		Long: `Generate YAML manifests from Jaeger CRD, without connecting to a cluster.
 
-Defaults to reading Jaeger CRD from standard input and writing the manifest file to standard output, override with --cr <filename> and --output <filename>.`,
+Defaults to reading Jaeger CRD from standard input and writing the manifest file to standard output, override with --cr <filename> (or -f <filename>) and --output <filename>.`,
 		PreRun: func(cmd *cobra.Command, args []string) {
 			viper.BindPFlags(cmd.Flags())
 		},
@@ -34,7 +35,7 @@ Defaults to reading Jaeger CRD from standard input and writing the manifest file
 	}
 
 	start.AddFlags(cmd)
-	cmd.Flags().String("cr", "/dev/stdin", "Input Jaeger CRD")
+	cmd.Flags().StringP("cr", "f", "/dev/stdin", "Input Jaeger CRD")
 	cmd.Flags().String("output", "/dev/stdout", "Where to print the generated YAML documents")
 
 	return cmd
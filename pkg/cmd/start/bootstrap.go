@@ -6,6 +6,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"time"
 
 	osimagev1 "github.com/openshift/api/image/v1"
 	"github.com/operator-framework/operator-sdk/pkg/k8sutil"
@@ -20,6 +21,7 @@ import (
 	"go.opentelemetry.io/otel/global"
 	"google.golang.org/grpc/codes"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/rest"
@@ -63,8 +65,35 @@ func bootstrap(ctx context.Context) manager.Manager {
 		"jaeger":          version.Get().Jaeger,
 	}).Info("Versions")
 
-	if err := leader.Become(ctx, "jaeger-operator-lock"); err != nil {
-		log.Fatal(err)
+	if err := validateLeaderElection(
+		viper.GetString("leader-election"),
+		namespace,
+		viper.GetDuration("leader-election-lease-duration"),
+		viper.GetDuration("leader-election-renew-deadline"),
+		viper.GetDuration("leader-election-retry-period"),
+	); err != nil {
+		span.SetStatus(codes.InvalidArgument)
+		span.SetAttribute(key.String("error", err.Error()))
+		log.WithError(err).Fatal("invalid leader election settings")
+	}
+
+	if err := validateSyncPeriod(viper.GetDuration("sync-period")); err != nil {
+		span.SetStatus(codes.InvalidArgument)
+		span.SetAttribute(key.String("error", err.Error()))
+		log.WithError(err).Fatal("invalid sync period")
+	}
+
+	if err := validateConflictPolicy(viper.GetString(v1.ConfigConflictPolicy)); err != nil {
+		span.SetStatus(codes.InvalidArgument)
+		span.SetAttribute(key.String("error", err.Error()))
+		log.WithError(err).Fatal("invalid conflict policy")
+	}
+
+	// with the lease-based leader election, the manager acquires the lease before starting the controllers
+	if !strings.EqualFold(viper.GetString("leader-election"), leaderElectionLease) {
+		if err := leader.Become(ctx, "jaeger-operator-lock"); err != nil {
+			log.Fatal(err)
+		}
 	}
 
 	cfg, err := config.GetConfig()
@@ -82,9 +111,24 @@ func bootstrap(ctx context.Context) manager.Manager {
 		log.WithError(err).Fatal("failed to get watch namespace")
 	}
 
+	watchNamespace, err = normalizeWatchNamespace(watchNamespace)
+	if err != nil {
+		span.SetStatus(codes.InvalidArgument)
+		span.SetAttribute(key.String("error", err.Error()))
+		log.WithError(err).Fatal("invalid watch namespace")
+	}
+
+	if _, err := labels.Parse(viper.GetString(v1.ConfigInstanceSelector)); err != nil {
+		span.SetStatus(codes.InvalidArgument)
+		span.SetAttribute(key.String("error", err.Error()))
+		log.WithError(err).Fatal("invalid instance selector")
+	}
+
 	setOperatorScope(ctx, watchNamespace)
 
-	mgr := createManager(ctx, cfg)
+	waitForCRD(ctx, cfg)
+
+	mgr := createManager(ctx, cfg, namespace)
 
 	detectNamespacePermissions(ctx, mgr)
 	performUpgrades(ctx, mgr)
@@ -199,6 +243,66 @@ func setOperatorScope(ctx context.Context, namespace string) {
 	}
 }
 
+// normalizeWatchNamespace validates the comma-separated list of namespaces to watch, removing the surrounding spaces
+// from each entry. An empty entry is rejected, as it would otherwise be interpreted as "all namespaces".
+func normalizeWatchNamespace(watchNamespace string) (string, error) {
+	if watchNamespace == v1.WatchAllNamespaces {
+		return watchNamespace, nil
+	}
+
+	namespaces := strings.Split(watchNamespace, ",")
+	for i, ns := range namespaces {
+		namespaces[i] = strings.TrimSpace(ns)
+		if len(namespaces[i]) == 0 {
+			return "", fmt.Errorf("the list of namespaces to watch contains an empty entry: %q", watchNamespace)
+		}
+	}
+
+	return strings.Join(namespaces, ","), nil
+}
+
+// validateLeaderElection checks the leader election mode and that the lease timings allow the leader
+// to renew its lease before it expires, retrying at least once. The lease is stored in the operator's namespace,
+// which therefore has to be known
+func validateLeaderElection(mode, operatorNamespace string, leaseDuration, renewDeadline, retryPeriod time.Duration) error {
+	if !strings.EqualFold(mode, leaderElectionForLife) && !strings.EqualFold(mode, leaderElectionLease) {
+		return fmt.Errorf("unknown leader election mode %q, expected '%s' or '%s'", mode, leaderElectionForLife, leaderElectionLease)
+	}
+	if strings.EqualFold(mode, leaderElectionLease) && len(operatorNamespace) == 0 {
+		return fmt.Errorf("the 'lease' leader election requires the operator's namespace, set the POD_NAMESPACE env var")
+	}
+
+	if retryPeriod <= 0 {
+		return fmt.Errorf("the leader election retry period must be positive, got %v", retryPeriod)
+	}
+	if renewDeadline <= retryPeriod {
+		return fmt.Errorf("the leader election renew deadline (%v) must be greater than the retry period (%v)", renewDeadline, retryPeriod)
+	}
+	if leaseDuration <= renewDeadline {
+		return fmt.Errorf("the leader election lease duration (%v) must be greater than the renew deadline (%v)", leaseDuration, renewDeadline)
+	}
+
+	return nil
+}
+
+// validateSyncPeriod makes sure the objects aren't resynced so often that the API server gets overloaded
+func validateSyncPeriod(syncPeriod time.Duration) error {
+	if syncPeriod < minSyncPeriod {
+		return fmt.Errorf("the sync period has to be at least %v, got %v", minSyncPeriod, syncPeriod)
+	}
+	return nil
+}
+
+// validateConflictPolicy makes sure the policy for conflicting updates is one the update logic knows about,
+// as an unknown one would otherwise silently behave like 'fail'
+func validateConflictPolicy(policy string) error {
+	switch strings.ToLower(policy) {
+	case v1.FlagConflictPolicyFail, v1.FlagConflictPolicyForce, v1.FlagConflictPolicySkip:
+		return nil
+	}
+	return fmt.Errorf("unknown conflict policy %q, expected '%s', '%s' or '%s'", policy, v1.FlagConflictPolicyFail, v1.FlagConflictPolicyForce, v1.FlagConflictPolicySkip)
+}
+
 func setLogLevel(ctx context.Context) {
 	tracer := global.TraceProvider().GetTracer(v1.BootstrapTracer)
 	ctx, span := tracer.Start(ctx, "setLogLevel")
@@ -260,15 +364,17 @@ func buildJaegerExporter(ctx context.Context) {
 	}
 }
 
-func createManager(ctx context.Context, cfg *rest.Config) manager.Manager {
+func createManager(ctx context.Context, cfg *rest.Config, operatorNamespace string) manager.Manager {
 	tracer := global.TraceProvider().GetTracer(v1.BootstrapTracer)
 	ctx, span := tracer.Start(ctx, "createManager")
 	defer span.End()
 
 	namespace := viper.GetString(v1.ConfigWatchNamespace)
+	syncPeriod := viper.GetDuration("sync-period")
 	options := manager.Options{
 		Namespace:          namespace,
 		MetricsBindAddress: fmt.Sprintf("%s:%d", viper.GetString("metrics-host"), viper.GetInt32("metrics-port")),
+		SyncPeriod:         &syncPeriod,
 	}
 
 	// Add support for MultiNamespace set in WATCH_NAMESPACE (e.g ns1,ns2)
@@ -280,6 +386,20 @@ func createManager(ctx context.Context, cfg *rest.Config) manager.Manager {
 		options.NewCache = cache.MultiNamespacedCacheBuilder(strings.Split(namespace, ","))
 	}
 
+	// the operator's namespace was validated during the bootstrap, along with the other leader election settings
+	if strings.EqualFold(viper.GetString("leader-election"), leaderElectionLease) {
+		leaseDuration := viper.GetDuration("leader-election-lease-duration")
+		renewDeadline := viper.GetDuration("leader-election-renew-deadline")
+		retryPeriod := viper.GetDuration("leader-election-retry-period")
+
+		options.LeaderElection = true
+		options.LeaderElectionID = "jaeger-operator-lease"
+		options.LeaderElectionNamespace = operatorNamespace
+		options.LeaseDuration = &leaseDuration
+		options.RenewDeadline = &renewDeadline
+		options.RetryPeriod = &retryPeriod
+	}
+
 	// Create a new manager to provide shared dependencies and start components
 	mgr, err := manager.New(cfg, options)
 	if err != nil {
@@ -0,0 +1,73 @@
+package start
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeWatchNamespace(t *testing.T) {
+	for _, tt := range []struct {
+		watchNamespace string
+		expected       string
+	}{
+		{watchNamespace: "", expected: ""},
+		{watchNamespace: "observability", expected: "observability"},
+		{watchNamespace: "tenant1,tenant2,tenant3", expected: "tenant1,tenant2,tenant3"},
+		{watchNamespace: "tenant1, tenant2 ,tenant3", expected: "tenant1,tenant2,tenant3"},
+	} {
+		normalized, err := normalizeWatchNamespace(tt.watchNamespace)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, normalized)
+	}
+}
+
+func TestNormalizeWatchNamespaceRejectsEmptyEntries(t *testing.T) {
+	for _, watchNamespace := range []string{",", "tenant1,", ",tenant1", "tenant1,,tenant2", "tenant1, ,tenant2", " "} {
+		_, err := normalizeWatchNamespace(watchNamespace)
+		assert.Error(t, err, watchNamespace)
+	}
+}
+
+func TestValidateLeaderElection(t *testing.T) {
+	assert.NoError(t, validateLeaderElection("for-life", "observability", 15*time.Second, 10*time.Second, 2*time.Second))
+	assert.NoError(t, validateLeaderElection("lease", "observability", 15*time.Second, 10*time.Second, 2*time.Second))
+	assert.NoError(t, validateLeaderElection("Lease", "observability", 4*time.Second, 3*time.Second, time.Second))
+	assert.Error(t, validateLeaderElection("unknown", "observability", 15*time.Second, 10*time.Second, 2*time.Second))
+}
+
+func TestValidateLeaderElectionRequiresNamespaceForLease(t *testing.T) {
+	assert.NoError(t, validateLeaderElection("for-life", "", 15*time.Second, 10*time.Second, 2*time.Second))
+	assert.Error(t, validateLeaderElection("lease", "", 15*time.Second, 10*time.Second, 2*time.Second))
+}
+
+func TestValidateLeaderElectionTimingsOrdering(t *testing.T) {
+	for _, tt := range []struct {
+		lease, renew, retry time.Duration
+	}{
+		{lease: 15 * time.Second, renew: 10 * time.Second, retry: 0},
+		{lease: 15 * time.Second, renew: 10 * time.Second, retry: 10 * time.Second},
+		{lease: 15 * time.Second, renew: 10 * time.Second, retry: 12 * time.Second},
+		{lease: 10 * time.Second, renew: 10 * time.Second, retry: 2 * time.Second},
+		{lease: 5 * time.Second, renew: 10 * time.Second, retry: 2 * time.Second},
+	} {
+		assert.Error(t, validateLeaderElection("lease", "observability", tt.lease, tt.renew, tt.retry), "%v", tt)
+	}
+}
+
+func TestValidateSyncPeriod(t *testing.T) {
+	assert.NoError(t, validateSyncPeriod(10*time.Hour))
+	assert.NoError(t, validateSyncPeriod(time.Minute))
+	assert.Error(t, validateSyncPeriod(30*time.Second))
+	assert.Error(t, validateSyncPeriod(0))
+}
+
+func TestValidateConflictPolicy(t *testing.T) {
+	for _, policy := range []string{"fail", "force", "skip", "Force"} {
+		assert.NoError(t, validateConflictPolicy(policy), policy)
+	}
+	for _, policy := range []string{"", "overwrite", "forced"} {
+		assert.Error(t, validateConflictPolicy(policy), policy)
+	}
+}
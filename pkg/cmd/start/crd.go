@@ -0,0 +1,67 @@
+package start
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/global"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+)
+
+// crdPollInterval is the interval between two checks for the establishment of the Jaeger CRD
+var crdPollInterval = 2 * time.Second
+
+func waitForCRD(ctx context.Context, cfg *rest.Config) {
+	tracer := global.TraceProvider().GetTracer(v1.BootstrapTracer)
+	ctx, span := tracer.Start(ctx, "waitForCRD")
+	defer span.End()
+
+	timeout := viper.GetDuration(v1.ConfigCRDWaitTimeout)
+	if timeout <= 0 {
+		return
+	}
+
+	dcl, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		tracing.HandleError(err, span)
+		log.WithError(err).Fatal("failed to create the discovery client")
+	}
+
+	if err := waitForJaegerResource(dcl, crdPollInterval, timeout); err != nil {
+		tracing.HandleError(err, span)
+		log.WithError(err).Fatal("the Jaeger CRD hasn't been established")
+	}
+}
+
+// waitForJaegerResource blocks until the API server serves the Jaeger resource, which happens only once the CRD is established
+func waitForJaegerResource(dcl discovery.DiscoveryInterface, interval, timeout time.Duration) error {
+	gv := v1.SchemeGroupVersion.String()
+	err := wait.PollImmediate(interval, timeout, func() (bool, error) {
+		resources, err := dcl.ServerResourcesForGroupVersion(gv)
+		if err != nil {
+			log.WithError(err).WithField("groupVersion", gv).Debug("the Jaeger CRD isn't available yet")
+			return false, nil
+		}
+
+		for _, r := range resources.APIResources {
+			if r.Kind == "Jaeger" {
+				return true, nil
+			}
+		}
+
+		log.WithField("groupVersion", gv).Debug("the Jaeger CRD isn't established yet")
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("timed out after %v waiting for the Jaeger CRD to be established: %w", timeout, err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package start
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestWaitForJaegerResourceEstablished(t *testing.T) {
+	// prepare
+	calls := 0
+	dcl := &fakeDiscoveryClient{
+		ServerResourcesForGroupVersionFunc: func(groupVersion string) (*metav1.APIResourceList, error) {
+			assert.Equal(t, v1.SchemeGroupVersion.String(), groupVersion)
+			calls++
+			switch calls {
+			case 1:
+				return nil, errors.New("the server could not find the requested resource")
+			case 2:
+				return &metav1.APIResourceList{}, nil
+			default:
+				return &metav1.APIResourceList{
+					APIResources: []metav1.APIResource{{Name: "jaegers", Kind: "Jaeger"}},
+				}, nil
+			}
+		},
+	}
+
+	// test
+	err := waitForJaegerResource(dcl, time.Millisecond, time.Second)
+
+	// verify
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWaitForJaegerResourceTimeout(t *testing.T) {
+	// prepare
+	dcl := &fakeDiscoveryClient{
+		ServerResourcesForGroupVersionFunc: func(groupVersion string) (*metav1.APIResourceList, error) {
+			return &metav1.APIResourceList{}, nil
+		},
+	}
+
+	// test
+	err := waitForJaegerResource(dcl, time.Millisecond, 10*time.Millisecond)
+
+	// verify
+	assert.Error(t, err)
+}
+
+type fakeDiscoveryClient struct {
+	discovery.DiscoveryInterface
+	ServerResourcesForGroupVersionFunc func(groupVersion string) (*metav1.APIResourceList, error)
+}
+
+func (d *fakeDiscoveryClient) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	return d.ServerResourcesForGroupVersionFunc(groupVersion)
+}
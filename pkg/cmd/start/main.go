@@ -3,6 +3,7 @@ package start
 import (
 	"context"
 	"fmt"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -12,6 +13,20 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/version"
 )
 
+const (
+	// leaderElectionForLife keeps the leadership until the leader's pod is deleted
+	leaderElectionForLife = "for-life"
+
+	// leaderElectionLease requires the leader to renew a lease, which is taken over by a standby replica once it expires
+	leaderElectionLease = "lease"
+
+	// defaultSyncPeriod is the controller-runtime's default interval between two resyncs of the watched objects
+	defaultSyncPeriod = 10 * time.Hour
+
+	// minSyncPeriod is the shortest accepted interval between two resyncs of the watched objects
+	minSyncPeriod = time.Minute
+)
+
 // AddFlags adds all command line flags related to manifest
 // generation. They are shared between the operator and CLI `generate`
 // command.
@@ -30,6 +45,7 @@ func AddFlags(cmd *cobra.Command) {
 	cmd.Flags().String("openshift-oauth-proxy-image", "openshift/oauth-proxy:latest", "The Docker image location definition for the OpenShift OAuth Proxy")
 	cmd.Flags().String("openshift-oauth-proxy-imagestream-ns", "", "The namespace for the OpenShift OAuth Proxy imagestream")
 	cmd.Flags().String("openshift-oauth-proxy-imagestream-name", "", "The name for the OpenShift OAuth Proxy imagestream")
+	cmd.Flags().String("image-registry", "", "The registry, optionally followed by a path, prepended to the default images of the Jaeger components, such as 'registry.example.com/mirror'. Images that already name a registry, as well as the images set in the Jaeger instances, are used as-is")
 	cmd.Flags().String("platform", "auto-detect", "The target platform the operator will run. Possible values: 'kubernetes', 'openshift', 'auto-detect'")
 	cmd.Flags().String("es-provision", "auto", "Whether to auto-provision an Elasticsearch cluster for suitable Jaeger instances. Possible values: 'yes', 'no', 'auto'. When set to 'auto' and the API name 'logging.openshift.io' is available, auto-provisioning is enabled.")
 	cmd.Flags().String("kafka-provision", "auto", "Whether to auto-provision a Kafka cluster for suitable Jaeger instances. Possible values: 'yes', 'no', 'auto'. When set to 'auto' and the API name 'kafka.strimzi.io' is available, auto-provisioning is enabled.")
@@ -57,6 +73,16 @@ func NewStartCommand() *cobra.Command {
 	cmd.Flags().Int32("cr-metrics-port", 8686, "The metrics port for Operator and/or Custom Resource based metrics")
 	cmd.Flags().String("jaeger-agent-hostport", "localhost:6831", "The location for the Jaeger Agent")
 	cmd.Flags().Bool("tracing-enabled", false, "Whether the Operator should report its own spans to a Jaeger instance")
+	cmd.Flags().String("field-manager", "jaeger-operator", "The field manager name the operator uses when creating or updating objects")
+	cmd.Flags().String("conflict-policy", "fail", "What to do when an update conflicts with a newer version of the object. Possible values: 'fail', 'force', 'skip'. When set to 'force', the update is re-applied on top of the latest version. When set to 'skip', the update is discarded until the next reconciliation.")
+	cmd.Flags().Duration("crd-wait-timeout", 2*time.Minute, "How long to wait on startup for the Jaeger CRD to be established before starting the controllers. Set to 0 to skip the wait.")
+	cmd.Flags().String("instance-selector", "", "A label selector, like 'team=payments', restricting the Jaeger instances reconciled by this operator instance. Instances not matching it are ignored. By default, all the instances are reconciled")
+	cmd.Flags().String("leader-election", leaderElectionForLife, "How the operator replicas elect a leader. Possible values: 'for-life', 'lease'. With 'for-life', the leader keeps the lock until its pod is deleted. With 'lease', the leader has to renew a lease periodically, allowing a standby replica to take over once the lease expires")
+	cmd.Flags().Duration("leader-election-lease-duration", 15*time.Second, "How long a standby replica waits before taking over a lease that hasn't been renewed. Used only with the 'lease' leader election")
+	cmd.Flags().Duration("leader-election-renew-deadline", 10*time.Second, "How long the leader retries renewing its lease before giving up the leadership. Must be lower than the lease duration. Used only with the 'lease' leader election")
+	cmd.Flags().Duration("leader-election-retry-period", 2*time.Second, "How long the replicas wait between attempts to acquire or renew the lease. Must be lower than the renew deadline. Used only with the 'lease' leader election")
+	cmd.Flags().Duration("sync-period", defaultSyncPeriod, "How often the watched objects are resynced, triggering a reconciliation of all the Jaeger instances, which corrects the changes made to the managed objects by other parties. Has to be at least 1m")
+	cmd.Flags().Bool("audit-log", false, "Whether to record every object created, updated or deleted by the operator as a JSON entry in an audit log, written to the standard error")
 
 	return cmd
 }
@@ -0,0 +1,77 @@
+package aws
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+const (
+	volumeName      = "aws-iam-token"
+	volumeMountPath = "/var/run/secrets/eks.amazonaws.com/serviceaccount"
+	tokenFile       = "token"
+	defaultAudience = "sts.amazonaws.com"
+
+	// the same expiration as the one used by the EKS pod identity webhook
+	tokenExpirationSeconds = int64(86400)
+
+	// RoleARNEnvVar is the environment variable holding the IAM role the AWS SDK assumes
+	RoleARNEnvVar = "AWS_ROLE_ARN"
+
+	// TokenFileEnvVar is the environment variable holding the path to the projected web identity token
+	TokenFileEnvVar = "AWS_WEB_IDENTITY_TOKEN_FILE"
+)
+
+// Update will modify the supplied common spec, to include the projected
+// service account token used by the AWS SDK to assume the configured IAM role
+func Update(jaeger *v1.Jaeger, commonSpec *v1.JaegerCommonSpec) {
+	spec := jaeger.Spec.Storage.AWSWebIdentity
+	if len(spec.RoleARN) == 0 {
+		return
+	}
+
+	audience := spec.Audience
+	if len(audience) == 0 {
+		audience = defaultAudience
+	}
+
+	expiration := tokenExpirationSeconds
+	volume := corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{{
+					ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+						Audience:          audience,
+						ExpirationSeconds: &expiration,
+						Path:              tokenFile,
+					},
+				}},
+			},
+		},
+	}
+
+	volumeMount := corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: volumeMountPath,
+		ReadOnly:  true,
+	}
+
+	commonSpec.Volumes = util.RemoveDuplicatedVolumes(append(commonSpec.Volumes, volume))
+	commonSpec.VolumeMounts = util.RemoveDuplicatedVolumeMounts(append(commonSpec.VolumeMounts, volumeMount))
+}
+
+// EnvVars returns the environment variables instructing the AWS SDK to assume
+// the configured IAM role with the token projected by Update
+func EnvVars(jaeger *v1.Jaeger) []corev1.EnvVar {
+	roleARN := jaeger.Spec.Storage.AWSWebIdentity.RoleARN
+	if len(roleARN) == 0 {
+		return nil
+	}
+
+	return []corev1.EnvVar{
+		{Name: RoleARNEnvVar, Value: roleARN},
+		{Name: TokenFileEnvVar, Value: volumeMountPath + "/" + tokenFile},
+	}
+}
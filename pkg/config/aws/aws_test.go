@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestUpdateWithoutRole(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	commonSpec := v1.JaegerCommonSpec{}
+
+	// test
+	Update(jaeger, &commonSpec)
+
+	// verify
+	assert.Len(t, commonSpec.Volumes, 0)
+	assert.Len(t, commonSpec.VolumeMounts, 0)
+	assert.Len(t, EnvVars(jaeger), 0)
+}
+
+func TestUpdateWithRole(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Storage.AWSWebIdentity.RoleARN = "arn:aws:iam::123456789012:role/jaeger"
+	commonSpec := v1.JaegerCommonSpec{}
+
+	// test
+	Update(jaeger, &commonSpec)
+
+	// verify
+	assert.Len(t, commonSpec.Volumes, 1)
+	assert.Equal(t, volumeName, commonSpec.Volumes[0].Name)
+	projection := commonSpec.Volumes[0].Projected.Sources[0].ServiceAccountToken
+	assert.Equal(t, defaultAudience, projection.Audience)
+	assert.Equal(t, tokenFile, projection.Path)
+
+	assert.Len(t, commonSpec.VolumeMounts, 1)
+	assert.Equal(t, volumeMountPath, commonSpec.VolumeMounts[0].MountPath)
+	assert.True(t, commonSpec.VolumeMounts[0].ReadOnly)
+
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: RoleARNEnvVar, Value: "arn:aws:iam::123456789012:role/jaeger"},
+		{Name: TokenFileEnvVar, Value: "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"},
+	}, EnvVars(jaeger))
+}
+
+func TestUpdateWithCustomAudience(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Storage.AWSWebIdentity.RoleARN = "arn:aws:iam::123456789012:role/jaeger"
+	jaeger.Spec.Storage.AWSWebIdentity.Audience = "my-audience"
+	commonSpec := v1.JaegerCommonSpec{}
+
+	// test
+	Update(jaeger, &commonSpec)
+
+	// verify
+	assert.Equal(t, "my-audience", commonSpec.Volumes[0].Projected.Sources[0].ServiceAccountToken.Audience)
+}
@@ -34,7 +34,6 @@ func GetTrustedCABundle(jaeger *v1.Jaeger) *corev1.ConfigMap {
 	}
 
 	jaeger.Logger().Debug("CA: Creating the trustedCABundle configmap")
-	trueVar := true
 
 	name := TrustedCAName(jaeger)
 	labels := util.Labels(name, "ca-configmap", *jaeger)
@@ -47,16 +46,10 @@ func GetTrustedCABundle(jaeger *v1.Jaeger) *corev1.ConfigMap {
 			Kind:       "ConfigMap",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: jaeger.Namespace,
-			Labels:    labels,
-			OwnerReferences: []metav1.OwnerReference{{
-				APIVersion: jaeger.APIVersion,
-				Kind:       jaeger.Kind,
-				Name:       jaeger.Name,
-				UID:        jaeger.UID,
-				Controller: &trueVar,
-			}},
+			Name:            name,
+			Namespace:       jaeger.Namespace,
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 		Data: map[string]string{
 			"ca-bundle.crt": "",
@@ -77,7 +70,6 @@ func GetServiceCABundle(jaeger *v1.Jaeger) *corev1.ConfigMap {
 	}
 
 	jaeger.Logger().Debug("CA: Creating the service CA configmap")
-	trueVar := true
 
 	name := ServiceCAName(jaeger)
 	annotations := map[string]string{
@@ -90,17 +82,11 @@ func GetServiceCABundle(jaeger *v1.Jaeger) *corev1.ConfigMap {
 			Kind:       "ConfigMap",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        name,
-			Namespace:   jaeger.Namespace,
-			Labels:      util.Labels(name, "service-ca-configmap", *jaeger),
-			Annotations: annotations,
-			OwnerReferences: []metav1.OwnerReference{{
-				APIVersion: jaeger.APIVersion,
-				Kind:       jaeger.Kind,
-				Name:       jaeger.Name,
-				UID:        jaeger.UID,
-				Controller: &trueVar,
-			}},
+			Name:            name,
+			Namespace:       jaeger.Namespace,
+			Labels:          util.Labels(name, "service-ca-configmap", *jaeger),
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 	}
 }
@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/service"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
@@ -37,7 +38,11 @@ func Get(jaeger *v1.Jaeger) []corev1.ConfigMap {
 	if c != nil {
 		cms = append(cms, *c)
 	}
-	c = createIfNeeded(jaeger, "collector", jaeger.Spec.Collector.Options, jaeger.Spec.Collector.Config)
+	c = createIfNeeded(jaeger, "collector", jaeger.Spec.Collector.Options, jaeger.Spec.Collector.Config, func(cfg map[string]interface{}) {
+		setOTLPMaxConcurrentStreams(cfg, jaeger.Spec.Collector.OTLPMaxConcurrentStreams)
+		setOTLPKeepalive(cfg, jaeger.Spec.Collector.OTLPKeepalive)
+		setOTLPCORS(cfg, jaeger.Spec.Collector.OTLPCORS)
+	})
 	if c != nil {
 		cms = append(cms, *c)
 	}
@@ -45,7 +50,11 @@ func Get(jaeger *v1.Jaeger) []corev1.ConfigMap {
 	if c != nil {
 		cms = append(cms, *c)
 	}
-	c = createIfNeeded(jaeger, "all-in-one", jaeger.Spec.AllInOne.Options, jaeger.Spec.AllInOne.Config)
+	c = createIfNeeded(jaeger, "all-in-one", jaeger.Spec.AllInOne.Options, jaeger.Spec.AllInOne.Config, func(cfg map[string]interface{}) {
+		if jaeger.Spec.AllInOne.OTLPEnabled {
+			setOTLPEndpoints(cfg)
+		}
+	})
 	if c != nil {
 		cms = append(cms, *c)
 	}
@@ -61,11 +70,14 @@ func getMap(log *logrus.Entry, otelConfig v1.FreeForm) (map[string]interface{},
 	return m, err
 }
 
-func createIfNeeded(jaeger *v1.Jaeger, component string, opts v1.Options, otelConfig v1.FreeForm) *corev1.ConfigMap {
+func createIfNeeded(jaeger *v1.Jaeger, component string, opts v1.Options, otelConfig v1.FreeForm, updates ...func(map[string]interface{})) *corev1.ConfigMap {
 	m, err := getMap(jaeger.Logger().WithField("component", component), otelConfig)
 	if err != nil {
 		return nil
 	}
+	for _, update := range updates {
+		update(m)
+	}
 	if ShouldCreate(jaeger, opts, m) {
 		c, err := create(jaeger, component, m)
 		if err != nil {
@@ -76,6 +88,114 @@ func createIfNeeded(jaeger *v1.Jaeger, component string, opts v1.Options, otelCo
 	return nil
 }
 
+// setOTLPMaxConcurrentStreams sets the max concurrent streams for the OTLP gRPC receiver, unless it's explicitly set
+// in the given config already. Nothing is changed when the config has no OTLP gRPC receiver.
+func setOTLPMaxConcurrentStreams(cfg map[string]interface{}, maxStreams *uint32) {
+	if maxStreams == nil {
+		return
+	}
+
+	grpc, found := otlpProtocol(cfg, "grpc")
+	if !found {
+		return
+	}
+	if _, exists := grpc["max_concurrent_streams"]; !exists {
+		grpc["max_concurrent_streams"] = *maxStreams
+	}
+}
+
+// setOTLPEndpoints configures the OTLP receiver to listen on the standard gRPC and HTTP ports, unless the endpoints
+// are explicitly set in the given config already. Nothing is changed for an empty config, as it means that the
+// OpenTelemetry config isn't in use.
+func setOTLPEndpoints(cfg map[string]interface{}) {
+	if len(cfg) == 0 {
+		return
+	}
+
+	protocols := childMap(childMap(childMap(cfg, "receivers"), "otlp"), "protocols")
+	for protocol, port := range map[string]int{"grpc": service.OTLPGRPCPort, "http": service.OTLPHTTPPort} {
+		endpoint := childMap(protocols, protocol)
+		if _, exists := endpoint["endpoint"]; !exists {
+			endpoint["endpoint"] = fmt.Sprintf("0.0.0.0:%d", port)
+		}
+	}
+}
+
+// setOTLPKeepalive sets the keepalive server parameters of the OTLP gRPC receiver, unless they're explicitly set in
+// the given config already. Nothing is changed when the config has no OTLP gRPC receiver.
+func setOTLPKeepalive(cfg map[string]interface{}, keepalive v1.JaegerCollectorOTLPKeepaliveSpec) {
+	params := []struct {
+		key   string
+		value string
+	}{
+		{"max_connection_idle", keepalive.MaxConnectionIdle},
+		{"max_connection_age", keepalive.MaxConnectionAge},
+		{"max_connection_age_grace", keepalive.MaxConnectionAgeGrace},
+		{"time", keepalive.Time},
+		{"timeout", keepalive.Timeout},
+	}
+
+	grpc, found := otlpProtocol(cfg, "grpc")
+	if !found {
+		return
+	}
+	for _, param := range params {
+		if len(param.value) == 0 {
+			continue
+		}
+		serverParams := childMap(childMap(grpc, "keepalive"), "server_parameters")
+		if _, exists := serverParams[param.key]; !exists {
+			serverParams[param.key] = param.value
+		}
+	}
+}
+
+// setOTLPCORS sets the CORS allowed origins and headers of the OTLP HTTP receiver, unless they're explicitly set in
+// the given config already. Nothing is changed when the config has no OTLP HTTP receiver.
+func setOTLPCORS(cfg map[string]interface{}, cors v1.JaegerCollectorOTLPCORSSpec) {
+	http, found := otlpProtocol(cfg, "http")
+	if !found {
+		return
+	}
+	for key, values := range map[string][]string{
+		"cors_allowed_origins": cors.AllowedOrigins,
+		"cors_allowed_headers": cors.AllowedHeaders,
+	} {
+		if _, exists := http[key]; exists || len(values) == 0 {
+			continue
+		}
+		list := make([]interface{}, len(values))
+		for i, v := range values {
+			list[i] = v
+		}
+		http[key] = list
+	}
+}
+
+// otlpProtocol returns the settings of the given protocol of the OTLP receiver, and whether the config declares the
+// protocol at all. Undeclared protocols aren't created, as that would enable them, or disable the default ones when
+// the receiver doesn't list any protocol.
+func otlpProtocol(cfg map[string]interface{}, name string) (map[string]interface{}, bool) {
+	receivers, _ := cfg["receivers"].(map[string]interface{})
+	otlp, _ := receivers["otlp"].(map[string]interface{})
+	protocols, _ := otlp["protocols"].(map[string]interface{})
+	if _, found := protocols[name]; !found {
+		return nil, false
+	}
+	return childMap(protocols, name), true
+}
+
+// childMap returns the map stored under the given key, creating it when the current value isn't a map,
+// like when the entry is declared in the YAML without any content
+func childMap(parent map[string]interface{}, key string) map[string]interface{} {
+	if m, ok := parent[key].(map[string]interface{}); ok {
+		return m
+	}
+	m := map[string]interface{}{}
+	parent[key] = m
+	return m
+}
+
 func create(jaeger *v1.Jaeger, component string, otelConfig map[string]interface{}) (*corev1.ConfigMap, error) {
 	cfgYml, err := yaml.Marshal(otelConfig)
 	if err != nil {
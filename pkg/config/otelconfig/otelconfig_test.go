@@ -151,3 +151,240 @@ func TestSyncShouldRemove(t *testing.T) {
 	assert.Len(t, commonSpec.Volumes, 1)
 	assert.Len(t, args, 1)
 }
+
+func TestGetCollectorOTLPMaxConcurrentStreams(t *testing.T) {
+	maxStreams := uint32(100)
+	j := v1.NewJaeger(types.NamespacedName{Name: "jaeger"})
+	j.Spec.Collector.OTLPMaxConcurrentStreams = &maxStreams
+	j.Spec.Collector.Config = v1.NewFreeForm(map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{
+					"grpc": nil,
+				},
+			},
+		},
+	})
+
+	cms := Get(j)
+	require.Len(t, cms, 1)
+
+	cfg := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(cms[0].Data["config"]), &cfg))
+	grpc := cfg["receivers"].(map[interface{}]interface{})["otlp"].(map[interface{}]interface{})["protocols"].(map[interface{}]interface{})["grpc"].(map[interface{}]interface{})
+	assert.Equal(t, 100, grpc["max_concurrent_streams"])
+}
+
+func TestSetOTLPKeepalive(t *testing.T) {
+	keepalive := v1.JaegerCollectorOTLPKeepaliveSpec{MaxConnectionAge: "5m", Time: "30s"}
+	tests := []struct {
+		name     string
+		cfg      map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "no otlp receiver",
+			cfg:      map[string]interface{}{"receivers": map[string]interface{}{"jaeger": nil}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"jaeger": nil}},
+		},
+		{
+			name:     "otlp receiver without protocols",
+			cfg:      map[string]interface{}{"receivers": map[string]interface{}{"otlp": nil}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"otlp": nil}},
+		},
+		{
+			name: "otlp receiver without grpc",
+			cfg: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"http": nil},
+			}}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"http": nil},
+			}}},
+		},
+		{
+			name: "otlp grpc receiver",
+			cfg: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"grpc": nil},
+			}}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"grpc": map[string]interface{}{
+					"keepalive": map[string]interface{}{"server_parameters": map[string]interface{}{
+						"max_connection_age": "5m",
+						"time":               "30s",
+					}},
+				}},
+			}}},
+		},
+		{
+			name: "explicit value",
+			cfg: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"grpc": map[string]interface{}{
+					"keepalive": map[string]interface{}{"server_parameters": map[string]interface{}{"time": "1m"}},
+				}},
+			}}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"grpc": map[string]interface{}{
+					"keepalive": map[string]interface{}{"server_parameters": map[string]interface{}{
+						"max_connection_age": "5m",
+						"time":               "1m",
+					}},
+				}},
+			}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setOTLPKeepalive(test.cfg, keepalive)
+			assert.Equal(t, test.expected, test.cfg)
+		})
+	}
+}
+
+func TestSetOTLPMaxConcurrentStreams(t *testing.T) {
+	maxStreams := uint32(100)
+	tests := []struct {
+		name     string
+		cfg      map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "no receivers",
+			cfg:      map[string]interface{}{"exporters": "bar"},
+			expected: map[string]interface{}{"exporters": "bar"},
+		},
+		{
+			name:     "no otlp receiver",
+			cfg:      map[string]interface{}{"receivers": map[string]interface{}{"jaeger": nil}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"jaeger": nil}},
+		},
+		{
+			name:     "otlp receiver without protocols",
+			cfg:      map[string]interface{}{"receivers": map[string]interface{}{"otlp": nil}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"otlp": nil}},
+		},
+		{
+			name: "otlp receiver without grpc",
+			cfg: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"http": nil},
+			}}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"http": nil},
+			}}},
+		},
+		{
+			name: "otlp grpc receiver",
+			cfg: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"grpc": nil},
+			}}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"grpc": map[string]interface{}{"max_concurrent_streams": maxStreams}},
+			}}},
+		},
+		{
+			name: "explicit value",
+			cfg: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"grpc": map[string]interface{}{"max_concurrent_streams": 5}},
+			}}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"grpc": map[string]interface{}{"max_concurrent_streams": 5}},
+			}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setOTLPMaxConcurrentStreams(test.cfg, &maxStreams)
+			assert.Equal(t, test.expected, test.cfg)
+		})
+	}
+}
+
+func TestSetOTLPCORS(t *testing.T) {
+	cors := v1.JaegerCollectorOTLPCORSSpec{
+		AllowedOrigins: []string{"https://*.example.com"},
+		AllowedHeaders: []string{"X-Custom-Header"},
+	}
+	tests := []struct {
+		name     string
+		cfg      map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "no otlp receiver",
+			cfg:      map[string]interface{}{"receivers": map[string]interface{}{"jaeger": nil}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"jaeger": nil}},
+		},
+		{
+			name: "otlp receiver without http",
+			cfg: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"grpc": nil},
+			}}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"grpc": nil},
+			}}},
+		},
+		{
+			name: "otlp http receiver",
+			cfg: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"http": nil},
+			}}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"http": map[string]interface{}{
+					"cors_allowed_origins": []interface{}{"https://*.example.com"},
+					"cors_allowed_headers": []interface{}{"X-Custom-Header"},
+				}},
+			}}},
+		},
+		{
+			name: "explicit value",
+			cfg: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"http": map[string]interface{}{
+					"cors_allowed_origins": []interface{}{"*"},
+				}},
+			}}},
+			expected: map[string]interface{}{"receivers": map[string]interface{}{"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{"http": map[string]interface{}{
+					"cors_allowed_origins": []interface{}{"*"},
+					"cors_allowed_headers": []interface{}{"X-Custom-Header"},
+				}},
+			}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setOTLPCORS(test.cfg, cors)
+			assert.Equal(t, test.expected, test.cfg)
+		})
+	}
+}
+
+func TestGetAllInOneOTLPEndpoints(t *testing.T) {
+	j := v1.NewJaeger(types.NamespacedName{Name: "jaeger"})
+	j.Spec.AllInOne.OTLPEnabled = true
+	j.Spec.AllInOne.Config = v1.NewFreeForm(map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{
+					"http": map[string]interface{}{
+						"endpoint": "0.0.0.0:55681",
+					},
+				},
+			},
+		},
+	})
+
+	cms := Get(j)
+	require.Len(t, cms, 1)
+
+	cfg := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(cms[0].Data["config"]), &cfg))
+	protocols := cfg["receivers"].(map[interface{}]interface{})["otlp"].(map[interface{}]interface{})["protocols"].(map[interface{}]interface{})
+	assert.Equal(t, "0.0.0.0:4317", protocols["grpc"].(map[interface{}]interface{})["endpoint"])
+	assert.Equal(t, "0.0.0.0:55681", protocols["http"].(map[interface{}]interface{})["endpoint"])
+}
+
+func TestGetAllInOneOTLPWithoutConfig(t *testing.T) {
+	j := v1.NewJaeger(types.NamespacedName{Name: "jaeger"})
+	j.Spec.AllInOne.OTLPEnabled = true
+
+	assert.Len(t, Get(j), 0)
+}
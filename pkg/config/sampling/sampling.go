@@ -1,7 +1,9 @@
 package sampling
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +14,9 @@ import (
 
 const (
 	defaultSamplingStrategy = "{\"default_strategy\":{\"param\":1,\"type\":\"probabilistic\"}}"
+
+	// DefaultSecretKey is the key within the sampling secret holding the strategies, when none is specified
+	DefaultSecretKey = "sampling"
 )
 
 // Config represents a sampling configmap
@@ -33,6 +38,19 @@ func (u *Config) Get() *corev1.ConfigMap {
 		return nil
 	}
 
+	// the strategies are mounted straight from the secret
+	if len(u.jaeger.Spec.Sampling.SecretName) > 0 {
+		if !u.jaeger.Spec.Sampling.Options.IsEmpty() {
+			u.jaeger.Logger().WithField("secret", u.jaeger.Spec.Sampling.SecretName).
+				Warn("Both the sampling options and a sampling secret are set. Using the strategies from the secret.")
+		}
+		if len(u.jaeger.Spec.Collector.ServiceRateLimits) > 0 {
+			u.jaeger.Logger().WithField("secret", u.jaeger.Spec.Sampling.SecretName).
+				Warn("The service rate limits aren't applied to the sampling strategies from a secret.")
+		}
+		return nil
+	}
+
 	// Check for empty map
 	if u.jaeger.Spec.Sampling.Options.IsEmpty() {
 		jsonObject = []byte(defaultSamplingStrategy)
@@ -44,8 +62,15 @@ func (u *Config) Get() *corev1.ConfigMap {
 		return nil
 	}
 
+	if len(u.jaeger.Spec.Collector.ServiceRateLimits) > 0 {
+		jsonObject, err = addServiceRateLimits(jsonObject, u.jaeger.Spec.Collector.ServiceRateLimits)
+		if err != nil {
+			u.jaeger.Logger().WithError(err).Error("could not add the service rate limits to the sampling configuration")
+			return nil
+		}
+	}
+
 	u.jaeger.Logger().Debug("Assembling the Sampling configmap")
-	trueVar := true
 
 	data := map[string]string{
 		"sampling": string(jsonObject),
@@ -57,23 +82,55 @@ func (u *Config) Get() *corev1.ConfigMap {
 			Kind:       "ConfigMap",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-sampling-configuration", u.jaeger.Name),
-			Namespace: u.jaeger.Namespace,
-			Labels:    util.Labels(fmt.Sprintf("%s-sampling-configuration", u.jaeger.Name), "sampling-configuration", *u.jaeger),
-			OwnerReferences: []metav1.OwnerReference{
-				metav1.OwnerReference{
-					APIVersion: u.jaeger.APIVersion,
-					Kind:       u.jaeger.Kind,
-					Name:       u.jaeger.Name,
-					UID:        u.jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
+			Name:            fmt.Sprintf("%s-sampling-configuration", u.jaeger.Name),
+			Namespace:       u.jaeger.Namespace,
+			Labels:          util.Labels(fmt.Sprintf("%s-sampling-configuration", u.jaeger.Name), "sampling-configuration", *u.jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(u.jaeger)},
 		},
 		Data: data,
 	}
 }
 
+// addServiceRateLimits adds a "ratelimiting" strategy for each of the given services to the sampling configuration,
+// keeping the explicit strategies already in place for the service
+func addServiceRateLimits(jsonObject []byte, limits map[string]int32) ([]byte, error) {
+	cfg := map[string]interface{}{}
+	if err := json.Unmarshal(jsonObject, &cfg); err != nil {
+		return nil, err
+	}
+
+	strategies, _ := cfg["service_strategies"].([]interface{})
+	existing := map[string]bool{}
+	for _, s := range strategies {
+		if strategy, ok := s.(map[string]interface{}); ok {
+			if service, ok := strategy["service"].(string); ok {
+				existing[service] = true
+			}
+		}
+	}
+
+	services := make([]string, 0, len(limits))
+	for service := range limits {
+		services = append(services, service)
+	}
+	// ensure we have a consistent order of the strategies
+	sort.Strings(services)
+
+	for _, service := range services {
+		if existing[service] {
+			continue
+		}
+		strategies = append(strategies, map[string]interface{}{
+			"service": service,
+			"type":    "ratelimiting",
+			"param":   limits[service],
+		})
+	}
+	cfg["service_strategies"] = strategies
+
+	return json.Marshal(cfg)
+}
+
 // CheckForSamplingConfigFile will check if there is a config file present
 // if there is one it returns true
 func CheckForSamplingConfigFile(jaeger *v1.Jaeger) bool {
@@ -118,6 +175,17 @@ func Update(jaeger *v1.Jaeger, commonSpec *v1.JaegerCommonSpec, options *[]strin
 			},
 		},
 	}
+	if len(jaeger.Spec.Sampling.SecretName) > 0 {
+		volume.VolumeSource = corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: jaeger.Spec.Sampling.SecretName,
+				Items: []corev1.KeyToPath{{
+					Key:  SecretKey(jaeger),
+					Path: "sampling.json",
+				}},
+			},
+		}
+	}
 	volumeMount := corev1.VolumeMount{
 		Name:      samplingConfigVolumeName(jaeger),
 		MountPath: "/etc/jaeger/sampling",
@@ -128,6 +196,14 @@ func Update(jaeger *v1.Jaeger, commonSpec *v1.JaegerCommonSpec, options *[]strin
 	*options = append(*options, "--sampling.strategies-file=/etc/jaeger/sampling/sampling.json")
 }
 
+// SecretKey returns the key within the sampling secret holding the strategies
+func SecretKey(jaeger *v1.Jaeger) string {
+	if len(jaeger.Spec.Sampling.SecretKey) > 0 {
+		return jaeger.Spec.Sampling.SecretKey
+	}
+	return DefaultSecretKey
+}
+
 func samplingConfigVolumeName(jaeger *v1.Jaeger) string {
 	return util.DNSName(util.Truncate("%s-sampling-configuration-volume", 63, jaeger.Name))
 }
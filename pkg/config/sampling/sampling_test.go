@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
@@ -45,6 +46,35 @@ func TestWithSamplingConfig(t *testing.T) {
 	assert.Equal(t, json, cm.Data["sampling"])
 }
 
+func TestWithServiceRateLimits(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestWithServiceRateLimits"})
+	jaeger.Spec.Collector.ServiceRateLimits = map[string]int32{"noisy": 10, "chatty": 5}
+
+	cm := NewConfig(jaeger).Get()
+
+	json := `{"default_strategy":{"param":1,"type":"probabilistic"},"service_strategies":[` +
+		`{"param":5,"service":"chatty","type":"ratelimiting"},` +
+		`{"param":10,"service":"noisy","type":"ratelimiting"}]}`
+	assert.Equal(t, json, cm.Data["sampling"])
+}
+
+func TestWithServiceRateLimitsKeepsExplicitStrategies(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestWithServiceRateLimitsKeepsExplicitStrategies"})
+	jaeger.Spec.Sampling.Options = v1.NewFreeForm(map[string]interface{}{
+		"service_strategies": []interface{}{
+			map[string]interface{}{"service": "noisy", "type": "probabilistic", "param": 0.1},
+		},
+	})
+	jaeger.Spec.Collector.ServiceRateLimits = map[string]int32{"noisy": 10, "chatty": 5}
+
+	cm := NewConfig(jaeger).Get()
+
+	json := `{"service_strategies":[` +
+		`{"param":0.1,"service":"noisy","type":"probabilistic"},` +
+		`{"param":5,"service":"chatty","type":"ratelimiting"}]}`
+	assert.Equal(t, json, cm.Data["sampling"])
+}
+
 func TestUpdateNoSamplingConfig(t *testing.T) {
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestUpdateNoSamplingConfig"})
 
@@ -106,3 +136,34 @@ func TestGetWithSamplingConfigFileOption(t *testing.T) {
 	cm := config.Get()
 	assert.Nil(t, cm)
 }
+
+func TestGetWithSamplingSecret(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestGetWithSamplingSecret"})
+	jaeger.Spec.Sampling.SecretName = "my-sampling"
+	jaeger.Spec.Sampling.Options = v1.NewFreeForm(map[string]interface{}{
+		"default_strategy": map[string]interface{}{"type": "probabilistic", "param": 0.5},
+	})
+
+	assert.Nil(t, NewConfig(jaeger).Get())
+}
+
+func TestUpdateWithSamplingSecret(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestUpdateWithSamplingSecret"})
+	jaeger.Spec.Sampling.SecretName = "my-sampling"
+
+	commonSpec := v1.JaegerCommonSpec{}
+	options := []string{}
+
+	Update(jaeger, &commonSpec, &options)
+	assert.Len(t, commonSpec.Volumes, 1)
+	assert.Nil(t, commonSpec.Volumes[0].ConfigMap)
+	assert.Equal(t, "my-sampling", commonSpec.Volumes[0].Secret.SecretName)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "sampling", Path: "sampling.json"}}, commonSpec.Volumes[0].Secret.Items)
+	assert.Len(t, commonSpec.VolumeMounts, 1)
+	assert.Equal(t, []string{"--sampling.strategies-file=/etc/jaeger/sampling/sampling.json"}, options)
+
+	jaeger.Spec.Sampling.SecretKey = "strategies.json"
+	commonSpec = v1.JaegerCommonSpec{}
+	Update(jaeger, &commonSpec, &options)
+	assert.Equal(t, "strategies.json", commonSpec.Volumes[0].Secret.Items[0].Key)
+}
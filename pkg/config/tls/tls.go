@@ -2,6 +2,7 @@ package tls
 
 import (
 	"fmt"
+	"path"
 
 	"github.com/spf13/viper"
 	corev1 "k8s.io/api/core/v1"
@@ -11,6 +12,18 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
+const (
+	// CertKey is the entry of the query's storage TLS secret holding the certificate
+	CertKey = "tls.crt"
+	// KeyKey is the entry of the query's storage TLS secret holding the key
+	KeyKey = "tls.key"
+	// StorageCAKey is the entry of the query's storage CA secret holding the CA certificate
+	StorageCAKey = "ca.crt"
+
+	queryStorageMountPath   = "/etc/query-storage-tls-config"
+	queryStorageCAMountPath = "/etc/query-storage-tls-ca"
+)
+
 // Update will mount the tls secret on the collector pod.
 func Update(jaeger *v1.Jaeger, commonSpec *v1.JaegerCommonSpec, options *[]string) {
 	if viper.GetString("platform") != v1.FlagPlatformOpenShift {
@@ -40,3 +53,71 @@ func Update(jaeger *v1.Jaeger, commonSpec *v1.JaegerCommonSpec, options *[]strin
 func configurationVolumeName(jaeger *v1.Jaeger) string {
 	return util.DNSName(fmt.Sprintf("%s-collector-tls-config-volume", jaeger.Name))
 }
+
+// UpdateQueryStorage will mount the secrets configured for the query's connection to the storage and enable TLS for
+// it, leaving the options that have an explicit value untouched
+func UpdateQueryStorage(jaeger *v1.Jaeger, commonSpec *v1.JaegerCommonSpec, options *[]string) {
+	if jaeger.Spec.Query.Storage == nil || jaeger.Spec.Query.Storage.TLS == nil {
+		return
+	}
+
+	var prefix string
+	switch jaeger.Spec.Storage.Type {
+	case v1.JaegerESStorage, v1.JaegerCassandraStorage:
+		prefix = fmt.Sprintf("--%s.tls", jaeger.Spec.Storage.Type.OptionsPrefix())
+	default:
+		return
+	}
+
+	spec := jaeger.Spec.Query.Storage.TLS
+	// the storages still accept the deprecated --es.tls and --cassandra.tls flags
+	if len(util.FindItem(prefix+".enabled=", *options)) == 0 && len(util.FindItem(prefix+"=", *options)) == 0 {
+		*options = append(*options, prefix+".enabled=true")
+	}
+
+	if len(spec.CASecretName) > 0 {
+		commonSpec.Volumes = append(commonSpec.Volumes, secretVolume(queryStorageCAVolumeName(jaeger), spec.CASecretName))
+		commonSpec.VolumeMounts = append(commonSpec.VolumeMounts, corev1.VolumeMount{
+			Name:      queryStorageCAVolumeName(jaeger),
+			MountPath: queryStorageCAMountPath,
+			ReadOnly:  true,
+		})
+		if len(util.FindItem(prefix+".ca=", *options)) == 0 {
+			*options = append(*options, fmt.Sprintf("%s.ca=%s", prefix, path.Join(queryStorageCAMountPath, StorageCAKey)))
+		}
+	}
+
+	if len(spec.SecretName) > 0 {
+		commonSpec.Volumes = append(commonSpec.Volumes, secretVolume(queryStorageVolumeName(jaeger), spec.SecretName))
+		commonSpec.VolumeMounts = append(commonSpec.VolumeMounts, corev1.VolumeMount{
+			Name:      queryStorageVolumeName(jaeger),
+			MountPath: queryStorageMountPath,
+			ReadOnly:  true,
+		})
+		if len(util.FindItem(prefix+".cert=", *options)) == 0 {
+			*options = append(*options, fmt.Sprintf("%s.cert=%s", prefix, path.Join(queryStorageMountPath, CertKey)))
+		}
+		if len(util.FindItem(prefix+".key=", *options)) == 0 {
+			*options = append(*options, fmt.Sprintf("%s.key=%s", prefix, path.Join(queryStorageMountPath, KeyKey)))
+		}
+	}
+}
+
+func secretVolume(name, secretName string) corev1.Volume {
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+			},
+		},
+	}
+}
+
+func queryStorageVolumeName(jaeger *v1.Jaeger) string {
+	return util.DNSName(util.Truncate("%s-query-storage-tls-config-volume", 63, jaeger.Name))
+}
+
+func queryStorageCAVolumeName(jaeger *v1.Jaeger) string {
+	return util.DNSName(util.Truncate("%s-query-storage-tls-ca-volume", 63, jaeger.Name))
+}
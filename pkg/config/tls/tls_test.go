@@ -25,3 +25,54 @@ func TestUpdateWithTLSSecret(t *testing.T) {
 	assert.Equal(t, "--collector.grpc.tls.cert=/etc/tls-config/tls.crt", options[1])
 	assert.Equal(t, "--collector.grpc.tls.key=/etc/tls-config/tls.key", options[2])
 }
+
+func TestUpdateQueryStorage(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestUpdateQueryStorage"})
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Query.Storage = &v1.JaegerQueryStorageSpec{
+		TLS: &v1.JaegerQueryStorageTLSSpec{CASecretName: "es-ca", SecretName: "query-es-client"},
+	}
+
+	commonSpec := v1.JaegerCommonSpec{}
+	options := []string{}
+
+	UpdateQueryStorage(jaeger, &commonSpec, &options)
+	assert.Len(t, commonSpec.Volumes, 2)
+	assert.Equal(t, "es-ca", commonSpec.Volumes[0].Secret.SecretName)
+	assert.Equal(t, "query-es-client", commonSpec.Volumes[1].Secret.SecretName)
+	assert.Len(t, commonSpec.VolumeMounts, 2)
+	assert.Equal(t, []string{
+		"--es.tls.enabled=true",
+		"--es.tls.ca=/etc/query-storage-tls-ca/ca.crt",
+		"--es.tls.cert=/etc/query-storage-tls-config/tls.crt",
+		"--es.tls.key=/etc/query-storage-tls-config/tls.key",
+	}, options)
+}
+
+func TestUpdateQueryStorageCassandraExplicitOptions(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestUpdateQueryStorageCassandraExplicitOptions"})
+	jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+	jaeger.Spec.Query.Storage = &v1.JaegerQueryStorageSpec{
+		TLS: &v1.JaegerQueryStorageTLSSpec{CASecretName: "cassandra-ca"},
+	}
+
+	commonSpec := v1.JaegerCommonSpec{}
+	options := []string{"--cassandra.tls=true", "--cassandra.tls.ca=/etc/custom/ca.crt"}
+
+	UpdateQueryStorage(jaeger, &commonSpec, &options)
+	assert.Len(t, commonSpec.Volumes, 1)
+	assert.Equal(t, []string{"--cassandra.tls=true", "--cassandra.tls.ca=/etc/custom/ca.crt"}, options)
+}
+
+func TestUpdateQueryStorageNotConfigured(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestUpdateQueryStorageNotConfigured"})
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Query.Storage = &v1.JaegerQueryStorageSpec{SecretName: "query-es"}
+
+	commonSpec := v1.JaegerCommonSpec{}
+	options := []string{}
+
+	UpdateQueryStorage(jaeger, &commonSpec, &options)
+	assert.Empty(t, commonSpec.Volumes)
+	assert.Empty(t, options)
+}
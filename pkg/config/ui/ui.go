@@ -22,18 +22,18 @@ func NewUIConfig(jaeger *v1.Jaeger) *UIConfig {
 
 // Get returns a configmap specification for the current instance
 func (u *UIConfig) Get() *corev1.ConfigMap {
+	uiOpts := uiOptions(u.jaeger)
 	// Check for empty map
-	if u.jaeger.Spec.UI.Options.IsEmpty() {
+	if uiOpts.IsEmpty() {
 		return nil
 	}
 
-	json, err := u.jaeger.Spec.UI.Options.MarshalJSON()
+	json, err := uiOpts.MarshalJSON()
 	if err != nil {
 		return nil
 	}
 
 	u.jaeger.Logger().Debug("Assembling the UI configmap")
-	trueVar := true
 	data := map[string]string{
 		"ui": string(json),
 	}
@@ -44,18 +44,10 @@ func (u *UIConfig) Get() *corev1.ConfigMap {
 			Kind:       "ConfigMap",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-ui-configuration", u.jaeger.Name),
-			Namespace: u.jaeger.Namespace,
-			Labels:    util.Labels(fmt.Sprintf("%s-ui-configuration", u.jaeger.Name), "ui-configuration", *u.jaeger),
-			OwnerReferences: []metav1.OwnerReference{
-				metav1.OwnerReference{
-					APIVersion: u.jaeger.APIVersion,
-					Kind:       u.jaeger.Kind,
-					Name:       u.jaeger.Name,
-					UID:        u.jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
+			Name:            fmt.Sprintf("%s-ui-configuration", u.jaeger.Name),
+			Namespace:       u.jaeger.Namespace,
+			Labels:          util.Labels(fmt.Sprintf("%s-ui-configuration", u.jaeger.Name), "ui-configuration", *u.jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(u.jaeger)},
 		},
 		Data: data,
 	}
@@ -65,7 +57,7 @@ func (u *UIConfig) Get() *corev1.ConfigMap {
 // support for the UI configmap if appropriate
 func Update(jaeger *v1.Jaeger, commonSpec *v1.JaegerCommonSpec, options *[]string) {
 	// Check for empty map
-	if jaeger.Spec.UI.Options.IsEmpty() {
+	if uiOptions(jaeger).IsEmpty() {
 		return
 	}
 
@@ -95,6 +87,32 @@ func Update(jaeger *v1.Jaeger, commonSpec *v1.JaegerCommonSpec, options *[]strin
 	*options = append(*options, "--query.ui-config=/etc/config/ui.json")
 }
 
+// uiOptions returns the UI options with the dependencies menu toggle applied. The toggle isn't stored in the
+// options, so that changing it later takes effect, and it takes precedence over the options' own value.
+func uiOptions(jaeger *v1.Jaeger) v1.FreeForm {
+	enabled := jaeger.Spec.UI.DependenciesMenuEnabled
+	if enabled == nil {
+		return jaeger.Spec.UI.Options
+	}
+
+	uiOpts, err := jaeger.Spec.UI.Options.GetMap()
+	if err != nil {
+		return jaeger.Spec.UI.Options
+	}
+	deps := map[string]interface{}{}
+	if val, ok := uiOpts["dependencies"]; ok {
+		if val, ok := val.(map[string]interface{}); ok {
+			deps = val
+		} else {
+			// we return as the type does not match
+			return jaeger.Spec.UI.Options
+		}
+	}
+	deps["menuEnabled"] = *enabled
+	uiOpts["dependencies"] = deps
+	return v1.NewFreeForm(uiOpts)
+}
+
 func configurationVolumeName(jaeger *v1.Jaeger) string {
 	return util.DNSName(util.Truncate("%s-ui-configuration-volume", 63, jaeger.Name))
 }
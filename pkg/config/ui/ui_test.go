@@ -42,6 +42,33 @@ func TestWithUIConfig(t *testing.T) {
 	assert.Equal(t, json, dep.Data["ui"])
 }
 
+func TestWithDependenciesMenu(t *testing.T) {
+	falseVar := false
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestWithDependenciesMenu"})
+	jaeger.Spec.UI.DependenciesMenuEnabled = &falseVar
+
+	dep := NewUIConfig(jaeger).Get()
+	assert.Equal(t, `{"dependencies":{"menuEnabled":false}}`, dep.Data["ui"])
+
+	// the toggle isn't stored in the options, and takes precedence over them
+	assert.True(t, jaeger.Spec.UI.Options.IsEmpty())
+	trueVar := true
+	jaeger.Spec.UI.DependenciesMenuEnabled = &trueVar
+	jaeger.Spec.UI.Options = v1.NewFreeForm(map[string]interface{}{
+		"dependencies": map[string]interface{}{"menuEnabled": false},
+		"tracking":     map[string]interface{}{"gaID": "UA-000000-2"},
+	})
+
+	dep = NewUIConfig(jaeger).Get()
+	assert.Equal(t, `{"dependencies":{"menuEnabled":true},"tracking":{"gaID":"UA-000000-2"}}`, dep.Data["ui"])
+
+	commonSpec := v1.JaegerCommonSpec{}
+	options := []string{}
+	jaeger.Spec.UI.Options = v1.FreeForm{}
+	Update(jaeger, &commonSpec, &options)
+	assert.Equal(t, []string{"--query.ui-config=/etc/config/ui.json"}, options)
+}
+
 func TestUpdateNoUIConfig(t *testing.T) {
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestUpdateNoUIConfig"})
 
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	log "github.com/sirupsen/logrus"
-	"github.com/spf13/viper"
 	"go.opentelemetry.io/otel/api/key"
 	"go.opentelemetry.io/otel/global"
 	"google.golang.org/grpc/codes"
@@ -24,6 +23,7 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
 	"github.com/jaegertracing/jaeger-operator/pkg/inject"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 // Add creates a new Deployment Controller and adds it to the Manager. The Manager will set fields on the Controller
@@ -113,19 +113,22 @@ func (r *ReconcileDeployment) Reconcile(request reconcile.Request) (reconcile.Re
 	}
 
 	if inject.Needed(dep, ns) {
-		jaegers := &v1.JaegerList{}
-		opts := []client.ListOption{}
-
-		if viper.GetString(v1.ConfigOperatorScope) == v1.OperatorScopeNamespace {
-			opts = append(opts, client.InNamespace(viper.GetString(v1.ConfigWatchNamespace)))
-		}
-
-		if err := r.rClient.List(ctx, jaegers, opts...); err != nil {
+		jaegers, err := util.ListJaegers(ctx, r.rClient)
+		if err != nil {
 			log.WithError(err).Error("failed to get the available Jaeger pods")
 			return reconcile.Result{}, tracing.HandleError(err, span)
 		}
 
-		jaeger := inject.Select(dep, ns, jaegers)
+		jaeger, err := inject.Select(dep, ns, jaegers)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"deployment": dep.Name,
+				"namespace":  dep.Namespace,
+			}).WithError(err).Error("failed to select the Jaeger instance, skipping sidecar injection")
+			tracing.HandleError(err, span)
+			return reconcile.Result{}, nil
+		}
+
 		if jaeger != nil && jaeger.GetDeletionTimestamp() == nil {
 			if jaeger.Namespace != request.Namespace {
 				log.WithFields(log.Fields{
@@ -212,6 +215,12 @@ func (r *ReconcileDeployment) syncOnJaegerChanges(event handler.MapObject) []rec
 			continue
 		}
 
+		// if the deployment is forcing this instance, trigger a reconciliation
+		if _, ok := dep.Annotations[inject.AnnotationInstance]; ok {
+			reconciliations = append(reconciliations, req)
+			continue
+		}
+
 		// if we don't have the namespace in the cache yet, retrieve it
 		var ns corev1.Namespace
 		if ns, ok = nss[dep.Namespace]; !ok {
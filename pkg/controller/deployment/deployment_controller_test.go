@@ -134,3 +134,45 @@ func TestSyncOnJaegerChanges(t *testing.T) {
 
 	assert.Equal(t, expected, requests)
 }
+
+func TestSyncOnJaegerChangesForcedInstance(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{
+		Namespace: "observability",
+		Name:      "my-instance",
+	})
+
+	objs := []runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: "ns-without-annotation",
+		}},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "dep-with-instance-annotation",
+				Namespace: "ns-without-annotation",
+				Annotations: map[string]string{
+					inject.AnnotationInstance: "observability/my-instance",
+				},
+			},
+		},
+	}
+
+	cl := fake.NewFakeClient(objs...)
+	r := &ReconcileDeployment{
+		client:  cl,
+		rClient: cl,
+		scheme:  scheme.Scheme,
+	}
+
+	// test
+	requests := r.syncOnJaegerChanges(handler.MapObject{
+		Meta:   &jaeger.ObjectMeta,
+		Object: jaeger,
+	})
+
+	// verify
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Name:      "dep-with-instance-annotation",
+		Namespace: "ns-without-annotation",
+	}}}, requests)
+}
@@ -6,8 +6,11 @@ import (
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/global"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/jaegertracing/jaeger-operator/pkg/account"
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/inventory"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
@@ -36,7 +39,7 @@ func (r *ReconcileJaeger) applyAccounts(ctx context.Context, jaeger v1.Jaeger, d
 			"account":   d.Name,
 			"namespace": d.Namespace,
 		}).Debug("creating service account")
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.create(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -46,7 +49,7 @@ func (r *ReconcileJaeger) applyAccounts(ctx context.Context, jaeger v1.Jaeger, d
 			"account":   d.Name,
 			"namespace": d.Namespace,
 		}).Debug("updating service account")
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.update(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -56,7 +59,31 @@ func (r *ReconcileJaeger) applyAccounts(ctx context.Context, jaeger v1.Jaeger, d
 			"account":   d.Name,
 			"namespace": d.Namespace,
 		}).Debug("deleting service account")
-		if err := r.client.Delete(ctx, &d); err != nil {
+		if err := r.delete(ctx, jaeger, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+	}
+
+	return nil
+}
+
+// checkExistingAccounts warns about the service accounts referenced by the Jaeger instance that do not exist,
+// as those are not created by the operator and the pods using them would fail to be scheduled
+func (r *ReconcileJaeger) checkExistingAccounts(ctx context.Context, jaeger v1.Jaeger) error {
+	tracer := global.TraceProvider().GetTracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "checkExistingAccounts")
+	defer span.End()
+
+	for _, name := range account.Existing(&jaeger) {
+		sa := &corev1.ServiceAccount{}
+		if err := r.rClient.Get(ctx, types.NamespacedName{Namespace: jaeger.Namespace, Name: name}, sa); err != nil {
+			if k8serrors.IsNotFound(err) {
+				jaeger.Logger().WithFields(log.Fields{
+					"account":   name,
+					"namespace": jaeger.Namespace,
+				}).Warn("the referenced service account doesn't exist and isn't managed by the operator")
+				continue
+			}
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -188,3 +190,36 @@ func TestAccountCreateExistingNameInAnotherNamespace(t *testing.T) {
 	assert.Equal(t, nsnExisting.Name, persistedExisting.Name)
 	assert.Equal(t, nsnExisting.Namespace, persistedExisting.Namespace)
 }
+
+func TestCheckExistingAccounts(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestCheckExistingAccounts", Namespace: "observability"}
+	jaeger := v1.NewJaeger(nsn)
+	jaeger.Spec.Collector.ServiceAccount = "present-sa"
+	jaeger.Spec.Query.ServiceAccount = "missing-sa"
+
+	present := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "present-sa",
+			Namespace: nsn.Namespace,
+		},
+	}
+	r, _ := getReconciler([]runtime.Object{present})
+
+	hook := test.NewGlobal()
+	defer hook.Reset()
+
+	// test
+	err := r.checkExistingAccounts(context.Background(), *jaeger)
+
+	// verify
+	assert.NoError(t, err)
+	warnings := []*logrus.Entry{}
+	for _, entry := range hook.AllEntries() {
+		if entry.Level == logrus.WarnLevel {
+			warnings = append(warnings, entry)
+		}
+	}
+	assert.Len(t, warnings, 1)
+	assert.Equal(t, "missing-sa", warnings[0].Data["account"])
+}
@@ -0,0 +1,52 @@
+package jaeger
+
+import (
+	"os"
+	"reflect"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+const (
+	auditActionCreate = "create"
+	auditActionUpdate = "update"
+	auditActionDelete = "delete"
+)
+
+// auditLogger records the changes the operator performs, independently from the operator's own log settings
+var auditLogger = &log.Logger{
+	Out:       os.Stderr,
+	Formatter: &log.JSONFormatter{},
+	Hooks:     make(log.LevelHooks),
+	Level:     log.InfoLevel,
+}
+
+// audit records the action performed on the given object on behalf of the given instance, when the audit log is enabled
+func audit(action string, jaeger v1.Jaeger, obj runtime.Object) {
+	if !viper.GetBool(v1.ConfigAuditLog) {
+		return
+	}
+
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if len(kind) == 0 {
+		kind = reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+	}
+
+	fields := log.Fields{
+		"action":            action,
+		"kind":              kind,
+		"instance":          jaeger.Name,
+		"instanceNamespace": jaeger.Namespace,
+	}
+	if accessor, err := meta.Accessor(obj); err == nil {
+		fields["name"] = accessor.GetName()
+		fields["namespace"] = accessor.GetNamespace()
+	}
+
+	auditLogger.WithFields(fields).Info("reconcile action")
+}
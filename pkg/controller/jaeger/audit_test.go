@@ -0,0 +1,102 @@
+package jaeger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestAuditCreateUpdateDelete(t *testing.T) {
+	// prepare
+	viper.Set(v1.ConfigAuditLog, true)
+	defer viper.Reset()
+
+	buf := &bytes.Buffer{}
+	out := auditLogger.Out
+	auditLogger.Out = buf
+	defer func() { auditLogger.Out = out }()
+
+	nsn := types.NamespacedName{Name: "TestAuditCreateUpdateDelete", Namespace: "observability"}
+	jaeger := v1.NewJaeger(nsn)
+	r, _ := getReconciler([]runtime.Object{})
+
+	cm := corev1.ConfigMap{}
+	cm.Name = "my-config"
+	cm.Namespace = nsn.Namespace
+
+	// test
+	require.NoError(t, r.create(context.Background(), *jaeger, &cm))
+	cm.Data = map[string]string{"key": "value"}
+	require.NoError(t, r.update(context.Background(), *jaeger, &cm))
+	require.NoError(t, r.delete(context.Background(), *jaeger, &cm))
+
+	// verify
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	for i, action := range []string{"create", "update", "delete"} {
+		entry := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal([]byte(lines[i]), &entry))
+		assert.Equal(t, action, entry["action"])
+		assert.Equal(t, "ConfigMap", entry["kind"])
+		assert.Equal(t, "my-config", entry["name"])
+		assert.Equal(t, "observability", entry["namespace"])
+		assert.Equal(t, nsn.Name, entry["instance"])
+		assert.Equal(t, nsn.Namespace, entry["instanceNamespace"])
+	}
+}
+
+func TestAuditDisabledByDefault(t *testing.T) {
+	// prepare
+	buf := &bytes.Buffer{}
+	out := auditLogger.Out
+	auditLogger.Out = buf
+	defer func() { auditLogger.Out = out }()
+
+	r, _ := getReconciler([]runtime.Object{})
+	cm := corev1.ConfigMap{}
+	cm.Name = "my-config"
+
+	// test
+	require.NoError(t, r.create(context.Background(), *v1.NewJaeger(types.NamespacedName{Name: "TestAuditDisabledByDefault"}), &cm))
+
+	// verify
+	assert.Empty(t, buf.String())
+}
+
+func TestAuditSkippedUpdate(t *testing.T) {
+	// prepare
+	viper.Set(v1.ConfigAuditLog, true)
+	viper.Set(v1.ConfigConflictPolicy, v1.FlagConflictPolicySkip)
+	defer viper.Reset()
+
+	buf := &bytes.Buffer{}
+	out := auditLogger.Out
+	auditLogger.Out = buf
+	defer func() { auditLogger.Out = out }()
+
+	nsn := types.NamespacedName{Name: "TestAuditSkippedUpdate"}
+	orig := corev1.ConfigMap{}
+	orig.Name = nsn.Name
+	r, cl := getReconciler([]runtime.Object{&orig})
+
+	stale := &corev1.ConfigMap{}
+	require.NoError(t, cl.Get(context.Background(), nsn, stale))
+	require.NoError(t, cl.Update(context.Background(), stale.DeepCopy()))
+
+	// test
+	require.NoError(t, r.update(context.Background(), *v1.NewJaeger(nsn), stale))
+
+	// verify
+	assert.Empty(t, buf.String())
+}
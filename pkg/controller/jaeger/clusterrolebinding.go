@@ -39,7 +39,7 @@ func (r *ReconcileJaeger) applyClusterRoleBindingBindings(ctx context.Context, j
 			"clusteRoleBinding": d.Name,
 			"namespace":         d.Namespace,
 		}).Debug("creating cluster role binding")
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.create(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -49,7 +49,7 @@ func (r *ReconcileJaeger) applyClusterRoleBindingBindings(ctx context.Context, j
 			"clusteRoleBinding": d.Name,
 			"namespace":         d.Namespace,
 		}).Debug("updating cluster role binding")
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.update(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -59,7 +59,7 @@ func (r *ReconcileJaeger) applyClusterRoleBindingBindings(ctx context.Context, j
 			"clusteRoleBinding": d.Name,
 			"namespace":         d.Namespace,
 		}).Debug("deleting cluster role binding")
-		if err := r.client.Delete(ctx, &d); err != nil {
+		if err := r.delete(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
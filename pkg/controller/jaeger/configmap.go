@@ -3,9 +3,11 @@ package jaeger
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/global"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
@@ -36,7 +38,7 @@ func (r *ReconcileJaeger) applyConfigMaps(ctx context.Context, jaeger v1.Jaeger,
 			"configMap": d.Name,
 			"namespace": d.Namespace,
 		}).Debug("creating config maps")
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.create(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -46,7 +48,7 @@ func (r *ReconcileJaeger) applyConfigMaps(ctx context.Context, jaeger v1.Jaeger,
 			"configMap": d.Name,
 			"namespace": d.Namespace,
 		}).Debug("updating config maps")
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.update(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -56,10 +58,36 @@ func (r *ReconcileJaeger) applyConfigMaps(ctx context.Context, jaeger v1.Jaeger,
 			"configMap": d.Name,
 			"namespace": d.Namespace,
 		}).Debug("deleting config maps")
-		if err := r.client.Delete(ctx, &d); err != nil {
+		if err := r.delete(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
 
 	return nil
 }
+
+// checkConfigFiles makes sure that the config maps referenced as configuration files exist and have the referenced
+// entries, unless they are marked as optional
+func (r *ReconcileJaeger) checkConfigFiles(ctx context.Context, jaeger v1.Jaeger) error {
+	tracer := global.TraceProvider().GetTracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "checkConfigFiles")
+	defer span.End()
+
+	for _, ref := range []*corev1.ConfigMapKeySelector{jaeger.Spec.AllInOne.ConfigFile, jaeger.Spec.Collector.ConfigFile} {
+		if ref == nil || (ref.Optional != nil && *ref.Optional) {
+			continue
+		}
+
+		cm := &corev1.ConfigMap{}
+		if err := r.rClient.Get(ctx, types.NamespacedName{Namespace: jaeger.Namespace, Name: ref.Name}, cm); err != nil {
+			return tracing.HandleError(errors.Wrapf(err, "failed to get the config map %s with the configuration file", ref.Name), span)
+		}
+		_, inData := cm.Data[ref.Key]
+		_, inBinaryData := cm.BinaryData[ref.Key]
+		if !inData && !inBinaryData {
+			return tracing.HandleError(errors.Errorf("the config map %s has no %s entry for the configuration file", ref.Name, ref.Key), span)
+		}
+	}
+
+	return nil
+}
@@ -194,3 +194,37 @@ func TestConfigMapCreateExistingNameInAnotherNamespace(t *testing.T) {
 	assert.Equal(t, nsnExisting.Name, persistedExisting.Name)
 	assert.Equal(t, nsnExisting.Namespace, persistedExisting.Namespace)
 }
+
+func TestCheckConfigFiles(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCheckConfigFiles"})
+	jaeger.Spec.AllInOne.ConfigFile = &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "jaeger-config"},
+		Key:                  "config.yaml",
+	}
+
+	objs := []runtime.Object{
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "jaeger-config"},
+			Data:       map[string]string{"config.yaml": "log-level: debug"},
+		},
+	}
+	r, _ := getReconciler(objs)
+
+	// test and verify
+	assert.NoError(t, r.checkConfigFiles(context.Background(), *jaeger))
+
+	jaeger.Spec.AllInOne.ConfigFile.Key = "missing.yaml"
+	assert.Error(t, r.checkConfigFiles(context.Background(), *jaeger))
+
+	jaeger.Spec.AllInOne.ConfigFile = nil
+	jaeger.Spec.Collector.ConfigFile = &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "missing"},
+		Key:                  "config.yaml",
+	}
+	assert.Error(t, r.checkConfigFiles(context.Background(), *jaeger))
+
+	optional := true
+	jaeger.Spec.Collector.ConfigFile.Optional = &optional
+	assert.NoError(t, r.checkConfigFiles(context.Background(), *jaeger))
+}
@@ -42,7 +42,7 @@ func (r *ReconcileJaeger) applyConsoleLinks(ctx context.Context, jaeger v1.Jaege
 			"consoleLink": d.Name,
 			"namespace":   d.Namespace,
 		}).Debug("creating console link")
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.create(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -52,7 +52,7 @@ func (r *ReconcileJaeger) applyConsoleLinks(ctx context.Context, jaeger v1.Jaege
 			"consoleLink": d.Name,
 			"namespace":   d.Namespace,
 		}).Debug("updating console link")
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.update(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -62,7 +62,7 @@ func (r *ReconcileJaeger) applyConsoleLinks(ctx context.Context, jaeger v1.Jaege
 			"consoleLink": d.Name,
 			"namespace":   d.Namespace,
 		}).Debug("deleting console link")
-		if err := r.client.Delete(ctx, &d); err != nil {
+		if err := r.delete(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -36,7 +36,7 @@ func (r *ReconcileJaeger) applyCronJobs(ctx context.Context, jaeger v1.Jaeger, d
 			"cronjob":   d.Name,
 			"namespace": d.Namespace,
 		}).Debug("creating cronjob")
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.create(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -46,7 +46,7 @@ func (r *ReconcileJaeger) applyCronJobs(ctx context.Context, jaeger v1.Jaeger, d
 			"cronjob":   d.Name,
 			"namespace": d.Namespace,
 		}).Debug("updating cronjob")
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.update(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -56,7 +56,7 @@ func (r *ReconcileJaeger) applyCronJobs(ctx context.Context, jaeger v1.Jaeger, d
 			"cronjob":   d.Name,
 			"namespace": d.Namespace,
 		}).Debug("deleting cronjob")
-		if err := r.client.Delete(ctx, &d); err != nil {
+		if err := r.delete(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
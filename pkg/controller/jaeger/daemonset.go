@@ -36,7 +36,7 @@ func (r *ReconcileJaeger) applyDaemonSets(ctx context.Context, jaeger v1.Jaeger,
 			"daemonset": d.Name,
 			"namespace": d.Namespace,
 		}).Debug("creating daemonset")
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.create(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -46,7 +46,7 @@ func (r *ReconcileJaeger) applyDaemonSets(ctx context.Context, jaeger v1.Jaeger,
 			"daemonset": d.Name,
 			"namespace": d.Namespace,
 		}).Debug("updating daemonset")
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.update(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -56,7 +56,7 @@ func (r *ReconcileJaeger) applyDaemonSets(ctx context.Context, jaeger v1.Jaeger,
 			"daemonset": d.Name,
 			"namespace": d.Namespace,
 		}).Debug("deleting daemonset")
-		if err := r.client.Delete(ctx, &d); err != nil {
+		if err := r.delete(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -26,13 +26,13 @@ var (
 	ErrDependencyRemoved = errors.New("dependency has been removed")
 )
 
-func (r *ReconcileJaeger) handleDependencies(ctx context.Context, str strategy.S) error {
+func (r *ReconcileJaeger) handleDependencies(ctx context.Context, jaeger v1.Jaeger, str strategy.S) error {
 	tracer := global.TraceProvider().GetTracer(v1.ReconciliationTracer)
 	ctx, span := tracer.Start(ctx, "handleDependencies")
 	defer span.End()
 
 	for _, dep := range str.Dependencies() {
-		err := r.handleDependency(ctx, str, dep)
+		err := r.handleDependency(ctx, jaeger, dep)
 		if err != nil {
 			span.SetStatus(codes.Internal)
 			span.SetAttribute(key.String("error", err.Error()))
@@ -43,7 +43,7 @@ func (r *ReconcileJaeger) handleDependencies(ctx context.Context, str strategy.S
 	return nil
 }
 
-func (r *ReconcileJaeger) handleDependency(ctx context.Context, str strategy.S, dep batchv1.Job) error {
+func (r *ReconcileJaeger) handleDependency(ctx context.Context, jaeger v1.Jaeger, dep batchv1.Job) error {
 	tracer := global.TraceProvider().GetTracer(v1.ReconciliationTracer)
 	ctx, span := tracer.Start(ctx, "handleDependency")
 	defer span.End()
@@ -53,7 +53,7 @@ func (r *ReconcileJaeger) handleDependency(ctx context.Context, str strategy.S,
 		key.String("dependency.namespace", dep.Namespace),
 	)
 
-	err := r.client.Create(ctx, &dep)
+	err := r.create(ctx, jaeger, &dep)
 	if err != nil && !apierrors.IsAlreadyExists(err) {
 		span.SetStatus(codes.Internal)
 		span.SetAttribute(key.String("error", err.Error()))
@@ -15,6 +15,7 @@ import (
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/inventory"
+	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 	esv1 "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
 )
@@ -24,6 +25,29 @@ var (
 	ErrElasticsearchRemoved = errors.New("Elasticsearch cluster has been removed")
 )
 
+// checkExistingElasticsearch makes sure that the Elasticsearch CR referenced by the Jaeger instance exists, as
+// the operator doesn't manage its lifecycle
+func (r *ReconcileJaeger) checkExistingElasticsearch(ctx context.Context, jaeger v1.Jaeger) error {
+	tracer := global.TraceProvider().GetTracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "checkExistingElasticsearch")
+	defer span.End()
+
+	ref, ok := storage.ExistingElasticsearch(&jaeger)
+	if !ok || !storage.ShouldDeployElasticsearch(jaeger.Spec.Storage) {
+		return nil
+	}
+
+	es := &esv1.Elasticsearch{}
+	if err := r.rClient.Get(ctx, ref, es); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return tracing.HandleError(errors.Errorf("the referenced Elasticsearch cluster %s/%s doesn't exist", ref.Namespace, ref.Name), span)
+		}
+		return tracing.HandleError(err, span)
+	}
+
+	return nil
+}
+
 func (r *ReconcileJaeger) applyElasticsearches(ctx context.Context, jaeger v1.Jaeger, desired []esv1.Elasticsearch) error {
 	tracer := global.TraceProvider().GetTracer(v1.ReconciliationTracer)
 	ctx, span := tracer.Start(ctx, "applyElasticsearches")
@@ -47,7 +71,7 @@ func (r *ReconcileJaeger) applyElasticsearches(ctx context.Context, jaeger v1.Ja
 			"elasticsearch": d.Name,
 			"namespace":     d.Namespace,
 		}).Debug("creating elasticsearch")
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.create(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 		if err := waitForAvailableElastic(ctx, r.client, d); err != nil {
@@ -60,7 +84,7 @@ func (r *ReconcileJaeger) applyElasticsearches(ctx context.Context, jaeger v1.Ja
 			"elasticsearch": d.Name,
 			"namespace":     d.Namespace,
 		}).Debug("updating elasticsearch")
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.update(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -70,7 +94,7 @@ func (r *ReconcileJaeger) applyElasticsearches(ctx context.Context, jaeger v1.Ja
 			"elasticsearch": d.Name,
 			"namespace":     d.Namespace,
 		}).Debug("deleting elasticsearch")
-		if err := r.client.Delete(ctx, &d); err != nil {
+		if err := r.delete(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -207,3 +207,29 @@ func TestElasticsearchesCreateExistingNameInAnotherNamespace(t *testing.T) {
 	assert.Equal(t, nsnExisting.Name, persistedExisting.Name)
 	assert.Equal(t, nsnExisting.Namespace, persistedExisting.Namespace)
 }
+
+func TestCheckExistingElasticsearch(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCheckExistingElasticsearch"})
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Storage.Elasticsearch.Existing = &v1.ElasticsearchReference{Name: "elasticsearch", Namespace: "openshift-logging"}
+
+	existing := &esv1.Elasticsearch{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "elasticsearch",
+			Namespace: "openshift-logging",
+		},
+	}
+
+	r, _ := getReconciler([]runtime.Object{existing})
+
+	// test and verify
+	assert.NoError(t, r.checkExistingElasticsearch(context.Background(), *jaeger))
+
+	jaeger.Spec.Storage.Elasticsearch.Existing.Name = "missing"
+	assert.Error(t, r.checkExistingElasticsearch(context.Background(), *jaeger))
+
+	// not relevant when the storage isn't self-provisioned
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"es.server-urls": "http://elasticsearch:9200"})
+	assert.NoError(t, r.checkExistingElasticsearch(context.Background(), *jaeger))
+}
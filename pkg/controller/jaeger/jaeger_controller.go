@@ -3,6 +3,7 @@ package jaeger
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"reflect"
 	"strings"
 	"time"
@@ -16,19 +17,27 @@ import (
 	"google.golang.org/grpc/codes"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+	"github.com/jaegertracing/jaeger-operator/pkg/deployment"
+	"github.com/jaegertracing/jaeger-operator/pkg/ingress"
+	"github.com/jaegertracing/jaeger-operator/pkg/networkpolicy"
+	"github.com/jaegertracing/jaeger-operator/pkg/service"
 	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 // Add creates a new Jaeger Controller and adds it to the Manager. The Manager will set fields on the Controller
@@ -56,8 +65,13 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		d.Start()
 	}
 
-	// Watch for changes to primary resource Jaeger
-	err = c.Watch(&source.Kind{Type: &v1.Jaeger{}}, &handler.EnqueueRequestForObject{})
+	selector, err := util.InstanceSelector()
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to primary resource Jaeger, ignoring the instances not matching the instance selector
+	err = c.Watch(&source.Kind{Type: &v1.Jaeger{}}, &handler.EnqueueRequestForObject{}, instanceSelectorPredicate(selector))
 	if err != nil {
 		return err
 	}
@@ -65,6 +79,13 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	return nil
 }
 
+// instanceSelectorPredicate filters out the events for the Jaeger instances whose labels don't match the given selector
+func instanceSelectorPredicate(selector labels.Selector) predicate.Funcs {
+	return predicate.NewPredicateFuncs(func(meta metav1.Object, _ runtime.Object) bool {
+		return selector.Matches(labels.Set(meta.GetLabels()))
+	})
+}
+
 var _ reconcile.Reconciler = &ReconcileJaeger{}
 
 // ReconcileJaeger reconciles a Jaeger object
@@ -122,6 +143,10 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 		return reconcile.Result{}, err
 	}
 
+	for _, warning := range warnings(instance) {
+		instance.Logger().Warn(warning)
+	}
+
 	// note: we need a namespace-scoped owner identity, which makes the `OwnerReference`
 	// not suitable for this purpose
 	identity := viper.GetString(v1.ConfigIdentity)
@@ -140,7 +165,7 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 		}
 
 		instance.Labels[v1.LabelOperatedBy] = identity
-		if err := r.client.Update(ctx, instance); err != nil {
+		if err := r.client.Update(ctx, instance, fieldOwner()); err != nil {
 			// update the status to "Failed"
 			instance.Status.Phase = v1.JaegerPhaseFailed
 			if err := r.client.Status().Update(ctx, instance); err != nil {
@@ -153,6 +178,7 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 			).WithError(err).Error("failed to set this operator as the manager of the instance")
 			return reconcile.Result{}, tracing.HandleError(err, span)
 		}
+		audit(auditActionUpdate, *instance, instance)
 
 		logFields.WithField("operator-identity", identity).Debug("configured this operator as the owner of the CR")
 		return reconcile.Result{}, nil
@@ -167,6 +193,10 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 	str := r.runStrategyChooser(ctx, instance)
 
 	updated, err := r.apply(ctx, *instance, str)
+	if errors.Is(err, errPreUpgradeJobPending) {
+		logFields.WithField("requeue-after", preUpgradeJobRequeue).Info("waiting for the pre-upgrade job to complete before upgrading the instance")
+		return reconcile.Result{RequeueAfter: preUpgradeJobRequeue}, nil
+	}
 	if err != nil {
 		// update the status to "Failed"
 		instance.Status.Phase = v1.JaegerPhaseFailed
@@ -182,10 +212,11 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 
 	if !reflect.DeepEqual(originalInstance, *instance) {
 		// we store back the changed CR, so that what is stored reflects what is being used
-		if err := r.client.Update(ctx, instance); err != nil {
+		if err := r.client.Update(ctx, instance, fieldOwner()); err != nil {
 			logFields.WithError(err).Error("failed to store back the current CustomResource")
 			return reconcile.Result{}, tracing.HandleError(err, span)
 		}
+		audit(auditActionUpdate, *instance, instance)
 	}
 
 	// set the status version to the updated instance version if versions doesn't match
@@ -207,16 +238,236 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 	return reconcile.Result{}, nil
 }
 
+// validateInternalTracing makes sure that the components report the spans about themselves to a valid endpoint,
+// other than the collector of the same instance, which would then trace the handling of its own spans in a loop
+func validateInternalTracing(jaeger *v1.Jaeger, tracing *v1.JaegerInternalTracingSpec) error {
+	if tracing == nil {
+		return nil
+	}
+
+	endpoint, err := url.Parse(tracing.Endpoint)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse the endpoint")
+	}
+	if endpoint.Host == "" {
+		return errors.Errorf("the endpoint %q must be an absolute URL", tracing.Endpoint)
+	}
+
+	host := endpoint.Hostname()
+	for _, name := range []string{service.GetNameForCollectorService(jaeger), service.GetNameForHeadlessCollectorService(jaeger)} {
+		for _, own := range []string{
+			name,
+			fmt.Sprintf("%s.%s", name, jaeger.Namespace),
+			fmt.Sprintf("%s.%s.svc", name, jaeger.Namespace),
+			fmt.Sprintf("%s.%s.svc.cluster.local", name, jaeger.Namespace),
+		} {
+			if strings.EqualFold(host, own) {
+				return errors.Errorf("the endpoint %q points to the collector of the same instance", tracing.Endpoint)
+			}
+		}
+	}
+
+	switch tracing.SamplerType {
+	case "", "const", "probabilistic", "ratelimiting", "remote":
+	default:
+		return errors.Errorf("unknown sampler type %q, possible values: const, probabilistic, ratelimiting, remote", tracing.SamplerType)
+	}
+
+	return nil
+}
+
+// defaultKafkaTopic is the topic used by the collector and ingester when none is specified
+const defaultKafkaTopic = "jaeger-spans"
+
 // validate validates CR before processing it
 func validate(jaeger *v1.Jaeger) error {
-	if jaeger.Spec.Storage.EsRollover.ReadTTL != "" {
-		if _, err := time.ParseDuration(jaeger.Spec.Storage.EsRollover.ReadTTL); err != nil {
-			return errors.Wrap(err, "failed to parse esRollover.readTTL to time.Duration")
+	if err := storage.Validate(jaeger.Spec.Storage); err != nil {
+		return err
+	}
+
+	for _, validator := range []interface{ Validate() error }{
+		deployment.NewCollector(jaeger),
+		deployment.NewIngester(jaeger),
+		deployment.NewAgent(jaeger),
+		deployment.NewQuery(jaeger),
+		ingress.NewZipkinIngress(jaeger),
+		ingress.NewCollectorIngress(jaeger),
+	} {
+		if err := validator.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := networkpolicy.Validate(jaeger); err != nil {
+		return err
+	}
+
+	for _, c := range []struct {
+		name string
+		spec v1.JaegerCommonSpec
+	}{
+		{"", jaeger.Spec.JaegerCommonSpec},
+		{"allInOne.", jaeger.Spec.AllInOne.JaegerCommonSpec},
+		{"query.", jaeger.Spec.Query.JaegerCommonSpec},
+	} {
+		if err := validateInternalTracing(jaeger, c.spec.InternalTracing); err != nil {
+			return errors.Wrapf(err, "invalid %sinternalTracing", c.name)
+		}
+	}
+	if jaeger.Spec.Collector.InternalTracing != nil || jaeger.Spec.Ingester.InternalTracing != nil {
+		return errors.New("the collector and the ingester don't report spans about themselves, internalTracing is only supported for the query and the all-in-one")
+	}
+	for _, c := range []struct {
+		name           string
+		spec           v1.JaegerCommonSpec
+		tracingEnabled *bool
+	}{
+		{"allInOne", jaeger.Spec.AllInOne.JaegerCommonSpec, jaeger.Spec.AllInOne.TracingEnabled},
+		{"query", jaeger.Spec.Query.JaegerCommonSpec, jaeger.Spec.Query.TracingEnabled},
+	} {
+		if c.spec.InternalTracing != nil && c.tracingEnabled != nil && !*c.tracingEnabled {
+			return errors.Errorf("%s.internalTracing can't be set when %s.tracingEnabled is false", c.name, c.name)
+		}
+	}
+
+	if interval := jaeger.Spec.Sampling.ReloadInterval; len(interval) > 0 {
+		d, err := time.ParseDuration(interval)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse sampling.reloadInterval to time.Duration")
+		}
+		if d < 0 {
+			return errors.Errorf("the sampling reload interval can't be negative, got %s", interval)
+		}
+	}
+
+	for name, commonSpec := range map[string]v1.JaegerCommonSpec{
+		"spec":                   jaeger.Spec.JaegerCommonSpec,
+		"allInOne":               jaeger.Spec.AllInOne.JaegerCommonSpec,
+		"collector":              jaeger.Spec.Collector.JaegerCommonSpec,
+		"query":                  jaeger.Spec.Query.JaegerCommonSpec,
+		"ingester":               jaeger.Spec.Ingester.JaegerCommonSpec,
+		"agent":                  jaeger.Spec.Agent.JaegerCommonSpec,
+		"storage.esIndexCleaner": jaeger.Spec.Storage.EsIndexCleaner.JaegerCommonSpec,
+		"storage.esRollover":     jaeger.Spec.Storage.EsRollover.JaegerCommonSpec,
+		"storage.dependencies":   jaeger.Spec.Storage.Dependencies.JaegerCommonSpec,
+	} {
+		switch commonSpec.TerminationMessagePolicy {
+		case "", corev1.TerminationMessageReadFile, corev1.TerminationMessageFallbackToLogsOnError:
+		default:
+			return errors.Errorf("%s.terminationMessagePolicy has to be either %s or %s, got %s", name,
+				corev1.TerminationMessageReadFile, corev1.TerminationMessageFallbackToLogsOnError, commonSpec.TerminationMessagePolicy)
+		}
+
+		switch commonSpec.ImagePullPolicy {
+		case "", corev1.PullAlways, corev1.PullIfNotPresent, corev1.PullNever:
+		default:
+			return errors.Errorf("%s.imagePullPolicy has to be one of %s, %s or %s, got %s", name,
+				corev1.PullAlways, corev1.PullIfNotPresent, corev1.PullNever, commonSpec.ImagePullPolicy)
+		}
+	}
+
+	if !jaeger.Spec.UI.Options.IsEmpty() {
+		if _, err := jaeger.Spec.UI.Options.GetMap(); err != nil {
+			return errors.Wrap(err, "the ui.options are not a valid JSON object")
+		}
+	}
+
+	for _, c := range []struct {
+		component  string
+		commonSpec v1.JaegerCommonSpec
+	}{
+		{"jaeger", jaeger.Spec.JaegerCommonSpec},
+		{"allInOne", jaeger.Spec.AllInOne.JaegerCommonSpec},
+		{"query", jaeger.Spec.Query.JaegerCommonSpec},
+		{"collector", jaeger.Spec.Collector.JaegerCommonSpec},
+		{"ingester", jaeger.Spec.Ingester.JaegerCommonSpec},
+		{"agent", jaeger.Spec.Agent.JaegerCommonSpec},
+		{"storage.esIndexCleaner", jaeger.Spec.Storage.EsIndexCleaner.JaegerCommonSpec},
+		{"storage.esRollover", jaeger.Spec.Storage.EsRollover.JaegerCommonSpec},
+		{"storage.dependencies", jaeger.Spec.Storage.Dependencies.JaegerCommonSpec},
+	} {
+		if err := util.ValidateLogLevel(c.commonSpec.LogLevel); err != nil {
+			return errors.Wrapf(err, "failed to validate the log level for %s", c.component)
+		}
+		for _, sidecar := range c.commonSpec.Sidecars {
+			if reservedContainerNames[sidecar.Name] {
+				return errors.Errorf("the sidecar name %q for %s collides with a container managed by the operator", sidecar.Name, c.component)
+			}
+		}
+	}
+
+	for name, ref := range map[string]*corev1.ConfigMapKeySelector{
+		"allInOne":  jaeger.Spec.AllInOne.ConfigFile,
+		"collector": jaeger.Spec.Collector.ConfigFile,
+	} {
+		if ref != nil && (ref.Name == "" || ref.Key == "") {
+			return errors.Errorf("the name and the key of the config map for %s.configFile must not be empty", name)
+		}
+	}
+
+	// the flag-based all-in-one has no OTLP receiver, it's only available via the OpenTelemetry config
+	if jaeger.Spec.AllInOne.OTLPEnabled {
+		if otelConf, err := jaeger.Spec.AllInOne.Config.GetMap(); err != nil || len(otelConf) == 0 {
+			return errors.New("allInOne.otlpEnabled requires an OpenTelemetry config in allInOne.config")
+		}
+	}
+
+	if jaeger.Spec.Strategy == v1.DeploymentStrategyStreaming {
+		producerTopic := kafkaTopic(jaeger.Spec.Collector.KafkaTopic, "kafka.producer.topic", jaeger.Spec.Collector.Options, jaeger.Spec.Storage.Options)
+		consumerTopic := kafkaTopic(jaeger.Spec.Ingester.KafkaTopic, "kafka.consumer.topic", jaeger.Spec.Ingester.Options)
+		if producerTopic != consumerTopic {
+			return errors.Errorf("the collector's Kafka topic (%s) doesn't match the ingester's Kafka topic (%s)", producerTopic, consumerTopic)
 		}
 	}
 	return nil
 }
 
+// warnings returns the messages about settings that are valid, but most likely not what the user wants
+func warnings(jaeger *v1.Jaeger) []string {
+	var res []string
+
+	if partitions := jaeger.Spec.Ingester.KafkaPartitions; partitions != nil {
+		for _, r := range []struct {
+			field    string
+			replicas *int32
+		}{
+			{field: "replicas", replicas: jaeger.Spec.Ingester.Replicas},
+			{field: "minReplicas", replicas: jaeger.Spec.Ingester.MinReplicas},
+			{field: "maxReplicas", replicas: jaeger.Spec.Ingester.MaxReplicas},
+		} {
+			if r.replicas != nil && *r.replicas > *partitions {
+				res = append(res, fmt.Sprintf("ingester.%s (%d) exceeds the number of Kafka partitions (%d), the extra ingester replicas won't consume any spans", r.field, *r.replicas, *partitions))
+			}
+		}
+	}
+
+	return res
+}
+
+// reservedContainerNames are the names of the containers managed by the operator, which sidecars can't use
+var reservedContainerNames = map[string]bool{
+	"jaeger":                 true,
+	"jaeger-agent":           true,
+	"jaeger-agent-daemonset": true,
+	"jaeger-collector":       true,
+	"jaeger-ingester":        true,
+	"jaeger-query":           true,
+	"oauth-proxy":            true,
+}
+
+// kafkaTopic returns the effective Kafka topic, giving precedence to the explicit options over the structured field
+func kafkaTopic(topic, option string, opts ...v1.Options) string {
+	for _, o := range opts {
+		if val, ok := o.Map()[option]; ok && len(val) > 0 {
+			return val
+		}
+	}
+	if len(topic) > 0 {
+		return topic
+	}
+	return defaultKafkaTopic
+}
+
 func (r *ReconcileJaeger) runStrategyChooser(ctx context.Context, instance *v1.Jaeger) strategy.S {
 	if nil == r.strategyChooser {
 		return defaultStrategyChooser(ctx, instance)
@@ -239,6 +490,26 @@ func (r *ReconcileJaeger) apply(ctx context.Context, jaeger v1.Jaeger, str strat
 		return jaeger, tracing.HandleError(err, span)
 	}
 
+	if err := r.checkExistingElasticsearch(ctx, jaeger); err != nil {
+		return jaeger, tracing.HandleError(err, span)
+	}
+
+	if err := r.checkQueryStorageTLSSecrets(ctx, jaeger); err != nil {
+		return jaeger, tracing.HandleError(err, span)
+	}
+
+	if err := r.checkSamplingSecret(ctx, jaeger); err != nil {
+		return jaeger, tracing.HandleError(err, span)
+	}
+
+	if err := r.checkEsIndexCleanerSecret(ctx, jaeger); err != nil {
+		return jaeger, tracing.HandleError(err, span)
+	}
+
+	if err := r.checkConfigFiles(ctx, jaeger); err != nil {
+		return jaeger, tracing.HandleError(err, span)
+	}
+
 	// ES cert handling requires secrets from environment
 	// therefore running this here and not in the strategy
 	if storage.ShouldDeployElasticsearch(jaeger.Spec.Storage) {
@@ -304,8 +575,12 @@ func (r *ReconcileJaeger) apply(ctx context.Context, jaeger v1.Jaeger, str strat
 		return jaeger, tracing.HandleError(err, span)
 	}
 
+	if err := r.checkExistingAccounts(ctx, jaeger); err != nil {
+		return jaeger, tracing.HandleError(err, span)
+	}
+
 	// storage dependencies have to be deployed after ES is ready
-	if err := r.handleDependencies(ctx, str); err != nil {
+	if err := r.handleDependencies(ctx, jaeger, str); err != nil {
 		return jaeger, tracing.HandleError(err, span)
 	}
 
@@ -328,6 +603,11 @@ func (r *ReconcileJaeger) apply(ctx context.Context, jaeger v1.Jaeger, str strat
 		return jaeger, tracing.HandleError(err, span)
 	}
 
+	// the network policies are in place before the deployments, so that the pods are reachable once they are ready
+	if err := r.applyNetworkPolicies(ctx, jaeger, str.NetworkPolicies()); err != nil {
+		return jaeger, tracing.HandleError(err, span)
+	}
+
 	if err := r.applyDeployments(ctx, jaeger, str.Deployments()); err != nil {
 		return jaeger, tracing.HandleError(err, span)
 	}
@@ -11,11 +11,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
@@ -153,6 +155,270 @@ func TestSkipOnNonOwnedCR(t *testing.T) {
 	assert.Equal(t, v1.JaegerPhase(""), persisted.Status.Phase)
 }
 
+func TestInstanceSelectorPredicate(t *testing.T) {
+	selector, err := labels.Parse("team=payments")
+	assert.NoError(t, err)
+	p := instanceSelectorPredicate(selector)
+
+	matching := v1.NewJaeger(types.NamespacedName{Name: "matching"})
+	matching.Labels = map[string]string{"team": "payments"}
+	other := v1.NewJaeger(types.NamespacedName{Name: "other"})
+	other.Labels = map[string]string{"team": "checkout"}
+
+	assert.True(t, p.Create(event.CreateEvent{Meta: matching, Object: matching}))
+	assert.False(t, p.Create(event.CreateEvent{Meta: other, Object: other}))
+	assert.False(t, p.Update(event.UpdateEvent{MetaOld: other, ObjectOld: other, MetaNew: other, ObjectNew: other}))
+	assert.False(t, p.Delete(event.DeleteEvent{Meta: other, Object: other}))
+
+	// the default, empty selector matches all the instances
+	p = instanceSelectorPredicate(labels.Everything())
+	assert.True(t, p.Create(event.CreateEvent{Meta: other, Object: other}))
+}
+
+func TestValidateLogLevel(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidateLogLevel"})
+	jaeger.Spec.LogLevel = "info"
+	jaeger.Spec.Collector.LogLevel = "debug"
+	assert.NoError(t, validate(jaeger))
+
+	jaeger.Spec.Collector.LogLevel = "verbose"
+	err := validate(jaeger)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "collector")
+}
+
+func TestValidateUIOptions(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidateUIOptions"})
+	jaeger.Spec.UI.Options = v1.NewFreeForm(map[string]interface{}{"dependencies": map[string]interface{}{"menuEnabled": false}})
+	assert.NoError(t, validate(jaeger))
+
+	assert.NoError(t, jaeger.Spec.UI.Options.UnmarshalJSON([]byte(`["not", "an", "object"]`)))
+	assert.Error(t, validate(jaeger))
+}
+
+func TestWarningsIngesterReplicasExceedKafkaPartitions(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestWarningsIngesterReplicasExceedKafkaPartitions"})
+	partitions := int32(6)
+	replicas := int32(10)
+	jaeger.Spec.Ingester.MaxReplicas = &replicas
+	assert.Empty(t, warnings(jaeger))
+
+	jaeger.Spec.Ingester.KafkaPartitions = &partitions
+	w := warnings(jaeger)
+	assert.Len(t, w, 1)
+	assert.Contains(t, w[0], "ingester.maxReplicas (10)")
+
+	jaeger.Spec.Ingester.Replicas = &replicas
+	jaeger.Spec.Ingester.MaxReplicas = &partitions
+	w = warnings(jaeger)
+	assert.Len(t, w, 1)
+	assert.Contains(t, w[0], "ingester.replicas (10)")
+}
+
+func TestValidateComponents(t *testing.T) {
+	enabled := true
+	zero := int32(0)
+	negative := int32(-1)
+	for _, tt := range []struct {
+		name   string
+		modify func(jaeger *v1.Jaeger)
+	}{
+		{name: "storage", modify: func(jaeger *v1.Jaeger) { jaeger.Spec.Storage.EsNumShards = &negative }},
+		{name: "collector", modify: func(jaeger *v1.Jaeger) { jaeger.Spec.Collector.QueueSize = &zero }},
+		{name: "ingester", modify: func(jaeger *v1.Jaeger) { jaeger.Spec.Ingester.KafkaPartitions = &zero }},
+		{name: "agent", modify: func(jaeger *v1.Jaeger) { jaeger.Spec.Agent.ProcessorWorkers = &zero }},
+		{name: "query", modify: func(jaeger *v1.Jaeger) { jaeger.Spec.Query.Zones = []string{""} }},
+		{name: "zipkin ingress", modify: func(jaeger *v1.Jaeger) { jaeger.Spec.Ingress.Zipkin.Enabled = &enabled }},
+		{name: "collector ingress", modify: func(jaeger *v1.Jaeger) { jaeger.Spec.Ingress.Collector.Enabled = &enabled }},
+		{name: "network policy", modify: func(jaeger *v1.Jaeger) { jaeger.Spec.NetworkPolicy.QueryIngressCIDRs = []string{"10.0.0.1"} }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidateComponents"})
+			assert.NoError(t, validate(jaeger))
+
+			tt.modify(jaeger)
+			assert.Error(t, validate(jaeger))
+		})
+	}
+}
+
+func TestValidateInternalTracing(t *testing.T) {
+	for _, tt := range []struct {
+		endpoint    string
+		samplerType string
+		err         bool
+	}{
+		{endpoint: "http://meta-collector.observability:14268/api/traces"},
+		{endpoint: "http://meta-collector.observability:14268/api/traces", samplerType: "const"},
+		{endpoint: "http://meta-collector.observability:14268/api/traces", samplerType: "adaptive", err: true},
+		{endpoint: "meta-collector:14268", err: true},
+		{endpoint: "", err: true},
+		{endpoint: "http://my-instance-collector:14268/api/traces", err: true},
+		{endpoint: "http://my-instance-collector-headless.observability.svc:14268/api/traces", err: true},
+		{endpoint: "http://my-instance-collector.other:14268/api/traces"},
+	} {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+			jaeger.Spec.Query.InternalTracing = &v1.JaegerInternalTracingSpec{Endpoint: tt.endpoint, SamplerType: tt.samplerType}
+
+			err := validate(jaeger)
+			if tt.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateInternalTracingScope(t *testing.T) {
+	tracing := &v1.JaegerInternalTracingSpec{Endpoint: "http://meta-collector.observability:14268/api/traces"}
+	disabled := false
+
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidateInternalTracingScope"})
+	jaeger.Spec.Collector.InternalTracing = tracing
+	assert.Error(t, validate(jaeger))
+
+	jaeger = v1.NewJaeger(types.NamespacedName{Name: "TestValidateInternalTracingScope"})
+	jaeger.Spec.Ingester.InternalTracing = tracing
+	assert.Error(t, validate(jaeger))
+
+	jaeger = v1.NewJaeger(types.NamespacedName{Name: "TestValidateInternalTracingScope"})
+	jaeger.Spec.Query.InternalTracing = tracing
+	jaeger.Spec.Query.TracingEnabled = &disabled
+	assert.Error(t, validate(jaeger))
+
+	jaeger = v1.NewJaeger(types.NamespacedName{Name: "TestValidateInternalTracingScope"})
+	jaeger.Spec.AllInOne.InternalTracing = tracing
+	jaeger.Spec.AllInOne.TracingEnabled = &disabled
+	assert.Error(t, validate(jaeger))
+
+	// the general setting is skipped by the components with tracing disabled
+	jaeger = v1.NewJaeger(types.NamespacedName{Name: "TestValidateInternalTracingScope"})
+	jaeger.Spec.InternalTracing = tracing
+	jaeger.Spec.Query.TracingEnabled = &disabled
+	assert.NoError(t, validate(jaeger))
+}
+
+func TestValidateSidecarNames(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidateSidecarNames"})
+	jaeger.Spec.Collector.Sidecars = []corev1.Container{{Name: "log-shipper"}}
+	assert.NoError(t, validate(jaeger))
+
+	for _, name := range []string{"jaeger-agent", "oauth-proxy", "jaeger-query"} {
+		jaeger.Spec.Query.Sidecars = []corev1.Container{{Name: name}}
+		err := validate(jaeger)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "query")
+	}
+}
+
+func TestValidateConfigFile(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidateConfigFile"})
+	jaeger.Spec.Collector.ConfigFile = &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "jaeger-config"},
+		Key:                  "config.yaml",
+	}
+	assert.NoError(t, validate(jaeger))
+
+	jaeger.Spec.Collector.ConfigFile.Key = ""
+	assert.Error(t, validate(jaeger))
+}
+
+func TestValidateAllInOneOTLP(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidateAllInOneOTLP"})
+	jaeger.Spec.AllInOne.OTLPEnabled = true
+	assert.Error(t, validate(jaeger))
+
+	jaeger.Spec.AllInOne.Config = v1.NewFreeForm(map[string]interface{}{"receivers": map[string]interface{}{"otlp": nil}})
+	assert.NoError(t, validate(jaeger))
+}
+
+func TestValidateTerminationMessagePolicy(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidateTerminationMessagePolicy"})
+	jaeger.Spec.Collector.TerminationMessagePolicy = corev1.TerminationMessageReadFile
+	assert.NoError(t, validate(jaeger))
+
+	jaeger.Spec.Collector.TerminationMessagePolicy = "Logs"
+	assert.Error(t, validate(jaeger))
+}
+
+func TestValidateImagePullPolicy(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidateImagePullPolicy"})
+	jaeger.Spec.Query.ImagePullPolicy = corev1.PullIfNotPresent
+	assert.NoError(t, validate(jaeger))
+
+	jaeger.Spec.Storage.EsIndexCleaner.ImagePullPolicy = "Sometimes"
+	assert.Error(t, validate(jaeger))
+}
+
+func TestValidateSamplingReloadInterval(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidateSamplingReloadInterval"})
+	jaeger.Spec.Sampling.ReloadInterval = "30s"
+	assert.NoError(t, validate(jaeger))
+
+	jaeger.Spec.Sampling.ReloadInterval = "30"
+	assert.Error(t, validate(jaeger))
+
+	jaeger.Spec.Sampling.ReloadInterval = "-1m"
+	assert.Error(t, validate(jaeger))
+}
+
+func TestValidateKafkaTopics(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		producerTopic   string
+		producerOptions map[string]interface{}
+		consumerTopic   string
+		consumerOptions map[string]interface{}
+		valid           bool
+	}{
+		{name: "defaults", valid: true},
+		{name: "matching fields", producerTopic: "spans", consumerTopic: "spans", valid: true},
+		{name: "mismatching fields", producerTopic: "spans", consumerTopic: "other-spans", valid: false},
+		{name: "only producer", producerTopic: "spans", valid: false},
+		{name: "field and matching option", producerTopic: "spans", consumerOptions: map[string]interface{}{"kafka.consumer.topic": "spans"}, valid: true},
+		{name: "option overrides field", producerTopic: "spans", producerOptions: map[string]interface{}{"kafka.producer.topic": "other-spans"}, consumerTopic: "spans", valid: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidateKafkaTopics"})
+			jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+			jaeger.Spec.Collector.KafkaTopic = tt.producerTopic
+			jaeger.Spec.Collector.Options = v1.NewOptions(tt.producerOptions)
+			jaeger.Spec.Ingester.KafkaTopic = tt.consumerTopic
+			jaeger.Spec.Ingester.Options = v1.NewOptions(tt.consumerOptions)
+
+			err := validate(jaeger)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateKafkaTopicsIgnoredWithoutStreaming(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidateKafkaTopicsIgnoredWithoutStreaming"})
+	jaeger.Spec.Collector.KafkaTopic = "spans"
+	assert.NoError(t, validate(jaeger))
+}
+
+func TestRejectInvalidLogLevel(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestRejectInvalidLogLevel"}
+	jaeger := v1.NewJaeger(nsn)
+	jaeger.Spec.Storage.EsIndexCleaner.LogLevel = "trace"
+
+	r, _ := getReconciler([]runtime.Object{jaeger})
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	assert.Error(t, err)
+}
+
 func TestGetResourceFromNonCachedClient(t *testing.T) {
 	// prepare
 	nsn := types.NamespacedName{Name: "my-instance"}
@@ -48,7 +48,7 @@ func (r *ReconcileJaeger) applyKafkaUsers(ctx context.Context, jaeger v1.Jaeger,
 			"kafka":     d.GetName(),
 			"namespace": d.GetNamespace(),
 		}).Debug("creating kafka users")
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.create(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -58,7 +58,7 @@ func (r *ReconcileJaeger) applyKafkaUsers(ctx context.Context, jaeger v1.Jaeger,
 			"kafka":     d.GetName(),
 			"namespace": d.GetNamespace(),
 		}).Debug("updating kafka user")
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.update(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -80,7 +80,7 @@ func (r *ReconcileJaeger) applyKafkaUsers(ctx context.Context, jaeger v1.Jaeger,
 			"kafka":     d.GetName(),
 			"namespace": d.GetNamespace(),
 		}).Debug("deleting kafka user")
-		if err := r.client.Delete(ctx, &d); err != nil {
+		if err := r.delete(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
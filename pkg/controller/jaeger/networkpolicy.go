@@ -0,0 +1,65 @@
+package jaeger
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/global"
+	networkingv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/inventory"
+	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+)
+
+func (r *ReconcileJaeger) applyNetworkPolicies(ctx context.Context, jaeger v1.Jaeger, desired []networkingv1.NetworkPolicy) error {
+	tracer := global.TraceProvider().GetTracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "applyNetworkPolicies")
+	defer span.End()
+
+	opts := []client.ListOption{
+		client.InNamespace(jaeger.Namespace),
+		client.MatchingLabels(map[string]string{
+			"app.kubernetes.io/instance":   jaeger.Name,
+			"app.kubernetes.io/managed-by": "jaeger-operator",
+		}),
+	}
+	policyList := &networkingv1.NetworkPolicyList{}
+	if err := r.rClient.List(ctx, policyList, opts...); err != nil {
+		return tracing.HandleError(err, span)
+	}
+
+	policyInventory := inventory.ForNetworkPolicies(policyList.Items, desired)
+	for _, d := range policyInventory.Create {
+		jaeger.Logger().WithFields(log.Fields{
+			"networkPolicy": d.Name,
+			"namespace":     d.Namespace,
+		}).Debug("creating network policy")
+		if err := r.create(ctx, jaeger, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+	}
+
+	for _, d := range policyInventory.Update {
+		jaeger.Logger().WithFields(log.Fields{
+			"networkPolicy": d.Name,
+			"namespace":     d.Namespace,
+		}).Debug("updating network policy")
+		if err := r.update(ctx, jaeger, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+	}
+
+	for _, d := range policyInventory.Delete {
+		jaeger.Logger().WithFields(log.Fields{
+			"networkPolicy": d.Name,
+			"namespace":     d.Namespace,
+		}).Debug("deleting network policy")
+		if err := r.delete(ctx, jaeger, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+	}
+
+	return nil
+}
@@ -2,13 +2,18 @@ package jaeger
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/global"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/sampling"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/tls"
 	"github.com/jaegertracing/jaeger-operator/pkg/inventory"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
 )
@@ -36,7 +41,7 @@ func (r *ReconcileJaeger) applySecrets(ctx context.Context, jaeger v1.Jaeger, de
 			"secret":    d.Name,
 			"namespace": d.Namespace,
 		}).Debug("creating secrets")
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.create(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -46,7 +51,7 @@ func (r *ReconcileJaeger) applySecrets(ctx context.Context, jaeger v1.Jaeger, de
 			"secret":    d.Name,
 			"namespace": d.Namespace,
 		}).Debug("updating secrets")
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.update(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -56,10 +61,102 @@ func (r *ReconcileJaeger) applySecrets(ctx context.Context, jaeger v1.Jaeger, de
 			"secret":    d.Name,
 			"namespace": d.Namespace,
 		}).Debug("deleting secrets")
-		if err := r.client.Delete(ctx, &d); err != nil {
+		if err := r.delete(ctx, jaeger, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
 
 	return nil
 }
+
+// checkQueryStorageTLSSecrets makes sure that the secrets referenced by the TLS configuration of the query's connection
+// to the storage exist and have the entries that get mounted into the query pods
+func (r *ReconcileJaeger) checkQueryStorageTLSSecrets(ctx context.Context, jaeger v1.Jaeger) error {
+	tracer := global.TraceProvider().GetTracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "checkQueryStorageTLSSecrets")
+	defer span.End()
+
+	if jaeger.Spec.Query.Storage == nil || jaeger.Spec.Query.Storage.TLS == nil {
+		return nil
+	}
+
+	spec := jaeger.Spec.Query.Storage.TLS
+	expected := map[string][]string{}
+	if len(spec.CASecretName) > 0 {
+		expected[spec.CASecretName] = append(expected[spec.CASecretName], tls.StorageCAKey)
+	}
+	if len(spec.SecretName) > 0 {
+		expected[spec.SecretName] = append(expected[spec.SecretName], tls.CertKey, tls.KeyKey)
+	}
+
+	for name, keys := range expected {
+		secret := &corev1.Secret{}
+		if err := r.rClient.Get(ctx, types.NamespacedName{Namespace: jaeger.Namespace, Name: name}, secret); err != nil {
+			return tracing.HandleError(errors.Wrapf(err, "failed to get the secret %s for the query's storage TLS", name), span)
+		}
+		for _, key := range keys {
+			if _, ok := secret.Data[key]; !ok {
+				return tracing.HandleError(errors.Errorf("the secret %s for the query's storage TLS has no %s entry", name, key), span)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkSamplingSecret makes sure that the secret referenced by the sampling configuration exists and holds
+// the strategies in the JSON format
+func (r *ReconcileJaeger) checkSamplingSecret(ctx context.Context, jaeger v1.Jaeger) error {
+	tracer := global.TraceProvider().GetTracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "checkSamplingSecret")
+	defer span.End()
+
+	name := jaeger.Spec.Sampling.SecretName
+	if len(name) == 0 {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.rClient.Get(ctx, types.NamespacedName{Namespace: jaeger.Namespace, Name: name}, secret); err != nil {
+		return tracing.HandleError(errors.Wrapf(err, "failed to get the sampling secret %s", name), span)
+	}
+
+	key := sampling.SecretKey(&jaeger)
+	data, ok := secret.Data[key]
+	if !ok {
+		return tracing.HandleError(errors.Errorf("the sampling secret %s has no %s entry", name, key), span)
+	}
+
+	strategies := map[string]interface{}{}
+	if err := json.Unmarshal(data, &strategies); err != nil {
+		return tracing.HandleError(errors.Wrapf(err, "failed to parse the sampling strategies from the secret %s", name), span)
+	}
+
+	return nil
+}
+
+// checkEsIndexCleanerSecret makes sure that the secret with the index cleaner's own credentials exists and holds
+// both the username and the password
+func (r *ReconcileJaeger) checkEsIndexCleanerSecret(ctx context.Context, jaeger v1.Jaeger) error {
+	tracer := global.TraceProvider().GetTracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "checkEsIndexCleanerSecret")
+	defer span.End()
+
+	name := jaeger.Spec.Storage.EsIndexCleaner.SecretName
+	if len(name) == 0 {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.rClient.Get(ctx, types.NamespacedName{Namespace: jaeger.Namespace, Name: name}, secret); err != nil {
+		return tracing.HandleError(errors.Wrapf(err, "failed to get the index cleaner's secret %s", name), span)
+	}
+
+	for _, key := range []string{"ES_USERNAME", "ES_PASSWORD"} {
+		if _, ok := secret.Data[key]; !ok {
+			return tracing.HandleError(errors.Errorf("the index cleaner's secret %s has no %s entry", name, key), span)
+		}
+	}
+
+	return nil
+}
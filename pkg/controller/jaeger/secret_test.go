@@ -194,3 +194,109 @@ func TestSecretsCreateExistingNameInAnotherNamespace(t *testing.T) {
 	assert.Equal(t, nsnExisting.Name, persistedExisting.Name)
 	assert.Equal(t, nsnExisting.Namespace, persistedExisting.Namespace)
 }
+
+func TestCheckQueryStorageTLSSecrets(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCheckQueryStorageTLSSecrets"})
+	jaeger.Spec.Query.Storage = &v1.JaegerQueryStorageSpec{
+		TLS: &v1.JaegerQueryStorageTLSSpec{CASecretName: "es-ca", SecretName: "query-es-client"},
+	}
+
+	objs := []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "query-es-client"},
+			Data:       map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "es-ca"},
+			Data:       map[string][]byte{"ca.crt": []byte("ca")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "incomplete"},
+			Data:       map[string][]byte{"tls.crt": []byte("cert")},
+		},
+	}
+	r, _ := getReconciler(objs)
+
+	// test and verify
+	assert.NoError(t, r.checkQueryStorageTLSSecrets(context.Background(), *jaeger))
+
+	jaeger.Spec.Query.Storage.TLS.SecretName = "incomplete"
+	assert.Error(t, r.checkQueryStorageTLSSecrets(context.Background(), *jaeger))
+
+	jaeger.Spec.Query.Storage.TLS = &v1.JaegerQueryStorageTLSSpec{CASecretName: "query-es-client"}
+	assert.Error(t, r.checkQueryStorageTLSSecrets(context.Background(), *jaeger))
+
+	jaeger.Spec.Query.Storage.TLS = &v1.JaegerQueryStorageTLSSpec{CASecretName: "missing"}
+	assert.Error(t, r.checkQueryStorageTLSSecrets(context.Background(), *jaeger))
+
+	jaeger.Spec.Query.Storage.TLS = nil
+	assert.NoError(t, r.checkQueryStorageTLSSecrets(context.Background(), *jaeger))
+}
+
+func TestCheckSamplingSecret(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCheckSamplingSecret"})
+	jaeger.Spec.Sampling.SecretName = "sampling"
+
+	objs := []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "sampling"},
+			Data: map[string][]byte{
+				"sampling": []byte(`{"default_strategy":{"type":"probabilistic","param":0.5}}`),
+				"invalid":  []byte(`{"default_strategy":`),
+			},
+		},
+	}
+	r, _ := getReconciler(objs)
+
+	// test and verify
+	assert.NoError(t, r.checkSamplingSecret(context.Background(), *jaeger))
+
+	jaeger.Spec.Sampling.SecretKey = "invalid"
+	assert.Error(t, r.checkSamplingSecret(context.Background(), *jaeger))
+
+	jaeger.Spec.Sampling.SecretKey = "missing"
+	assert.Error(t, r.checkSamplingSecret(context.Background(), *jaeger))
+
+	jaeger.Spec.Sampling = v1.JaegerSamplingSpec{SecretName: "missing"}
+	assert.Error(t, r.checkSamplingSecret(context.Background(), *jaeger))
+
+	jaeger.Spec.Sampling = v1.JaegerSamplingSpec{}
+	assert.NoError(t, r.checkSamplingSecret(context.Background(), *jaeger))
+}
+
+func TestCheckEsIndexCleanerSecret(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCheckEsIndexCleanerSecret"})
+	jaeger.Spec.Storage.EsIndexCleaner.SecretName = "cleaner"
+
+	objs := []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cleaner"},
+			Data: map[string][]byte{
+				"ES_USERNAME": []byte("cleaner"),
+				"ES_PASSWORD": []byte("changeme"),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-password"},
+			Data: map[string][]byte{
+				"ES_USERNAME": []byte("cleaner"),
+			},
+		},
+	}
+	r, _ := getReconciler(objs)
+
+	// test and verify
+	assert.NoError(t, r.checkEsIndexCleanerSecret(context.Background(), *jaeger))
+
+	jaeger.Spec.Storage.EsIndexCleaner.SecretName = "no-password"
+	assert.Error(t, r.checkEsIndexCleanerSecret(context.Background(), *jaeger))
+
+	jaeger.Spec.Storage.EsIndexCleaner.SecretName = "missing"
+	assert.Error(t, r.checkEsIndexCleanerSecret(context.Background(), *jaeger))
+
+	jaeger.Spec.Storage.EsIndexCleaner.SecretName = ""
+	assert.NoError(t, r.checkEsIndexCleanerSecret(context.Background(), *jaeger))
+}
@@ -0,0 +1,90 @@
+package jaeger
+
+import (
+	"context"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+// fieldOwner returns the field manager to be recorded for the objects the operator creates or updates
+func fieldOwner() client.FieldOwner {
+	if owner := viper.GetString(v1.ConfigFieldManager); len(owner) > 0 {
+		return client.FieldOwner(owner)
+	}
+	return client.FieldOwner(v1.DefaultFieldManager)
+}
+
+// create stores the given new object, recording it in the audit log
+func (r *ReconcileJaeger) create(ctx context.Context, jaeger v1.Jaeger, obj runtime.Object) error {
+	if err := r.client.Create(ctx, obj, fieldOwner()); err != nil {
+		return err
+	}
+	audit(auditActionCreate, jaeger, obj)
+	return nil
+}
+
+// delete removes the given object, recording it in the audit log
+func (r *ReconcileJaeger) delete(ctx context.Context, jaeger v1.Jaeger, obj runtime.Object) error {
+	if err := r.client.Delete(ctx, obj); err != nil {
+		return err
+	}
+	audit(auditActionDelete, jaeger, obj)
+	return nil
+}
+
+// update stores the given object, resolving conflicts with newer versions of the object based on the configured policy
+func (r *ReconcileJaeger) update(ctx context.Context, jaeger v1.Jaeger, obj runtime.Object) error {
+	err := r.client.Update(ctx, obj, fieldOwner())
+	if err == nil {
+		audit(auditActionUpdate, jaeger, obj)
+		return nil
+	}
+	if !k8serrors.IsConflict(err) {
+		return err
+	}
+
+	accessor, aErr := meta.Accessor(obj)
+	if aErr != nil {
+		return aErr
+	}
+
+	logFields := jaeger.Logger().WithFields(log.Fields{
+		"kind":      obj.GetObjectKind().GroupVersionKind().Kind,
+		"name":      accessor.GetName(),
+		"namespace": accessor.GetNamespace(),
+	})
+
+	switch strings.ToLower(viper.GetString(v1.ConfigConflictPolicy)) {
+	case v1.FlagConflictPolicySkip:
+		logFields.WithError(err).Info("skipping the update, as the object has been modified in the meantime")
+		return nil
+	case v1.FlagConflictPolicyForce:
+		latest := obj.DeepCopyObject()
+		key := client.ObjectKey{Namespace: accessor.GetNamespace(), Name: accessor.GetName()}
+		if err := r.rClient.Get(ctx, key, latest); err != nil {
+			return err
+		}
+		latestAccessor, err := meta.Accessor(latest)
+		if err != nil {
+			return err
+		}
+
+		logFields.Debug("forcing the update on top of the latest version of the object")
+		accessor.SetResourceVersion(latestAccessor.GetResourceVersion())
+		if err := r.client.Update(ctx, obj, fieldOwner()); err != nil {
+			return err
+		}
+		audit(auditActionUpdate, jaeger, obj)
+		return nil
+	default:
+		return err
+	}
+}
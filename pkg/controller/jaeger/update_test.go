@@ -0,0 +1,77 @@
+package jaeger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestFieldOwnerDefault(t *testing.T) {
+	assert.Equal(t, client.FieldOwner("jaeger-operator"), fieldOwner())
+}
+
+func TestFieldOwnerCustom(t *testing.T) {
+	viper.Set(v1.ConfigFieldManager, "my-field-manager")
+	defer viper.Reset()
+
+	assert.Equal(t, client.FieldOwner("my-field-manager"), fieldOwner())
+}
+
+func TestUpdateConflictPolicy(t *testing.T) {
+	for _, tt := range []struct {
+		policy   string
+		err      bool
+		expected string
+	}{
+		{policy: "", err: true, expected: "concurrent-value"},
+		{policy: v1.FlagConflictPolicyFail, err: true, expected: "concurrent-value"},
+		{policy: v1.FlagConflictPolicySkip, err: false, expected: "concurrent-value"},
+		{policy: v1.FlagConflictPolicyForce, err: false, expected: "new-value"},
+	} {
+		t.Run(tt.policy, func(t *testing.T) {
+			// prepare
+			viper.Set(v1.ConfigConflictPolicy, tt.policy)
+			defer viper.Reset()
+
+			nsn := types.NamespacedName{Name: "TestUpdateConflictPolicy"}
+			orig := corev1.ConfigMap{}
+			orig.Name = nsn.Name
+			orig.Data = map[string]string{"key": "value"}
+
+			r, cl := getReconciler([]runtime.Object{&orig})
+
+			stale := &corev1.ConfigMap{}
+			require.NoError(t, cl.Get(context.Background(), nsn, stale))
+
+			// someone else changes the object in the meantime
+			concurrent := stale.DeepCopy()
+			concurrent.Data["key"] = "concurrent-value"
+			require.NoError(t, cl.Update(context.Background(), concurrent))
+
+			// test
+			stale.Data["key"] = "new-value"
+			err := r.update(context.Background(), *v1.NewJaeger(nsn), stale)
+
+			// verify
+			if tt.err {
+				assert.True(t, k8serrors.IsConflict(err))
+			} else {
+				assert.NoError(t, err)
+			}
+
+			persisted := &corev1.ConfigMap{}
+			require.NoError(t, cl.Get(context.Background(), nsn, persisted))
+			assert.Equal(t, tt.expected, persisted.Data["key"])
+		})
+	}
+}
@@ -2,15 +2,36 @@ package jaeger
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/global"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
 	"github.com/jaegertracing/jaeger-operator/pkg/upgrade"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
 	"github.com/jaegertracing/jaeger-operator/pkg/version"
 )
 
+const (
+	// preUpgradeJobDeadline is the default deadline of the pre-upgrade job, in seconds
+	preUpgradeJobDeadline = int64(3600)
+
+	// preUpgradeJobRequeue is the interval at which the pre-upgrade job is checked while it runs
+	preUpgradeJobRequeue = 10 * time.Second
+)
+
+// errPreUpgradeJobPending signals that the upgrade waits for the pre-upgrade job to complete
+var errPreUpgradeJobPending = errors.New("waiting for the pre-upgrade job to complete")
+
 func (r *ReconcileJaeger) applyUpgrades(ctx context.Context, jaeger v1.Jaeger) (v1.Jaeger, error) {
 	tracer := global.TraceProvider().GetTracer(v1.ReconciliationTracer)
 	ctx, span := tracer.Start(ctx, "applyUpgrades")
@@ -20,6 +41,18 @@ func (r *ReconcileJaeger) applyUpgrades(ctx context.Context, jaeger v1.Jaeger) (
 
 	if len(jaeger.Status.Version) > 0 {
 		if jaeger.Status.Version != currentVersions.Jaeger {
+			// the upgrade is blocked until the pre-upgrade job, if any, completes successfully
+			if job := preUpgradeJob(jaeger, currentVersions.Jaeger); job != nil {
+				jaeger.Logger().WithFields(log.Fields{
+					"job":     job.Name,
+					"current": jaeger.Status.Version,
+					"target":  currentVersions.Jaeger,
+				}).Debug("checking the pre-upgrade job")
+				if err := r.checkPreUpgradeJob(ctx, jaeger, *job); err != nil {
+					return jaeger, tracing.HandleError(err, span)
+				}
+			}
+
 			// in theory, the version from the Status could be higher than currentVersions.Jaeger, but we let the upgrade routine
 			// check/handle it
 			upgraded, err := upgrade.ManagedInstance(ctx, r.client, jaeger, currentVersions.Jaeger)
@@ -35,3 +68,76 @@ func (r *ReconcileJaeger) applyUpgrades(ctx context.Context, jaeger v1.Jaeger) (
 	jaeger.Status.Version = currentVersions.Jaeger
 	return jaeger, nil
 }
+
+// preUpgradeJob returns the job to run before upgrading the given instance to the target version, or nil if none has been specified
+func preUpgradeJob(jaeger v1.Jaeger, target string) *batchv1.Job {
+	if jaeger.Spec.Upgrade.PreUpgradeJob == nil {
+		return nil
+	}
+
+	// one job per target version, so that a completed job doesn't unblock future upgrades
+	name := util.Truncate("%s-pre-upgrade-%s", 63, jaeger.Name, strings.ReplaceAll(target, ".", "-"))
+	spec := jaeger.Spec.Upgrade.PreUpgradeJob.DeepCopy()
+	if spec.ActiveDeadlineSeconds == nil {
+		// a job that hangs would block the upgrade forever, so it eventually fails instead
+		deadline := preUpgradeJobDeadline
+		spec.ActiveDeadlineSeconds = &deadline
+	}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       jaeger.Namespace,
+			Labels:          util.Labels(name, "pre-upgrade-job", jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(&jaeger)},
+		},
+		Spec: *spec,
+	}
+}
+
+// checkPreUpgradeJob creates the pre-upgrade job when it doesn't exist yet, and returns nil only once it has succeeded.
+// While the job runs, errPreUpgradeJobPending is returned, so that the reconciliation is requeued instead of waiting.
+func (r *ReconcileJaeger) checkPreUpgradeJob(ctx context.Context, jaeger v1.Jaeger, job batchv1.Job) error {
+	if err := r.create(ctx, jaeger, &job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	existing := &batchv1.Job{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: job.Name, Namespace: job.Namespace}, existing); err != nil {
+		if apierrors.IsNotFound(err) {
+			// the job might not be visible yet
+			return errPreUpgradeJobPending
+		}
+		return err
+	}
+
+	switch {
+	case jobSucceeded(existing):
+		return nil
+	case jobFailed(existing):
+		return errors.Errorf("the pre-upgrade job %s failed, the upgrade is blocked until the job is deleted and succeeds when recreated", existing.Name)
+	default:
+		return errPreUpgradeJobPending
+	}
+}
+
+func jobSucceeded(job *batchv1.Job) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobComplete && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	return job.Status.Succeeded >= completions
+}
+
+func jobFailed(job *batchv1.Job) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return job.Spec.BackoffLimit != nil && job.Status.Failed > *job.Spec.BackoffLimit
+}
@@ -6,9 +6,15 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
+	"github.com/jaegertracing/jaeger-operator/pkg/version"
 )
 
 func TestDirectNextMinor(t *testing.T) {
@@ -50,3 +56,129 @@ func TestSetVersionOnNewInstance(t *testing.T) {
 	// version, so, at least the status field should have been updated
 	assert.NotEmpty(t, j.Status.Version)
 }
+
+func TestPreUpgradeJobBlocksUpgrade(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestPreUpgradeJobBlocksUpgrade"}
+	j := *v1.NewJaeger(nsn)
+	j.Status.Version = "1.12.0"
+	j.Spec.Upgrade.PreUpgradeJob = &batchv1.JobSpec{}
+
+	r, cl := getReconciler([]runtime.Object{&j})
+
+	// test
+	upgraded, err := r.applyUpgrades(context.Background(), j)
+
+	// verify
+	assert.Equal(t, errPreUpgradeJobPending, err)
+	assert.Equal(t, "1.12.0", upgraded.Status.Version)
+
+	job := &batchv1.Job{}
+	name := types.NamespacedName{Name: preUpgradeJob(j, version.Get().Jaeger).Name}
+	assert.NoError(t, cl.Get(context.Background(), name, job))
+	assert.Equal(t, "pre-upgrade-job", job.Labels["app.kubernetes.io/component"])
+	assert.Equal(t, preUpgradeJobDeadline, *job.Spec.ActiveDeadlineSeconds)
+}
+
+func TestPreUpgradeJobGatesUpgrade(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestPreUpgradeJobGatesUpgrade"}
+	j := *v1.NewJaeger(nsn)
+	j.Status.Version = "1.12.0"
+	j.Spec.Upgrade.PreUpgradeJob = &batchv1.JobSpec{}
+
+	r, cl := getReconciler([]runtime.Object{&j})
+
+	_, err := r.applyUpgrades(context.Background(), j)
+	assert.Equal(t, errPreUpgradeJobPending, err)
+
+	job := &batchv1.Job{}
+	name := types.NamespacedName{Name: preUpgradeJob(j, version.Get().Jaeger).Name}
+	assert.NoError(t, cl.Get(context.Background(), name, job))
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	assert.NoError(t, cl.Status().Update(context.Background(), job))
+
+	// test
+	upgraded, err := r.applyUpgrades(context.Background(), j)
+
+	// verify
+	assert.NoError(t, err)
+	assert.Equal(t, version.Get().Jaeger, upgraded.Status.Version)
+}
+
+func TestPreUpgradeJobFailureBlocksUpgrade(t *testing.T) {
+	for _, status := range []batchv1.JobStatus{
+		{Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}},
+		{Failed: 3},
+	} {
+		// prepare
+		nsn := types.NamespacedName{Name: "TestPreUpgradeJobFailureBlocksUpgrade"}
+		backoffLimit := int32(2)
+		j := *v1.NewJaeger(nsn)
+		j.Status.Version = "1.12.0"
+		j.Spec.Upgrade.PreUpgradeJob = &batchv1.JobSpec{BackoffLimit: &backoffLimit}
+
+		job := preUpgradeJob(j, version.Get().Jaeger)
+		job.Status = status
+		r, _ := getReconciler([]runtime.Object{&j, job})
+
+		// test
+		upgraded, err := r.applyUpgrades(context.Background(), j)
+
+		// verify
+		assert.Error(t, err)
+		assert.NotEqual(t, errPreUpgradeJobPending, err)
+		assert.Equal(t, "1.12.0", upgraded.Status.Version)
+	}
+}
+
+func TestPreUpgradeJobRequeuesReconciliation(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestPreUpgradeJobRequeuesReconciliation"}
+	j := v1.NewJaeger(nsn)
+	j.Status.Version = "1.12.0"
+	j.Spec.Upgrade.PreUpgradeJob = &batchv1.JobSpec{}
+
+	r, cl := getReconciler([]runtime.Object{j})
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.S{}
+	}
+
+	// test
+	res, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	assert.NoError(t, err)
+	assert.Equal(t, preUpgradeJobRequeue, res.RequeueAfter)
+
+	persisted := &v1.Jaeger{}
+	assert.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	assert.NotEqual(t, v1.JaegerPhaseFailed, persisted.Status.Phase)
+	assert.Equal(t, "1.12.0", persisted.Status.Version)
+}
+
+func TestNoPreUpgradeJobForNewInstance(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestNoPreUpgradeJobForNewInstance"}
+	j := *v1.NewJaeger(nsn)
+	j.Spec.Upgrade.PreUpgradeJob = &batchv1.JobSpec{}
+
+	r, cl := getReconciler([]runtime.Object{&j})
+
+	// test
+	_, err := r.applyUpgrades(context.Background(), j)
+
+	// verify
+	assert.NoError(t, err)
+	jobs := &batchv1.JobList{}
+	assert.NoError(t, cl.List(context.Background(), jobs))
+	assert.Empty(t, jobs.Items)
+}
+
+func TestPreUpgradeJobName(t *testing.T) {
+	j := *v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	assert.Nil(t, preUpgradeJob(j, "1.21.0"))
+
+	j.Spec.Upgrade.PreUpgradeJob = &batchv1.JobSpec{}
+	assert.Equal(t, "my-instance-pre-upgrade-1-21-0", preUpgradeJob(j, "1.21.0").Name)
+}
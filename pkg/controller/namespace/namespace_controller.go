@@ -22,6 +22,7 @@ import (
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/inject"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 // Add creates a new Namespace Controller and adds it to the Manager. The Manager will set fields on the Controller
@@ -125,19 +126,22 @@ func (r *ReconcileNamespace) Reconcile(request reconcile.Request) (reconcile.Res
 	for i := 0; i < len(deps.Items); i++ {
 		dep := &deps.Items[i]
 		if inject.Needed(dep, ns) {
-			jaegers := &v1.JaegerList{}
-			opts := []client.ListOption{}
-
-			if viper.GetString(v1.ConfigOperatorScope) == v1.OperatorScopeNamespace {
-				opts = append(opts, client.InNamespace(viper.GetString(v1.ConfigWatchNamespace)))
-			}
-
-			if err := r.rClient.List(ctx, jaegers, opts...); err != nil {
+			jaegers, err := util.ListJaegers(ctx, r.rClient)
+			if err != nil {
 				log.WithError(err).Error("failed to get the available Jaeger pods")
 				return reconcile.Result{}, tracing.HandleError(err, span)
 			}
 			patch := client.MergeFrom(dep.DeepCopy())
-			jaeger := inject.Select(dep, ns, jaegers)
+			jaeger, err := inject.Select(dep, ns, jaegers)
+			if err != nil {
+				log.WithFields(log.Fields{
+					"deployment": dep.Name,
+					"namespace":  dep.Namespace,
+				}).WithError(err).Error("failed to select the Jaeger instance, skipping sidecar injection")
+				tracing.HandleError(err, span)
+				continue
+			}
+
 			if jaeger != nil && jaeger.GetDeletionTimestamp() == nil {
 				// a suitable jaeger instance was found! let's inject a sidecar pointing to it then
 				// Verified that jaeger instance was found and is not marked for deletion.
@@ -11,24 +11,58 @@ import (
 
 	"github.com/jaegertracing/jaeger-operator/pkg/account"
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/aws"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
-// CreateEsIndexCleaner returns a new cronjob for the Elasticsearch Index Cleaner operation
+// keepLatestScript wraps the index cleaner, raising the number of days to clean so that the newest KEEP_LATEST daily
+// span and service indices are preserved, regardless of their age. The cleaner itself only knows about the age.
+// It connects to Elasticsearch with the same TLS settings as the cleaner, including the client certificate: skipping
+// the host verification keeps the configured CA.
+const keepLatestScript = `import base64, datetime, json, os, re, ssl, sys, urllib.request
+days, url, keep = int(sys.argv[1]), sys.argv[2], int(os.environ["KEEP_LATEST"])
+prefix = os.getenv("INDEX_PREFIX", "")
+if prefix:
+    prefix += "-"
+req = urllib.request.Request(url.rstrip("/") + "/_cat/indices?h=index&format=json")
+if os.getenv("ES_USERNAME"):
+    credentials = "%s:%s" % (os.getenv("ES_USERNAME"), os.getenv("ES_PASSWORD", ""))
+    req.add_header("Authorization", "Basic " + base64.b64encode(credentials.encode()).decode())
+ctx = ssl.create_default_context(cafile=os.getenv("ES_TLS_CA"))
+if os.getenv("ES_TLS_CERT"):
+    ctx.load_cert_chain(os.environ["ES_TLS_CERT"], os.getenv("ES_TLS_KEY"))
+if os.getenv("ES_TLS_SKIP_HOST_VERIFY", "").lower() == "true":
+    ctx.check_hostname = False
+indices = [i["index"] for i in json.load(urllib.request.urlopen(req, context=ctx))]
+today = datetime.date.today()
+for kind in ("span", "service"):
+    pattern = re.compile(r"^%sjaeger-%s-(\d{4})-(\d{2})-(\d{2})$" % (re.escape(prefix), kind))
+    dates = sorted((datetime.date(*map(int, m.groups())) for m in map(pattern.match, indices) if m), reverse=True)
+    if dates:
+        days = max(days, (today - dates[min(keep, len(dates)) - 1]).days + 1)
+os.execvp("python3", ["python3", "/es-index-cleaner/esCleaner.py", str(days), url])
+`
 
 // CreateEsIndexCleaner returns a new cronjob for the Elasticsearch Index Cleaner operation
 func CreateEsIndexCleaner(jaeger *v1.Jaeger) *batchv1beta1.CronJob {
-	esUrls := util.GetEsHostname(jaeger.Spec.Storage.Options.Map())
-	trueVar := true
+	storageOpts := jaeger.Spec.Storage.EffectiveOptions()
+	esUrls := util.GetEsHostname(storageOpts.Map())
 	one := int32(1)
 
 	// CronJob names are restricted to 52 chars
 	name := util.Truncate("%s-es-index-cleaner", 52, jaeger.Name)
 
-	envFromSource := util.CreateEnvsFromSecret(jaeger.Spec.Storage.SecretName)
-	envs := EsScriptEnvVars(jaeger.Spec.Storage.Options)
-	if val, ok := jaeger.Spec.Storage.Options.Map()["es.use-aliases"]; ok && strings.EqualFold(val, "true") {
+	secretName := jaeger.Spec.Storage.SecretName
+	if len(jaeger.Spec.Storage.EsIndexCleaner.SecretName) > 0 {
+		secretName = jaeger.Spec.Storage.EsIndexCleaner.SecretName
+	}
+	envFromSource := util.CreateEnvsFromSecret(secretName)
+	envs := EsScriptEnvVars(storageOpts)
+	// with rollover, the indices don't carry the date in their names and the write indices must survive: the cleaner
+	// then only removes the rolled over indices that aren't behind the write aliases anymore
+	rollover := rolloverEnabled(storageOpts)
+	if rollover {
 		envs = append(envs, corev1.EnvVar{Name: "ROLLOVER", Value: "true"})
 	}
 
@@ -43,23 +77,29 @@ func CreateEsIndexCleaner(jaeger *v1.Jaeger) *batchv1beta1.CronJob {
 
 	commonSpec := util.Merge([]v1.JaegerCommonSpec{jaeger.Spec.Storage.EsIndexCleaner.JaegerCommonSpec, jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
 
+	var command []string
+	if keepLatest := jaeger.Spec.Storage.EsIndexCleaner.KeepLatest; keepLatest != nil && *keepLatest > 0 {
+		if rollover {
+			jaeger.Logger().Warn("The index cleaner's 'keepLatest' is not applicable when the indices are managed via rollover. Ignoring it.")
+		} else {
+			command = []string{"python3", "-c", keepLatestScript}
+			envs = append(envs, corev1.EnvVar{Name: "KEEP_LATEST", Value: strconv.Itoa(*keepLatest)})
+		}
+	}
+
 	ca.Update(jaeger, commonSpec)
+	aws.Update(jaeger, commonSpec)
+
+	envs = util.RemoveEmptyVars(append(envs, aws.EnvVars(jaeger)...))
+	envs = append(envs, util.CommonEnvVars(*commonSpec, envs)...)
 
 	return &batchv1beta1.CronJob{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        name,
-			Namespace:   jaeger.Namespace,
-			Labels:      commonSpec.Labels,
-			Annotations: commonSpec.Annotations,
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: jaeger.APIVersion,
-					Kind:       jaeger.Kind,
-					Name:       jaeger.Name,
-					UID:        jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
+			Name:            name,
+			Namespace:       jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			Annotations:     commonSpec.Annotations,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 		Spec: batchv1beta1.CronJobSpec{
 			Schedule:                   jaeger.Spec.Storage.EsIndexCleaner.Schedule,
@@ -72,18 +112,21 @@ func CreateEsIndexCleaner(jaeger *v1.Jaeger) *batchv1beta1.CronJob {
 						Spec: corev1.PodSpec{
 							Containers: []corev1.Container{
 								{
-									Name:         util.Truncate(name, 63),
-									Image:        util.ImageName(jaeger.Spec.Storage.EsIndexCleaner.Image, "jaeger-es-index-cleaner-image"),
-									Args:         []string{strconv.Itoa(*jaeger.Spec.Storage.EsIndexCleaner.NumberOfDays), esUrls},
-									Env:          util.RemoveEmptyVars(envs),
-									EnvFrom:      envFromSource,
-									Resources:    commonSpec.Resources,
-									VolumeMounts: commonSpec.VolumeMounts,
+									Name:            util.Truncate(name, 63),
+									Image:           util.ImageName(jaeger.Spec.Storage.EsIndexCleaner.Image, "jaeger-es-index-cleaner-image"),
+									ImagePullPolicy: commonSpec.ImagePullPolicy,
+									Command:         command,
+									Args:            []string{strconv.Itoa(*jaeger.Spec.Storage.EsIndexCleaner.NumberOfDays), esUrls},
+									Env:             envs,
+									EnvFrom:         envFromSource,
+									Resources:       commonSpec.Resources,
+									VolumeMounts:    commonSpec.VolumeMounts,
 								},
 							},
 							RestartPolicy:      corev1.RestartPolicyNever,
 							Affinity:           commonSpec.Affinity,
 							Tolerations:        commonSpec.Tolerations,
+							NodeSelector:       commonSpec.NodeSelector,
 							SecurityContext:    commonSpec.SecurityContext,
 							ServiceAccountName: account.JaegerServiceAccountFor(jaeger, account.EsIndexCleanerComponent),
 							Volumes:            commonSpec.Volumes,
@@ -98,3 +141,8 @@ func CreateEsIndexCleaner(jaeger *v1.Jaeger) *batchv1beta1.CronJob {
 		},
 	}
 }
+
+// rolloverEnabled returns whether the indices are managed via rollover, based on the given storage options
+func rolloverEnabled(opts v1.Options) bool {
+	return strings.EqualFold(opts.Map()["es.use-aliases"], "true")
+}
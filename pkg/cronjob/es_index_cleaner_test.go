@@ -31,6 +31,19 @@ func TestCreateEsIndexCleaner(t *testing.T) {
 	assert.Equal(t, historyLimits, *cronJob.Spec.SuccessfulJobsHistoryLimit)
 }
 
+func TestEsIndexCleanerOwnSecret(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEsIndexCleanerOwnSecret"})
+	jaeger.Spec.Storage.SecretName = "collector-secret"
+	jaeger.Spec.Storage.EsIndexCleaner.SecretName = "cleaner-secret"
+
+	days := 0
+	jaeger.Spec.Storage.EsIndexCleaner.NumberOfDays = &days
+	cronJob := CreateEsIndexCleaner(jaeger)
+	envFrom := cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].EnvFrom
+	assert.Len(t, envFrom, 1)
+	assert.Equal(t, "cleaner-secret", envFrom[0].SecretRef.LocalObjectReference.Name)
+}
+
 func TestEsIndexCleanerSecrets(t *testing.T) {
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEsIndexCleanerSecrets"})
 	secret := "mysecret"
@@ -124,6 +137,25 @@ func TestEsIndexCleanerLabels(t *testing.T) {
 	assert.Equal(t, cjob.ObjectMeta.Labels, cjob.Spec.JobTemplate.Spec.Template.ObjectMeta.Labels)
 }
 
+func TestEsIndexCleanerScheduling(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEsIndexCleanerScheduling"})
+	jaeger.Spec.Tolerations = []corev1.Toleration{{Key: "general"}}
+	jaeger.Spec.NodeSelector = map[string]string{"disktype": "ssd", "zone": "a"}
+	jaeger.Spec.Storage.EsIndexCleaner.Tolerations = []corev1.Toleration{{Key: "specific"}}
+	jaeger.Spec.Storage.EsIndexCleaner.NodeSelector = map[string]string{"zone": "b"}
+	jaeger.Spec.Storage.EsIndexCleaner.Affinity = &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}}
+	days := 0
+	jaeger.Spec.Storage.EsIndexCleaner.NumberOfDays = &days
+
+	podSpec := CreateEsIndexCleaner(jaeger).Spec.JobTemplate.Spec.Template.Spec
+
+	assert.Len(t, podSpec.Tolerations, 2)
+	assert.Equal(t, "specific", podSpec.Tolerations[0].Key)
+	assert.Equal(t, "general", podSpec.Tolerations[1].Key)
+	assert.Equal(t, map[string]string{"disktype": "ssd", "zone": "b"}, podSpec.NodeSelector)
+	assert.NotNil(t, podSpec.Affinity)
+}
+
 func TestEsIndexCleanerResources(t *testing.T) {
 
 	parentResources := corev1.ResourceRequirements{
@@ -219,3 +251,106 @@ func TestCustomEsIndexCleanerImage(t *testing.T) {
 	assert.Empty(t, jaeger.Spec.Storage.EsIndexCleaner.Image)
 	assert.Equal(t, "org/custom-es-index-cleaner-image:"+version.Get().Jaeger, cjob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Image)
 }
+
+func TestEsIndexCleanerImagePullPolicy(t *testing.T) {
+	days := 0
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEsIndexCleanerImagePullPolicy"})
+	jaeger.Spec.Storage.EsIndexCleaner.NumberOfDays = &days
+	jaeger.Spec.ImagePullPolicy = corev1.PullIfNotPresent
+
+	cjob := CreateEsIndexCleaner(jaeger)
+	assert.Equal(t, corev1.PullIfNotPresent, cjob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].ImagePullPolicy)
+
+	jaeger.Spec.Storage.EsIndexCleaner.ImagePullPolicy = corev1.PullNever
+	cjob = CreateEsIndexCleaner(jaeger)
+	assert.Equal(t, corev1.PullNever, cjob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].ImagePullPolicy)
+}
+
+func TestEsIndexCleanerKeepLatest(t *testing.T) {
+	days := 7
+	keep := 3
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEsIndexCleanerKeepLatest"})
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"es.server-urls": "http://es:9200"})
+	jaeger.Spec.Storage.EsIndexCleaner.NumberOfDays = &days
+
+	container := CreateEsIndexCleaner(jaeger).Spec.JobTemplate.Spec.Template.Spec.Containers[0]
+	assert.Empty(t, container.Command)
+
+	jaeger.Spec.Storage.EsIndexCleaner.KeepLatest = &keep
+
+	container = CreateEsIndexCleaner(jaeger).Spec.JobTemplate.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, []string{"python3", "-c", keepLatestScript}, container.Command)
+	assert.Equal(t, []string{"7", "http://es:9200"}, container.Args)
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: "KEEP_LATEST", Value: "3"})
+}
+
+func TestEsIndexCleanerKeepLatestTLS(t *testing.T) {
+	days := 7
+	keep := 3
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEsIndexCleanerKeepLatestTLS"})
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{
+		"es.server-urls":          "https://es:9200",
+		"es.tls":                  "true",
+		"es.tls.ca":               "/certs/ca.crt",
+		"es.tls.cert":             "/certs/tls.crt",
+		"es.tls.key":              "/certs/tls.key",
+		"es.tls.skip-host-verify": "true",
+	})
+	jaeger.Spec.Storage.EsIndexCleaner.NumberOfDays = &days
+	jaeger.Spec.Storage.EsIndexCleaner.KeepLatest = &keep
+
+	container := CreateEsIndexCleaner(jaeger).Spec.JobTemplate.Spec.Template.Spec.Containers[0]
+
+	for _, env := range []corev1.EnvVar{
+		{Name: "ES_TLS_CA", Value: "/certs/ca.crt"},
+		{Name: "ES_TLS_CERT", Value: "/certs/tls.crt"},
+		{Name: "ES_TLS_KEY", Value: "/certs/tls.key"},
+		{Name: "ES_TLS_SKIP_HOST_VERIFY", Value: "true"},
+	} {
+		assert.Contains(t, container.Env, env)
+		assert.Contains(t, keepLatestScript, env.Name)
+	}
+	assert.Contains(t, keepLatestScript, "load_cert_chain")
+	assert.NotContains(t, keepLatestScript, "_create_unverified_context")
+}
+
+func TestEsIndexCleanerKeepLatestIgnoredWithRollover(t *testing.T) {
+	days := 7
+	keep := 3
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEsIndexCleanerKeepLatestIgnoredWithRollover"})
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"es.use-aliases": "true"})
+	jaeger.Spec.Storage.EsIndexCleaner.NumberOfDays = &days
+	jaeger.Spec.Storage.EsIndexCleaner.KeepLatest = &keep
+
+	container := CreateEsIndexCleaner(jaeger).Spec.JobTemplate.Spec.Template.Spec.Containers[0]
+	assert.Empty(t, container.Command)
+	assert.NotContains(t, container.Env, corev1.EnvVar{Name: "KEEP_LATEST", Value: "3"})
+}
+
+func TestEsIndexCleanerAWSWebIdentity(t *testing.T) {
+	days := 7
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEsIndexCleanerAWSWebIdentity"})
+	jaeger.Spec.Storage.EsIndexCleaner.NumberOfDays = &days
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"es.server-urls": "http://es:9200"})
+	jaeger.Spec.Storage.AWSWebIdentity.RoleARN = "arn:aws:iam::123456789012:role/jaeger"
+
+	podSpec := CreateEsIndexCleaner(jaeger).Spec.JobTemplate.Spec.Template.Spec
+
+	assert.Contains(t, podSpec.Containers[0].Env, corev1.EnvVar{Name: "AWS_ROLE_ARN", Value: "arn:aws:iam::123456789012:role/jaeger"})
+	assert.Equal(t, "aws-iam-token", podSpec.Volumes[len(podSpec.Volumes)-1].Name)
+	assert.Equal(t, "aws-iam-token", podSpec.Containers[0].VolumeMounts[len(podSpec.Containers[0].VolumeMounts)-1].Name)
+}
+
+func TestEsIndexCleanerProxyEnv(t *testing.T) {
+	days := 7
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEsIndexCleanerProxyEnv"})
+	jaeger.Spec.Storage.EsIndexCleaner.NumberOfDays = &days
+	jaeger.Spec.Env = []corev1.EnvVar{{Name: "HTTPS_PROXY", Value: "http://proxy:3128"}, {Name: "NO_PROXY", Value: ".svc"}}
+	jaeger.Spec.Storage.EsIndexCleaner.Env = []corev1.EnvVar{{Name: "NO_PROXY", Value: ".cluster.local"}}
+
+	env := CreateEsIndexCleaner(jaeger).Spec.JobTemplate.Spec.Template.Spec.Containers[0].Env
+
+	assert.Contains(t, env, corev1.EnvVar{Name: "HTTPS_PROXY", Value: "http://proxy:3128"})
+	assert.Contains(t, env, corev1.EnvVar{Name: "NO_PROXY", Value: ".cluster.local"})
+	assert.NotContains(t, env, corev1.EnvVar{Name: "NO_PROXY", Value: ".svc"})
+}
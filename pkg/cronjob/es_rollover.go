@@ -12,6 +12,7 @@ import (
 
 	"github.com/jaegertracing/jaeger-operator/pkg/account"
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/aws"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
@@ -32,7 +33,7 @@ func CreateRollover(jaeger *v1.Jaeger) []batchv1beta1.CronJob {
 func rollover(jaeger *v1.Jaeger) batchv1beta1.CronJob {
 	// CronJob names are restricted to 52 chars
 	name := util.Truncate("%s-es-rollover", 52, jaeger.Name)
-	envs := EsScriptEnvVars(jaeger.Spec.Storage.Options)
+	envs := EsScriptEnvVars(jaeger.Spec.Storage.EffectiveOptions())
 	if jaeger.Spec.Storage.EsRollover.Conditions != "" {
 		envs = append(envs, corev1.EnvVar{Name: "CONDITIONS", Value: jaeger.Spec.Storage.EsRollover.Conditions})
 	}
@@ -52,15 +53,16 @@ func rollover(jaeger *v1.Jaeger) batchv1beta1.CronJob {
 			JobTemplate: batchv1beta1.JobTemplateSpec{
 				Spec: batchv1.JobSpec{
 					Parallelism: &one,
-					Template:    *createTemplate(name, "rollover", jaeger, envs),
+					Template:    *createTemplate(name, "rollover", jaeger, envs, jaeger.Spec.Storage.EsRollover.StepResources.Rollover),
 				},
 			},
 		},
 	}
 }
 
-func createTemplate(name, action string, jaeger *v1.Jaeger, envs []corev1.EnvVar) *corev1.PodTemplateSpec {
+func createTemplate(name, action string, jaeger *v1.Jaeger, envs []corev1.EnvVar, stepResources corev1.ResourceRequirements) *corev1.PodTemplateSpec {
 	envFromSource := util.CreateEnvsFromSecret(jaeger.Spec.Storage.SecretName)
+	storageOpts := jaeger.Spec.Storage.EffectiveOptions()
 	baseCommonSpec := v1.JaegerCommonSpec{
 		Annotations: map[string]string{
 			"prometheus.io/scrape":    "false",
@@ -72,6 +74,10 @@ func createTemplate(name, action string, jaeger *v1.Jaeger, envs []corev1.EnvVar
 	commonSpec := util.Merge([]v1.JaegerCommonSpec{jaeger.Spec.Storage.EsRollover.JaegerCommonSpec, jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
 
 	ca.Update(jaeger, commonSpec)
+	aws.Update(jaeger, commonSpec)
+
+	envs = util.RemoveEmptyVars(append(envs, aws.EnvVars(jaeger)...))
+	envs = append(envs, util.CommonEnvVars(*commonSpec, envs)...)
 
 	return &corev1.PodTemplateSpec{
 		ObjectMeta: metav1.ObjectMeta{
@@ -82,18 +88,20 @@ func createTemplate(name, action string, jaeger *v1.Jaeger, envs []corev1.EnvVar
 			RestartPolicy:      corev1.RestartPolicyOnFailure,
 			Affinity:           commonSpec.Affinity,
 			Tolerations:        commonSpec.Tolerations,
+			NodeSelector:       commonSpec.NodeSelector,
 			SecurityContext:    commonSpec.SecurityContext,
 			ServiceAccountName: account.JaegerServiceAccountFor(jaeger, account.EsRolloverComponent),
 			Volumes:            commonSpec.Volumes,
 			Containers: []corev1.Container{
 				{
-					Name:         name,
-					Image:        util.ImageName(jaeger.Spec.Storage.EsRollover.Image, "jaeger-es-rollover-image"),
-					Args:         []string{action, util.GetEsHostname(jaeger.Spec.Storage.Options.Map())},
-					Env:          util.RemoveEmptyVars(envs),
-					EnvFrom:      envFromSource,
-					Resources:    commonSpec.Resources,
-					VolumeMounts: commonSpec.VolumeMounts,
+					Name:            name,
+					Image:           util.ImageName(jaeger.Spec.Storage.EsRollover.Image, "jaeger-es-rollover-image"),
+					ImagePullPolicy: commonSpec.ImagePullPolicy,
+					Args:            []string{action, util.GetEsHostname(storageOpts.Map())},
+					Env:             envs,
+					EnvFrom:         envFromSource,
+					Resources:       EsRolloverResources(stepResources, *commonSpec),
+					VolumeMounts:    commonSpec.VolumeMounts,
 				},
 			},
 		},
@@ -103,7 +111,7 @@ func createTemplate(name, action string, jaeger *v1.Jaeger, envs []corev1.EnvVar
 func lookback(jaeger *v1.Jaeger) batchv1beta1.CronJob {
 	// CronJob names are restricted to 52 chars
 	name := util.Truncate("%s-es-lookback", 52, jaeger.Name)
-	envs := EsScriptEnvVars(jaeger.Spec.Storage.Options)
+	envs := EsScriptEnvVars(jaeger.Spec.Storage.EffectiveOptions())
 	if jaeger.Spec.Storage.EsRollover.ReadTTL != "" {
 		dur, err := time.ParseDuration(jaeger.Spec.Storage.EsRollover.ReadTTL)
 		if err == nil {
@@ -132,7 +140,7 @@ func lookback(jaeger *v1.Jaeger) batchv1beta1.CronJob {
 			JobTemplate: batchv1beta1.JobTemplateSpec{
 				Spec: batchv1.JobSpec{
 					TTLSecondsAfterFinished: jaeger.Spec.Storage.EsRollover.TTLSecondsAfterFinished,
-					Template:                *createTemplate(name, "lookback", jaeger, envs),
+					Template:                *createTemplate(name, "lookback", jaeger, envs, jaeger.Spec.Storage.EsRollover.StepResources.Lookback),
 				},
 			},
 		},
@@ -184,3 +192,12 @@ func parseToUnits(d time.Duration) pythonUnits {
 	i, _ := b.Int64()
 	return pythonUnits{units: seconds, count: int(i)}
 }
+
+// EsRolloverResources returns the resources for a step of the es-rollover, the step's own resources taking precedence
+// over the ones from the given common spec
+func EsRolloverResources(stepResources corev1.ResourceRequirements, commonSpec v1.JaegerCommonSpec) corev1.ResourceRequirements {
+	resources := &corev1.ResourceRequirements{}
+	util.MergeResources(resources, stepResources)
+	util.MergeResources(resources, commonSpec.Resources)
+	return *resources
+}
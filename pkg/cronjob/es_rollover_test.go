@@ -160,6 +160,26 @@ func TestEsRolloverLabels(t *testing.T) {
 	assert.Equal(t, "false", cjob.Spec.JobTemplate.Spec.Template.Labels["another"])
 }
 
+func TestEsRolloverScheduling(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEsRolloverScheduling"})
+	jaeger.Spec.Tolerations = []corev1.Toleration{{Key: "general"}}
+	jaeger.Spec.NodeSelector = map[string]string{"disktype": "ssd", "zone": "a"}
+	jaeger.Spec.Storage.EsRollover.Tolerations = []corev1.Toleration{{Key: "specific"}}
+	jaeger.Spec.Storage.EsRollover.NodeSelector = map[string]string{"zone": "b"}
+	jaeger.Spec.Storage.EsRollover.Affinity = &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}}
+
+	cronJobs := CreateRollover(jaeger)
+	assert.Len(t, cronJobs, 2)
+	for _, cronJob := range cronJobs {
+		podSpec := cronJob.Spec.JobTemplate.Spec.Template.Spec
+		assert.Len(t, podSpec.Tolerations, 2)
+		assert.Equal(t, "specific", podSpec.Tolerations[0].Key)
+		assert.Equal(t, "general", podSpec.Tolerations[1].Key)
+		assert.Equal(t, map[string]string{"disktype": "ssd", "zone": "b"}, podSpec.NodeSelector)
+		assert.NotNil(t, podSpec.Affinity)
+	}
+}
+
 func TestEsRolloverResources(t *testing.T) {
 
 	parentResources := corev1.ResourceRequirements{
@@ -348,3 +368,30 @@ func TestCustomEsRolloverImage(t *testing.T) {
 	assert.Empty(t, jaeger.Spec.Storage.EsRollover.Image)
 	assert.Equal(t, "org/custom-es-rollover-image:"+version.Get().Jaeger, cjob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Image)
 }
+
+func TestEsRolloverProxyEnv(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEsRolloverProxyEnv"})
+	jaeger.Spec.Env = []corev1.EnvVar{{Name: "HTTPS_PROXY", Value: "http://proxy:3128"}}
+
+	for _, cjob := range CreateRollover(jaeger) {
+		assert.Contains(t, cjob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "HTTPS_PROXY", Value: "http://proxy:3128"})
+	}
+}
+
+func TestEsRolloverStepResources(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEsRolloverStepResources"})
+	jaeger.Spec.Storage.EsRollover.Resources = corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+	}
+	jaeger.Spec.Storage.EsRollover.StepResources.Lookback = corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+	}
+
+	rolloverResources := rollover(jaeger).Spec.JobTemplate.Spec.Template.Spec.Containers[0].Resources
+	assert.Equal(t, resource.MustParse("500m"), rolloverResources.Limits[corev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("128Mi"), rolloverResources.Limits[corev1.ResourceMemory])
+
+	lookbackResources := lookback(jaeger).Spec.JobTemplate.Spec.Template.Spec.Containers[0].Resources
+	assert.Equal(t, resource.MustParse("500m"), lookbackResources.Limits[corev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("1Gi"), lookbackResources.Limits[corev1.ResourceMemory])
+}
@@ -1,6 +1,7 @@
 package cronjob
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -9,10 +10,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/spf13/viper"
-
 	"github.com/jaegertracing/jaeger-operator/pkg/account"
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/aws"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
@@ -30,13 +30,12 @@ func CreateSparkDependencies(jaeger *v1.Jaeger) *batchv1beta1.CronJob {
 	envVars := []corev1.EnvVar{
 		{Name: "STORAGE", Value: string(jaeger.Spec.Storage.Type)},
 		{Name: "SPARK_MASTER", Value: jaeger.Spec.Storage.Dependencies.SparkMaster},
-		{Name: "JAVA_OPTS", Value: jaeger.Spec.Storage.Dependencies.JavaOpts},
+		{Name: "JAVA_OPTS", Value: javaOpts(jaeger.Spec.Storage.Dependencies)},
 	}
 	envVars = append(envVars, getStorageEnvs(jaeger.Spec.Storage)...)
 
 	envFromSource := util.CreateEnvsFromSecret(jaeger.Spec.Storage.SecretName)
 
-	trueVar := true
 	one := int32(1)
 
 	// cron job names are restricted to 52 chars
@@ -54,6 +53,10 @@ func CreateSparkDependencies(jaeger *v1.Jaeger) *batchv1beta1.CronJob {
 	commonSpec := util.Merge([]v1.JaegerCommonSpec{jaeger.Spec.Storage.Dependencies.JaegerCommonSpec, jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
 
 	ca.Update(jaeger, commonSpec)
+	aws.Update(jaeger, commonSpec)
+
+	envVars = util.RemoveEmptyVars(append(envVars, aws.EnvVars(jaeger)...))
+	envVars = append(envVars, util.CommonEnvVars(*commonSpec, envVars)...)
 
 	// Cannot use util.ImageName to obtain the correct image, as the spark-dependencies
 	// image does not get tagged with the jaeger version, so the latest image must
@@ -61,23 +64,15 @@ func CreateSparkDependencies(jaeger *v1.Jaeger) *batchv1beta1.CronJob {
 	image := jaeger.Spec.Storage.Dependencies.Image
 	if image == "" {
 		// the version is not included, there is only one version - latest
-		image = viper.GetString("jaeger-spark-dependencies-image")
+		image = util.DefaultImage("jaeger-spark-dependencies-image")
 	}
 
 	return &batchv1beta1.CronJob{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: jaeger.Namespace,
-			Labels:    commonSpec.Labels,
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: jaeger.APIVersion,
-					Kind:       jaeger.Kind,
-					Name:       jaeger.Name,
-					UID:        jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
+			Name:            name,
+			Namespace:       jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 		Spec: batchv1beta1.CronJobSpec{
 			ConcurrencyPolicy:          batchv1beta1.ForbidConcurrent,
@@ -90,17 +85,21 @@ func CreateSparkDependencies(jaeger *v1.Jaeger) *batchv1beta1.CronJob {
 						Spec: corev1.PodSpec{
 							Containers: []corev1.Container{
 								{
-									Image: image,
-									Name:  name,
+									Image:           image,
+									ImagePullPolicy: commonSpec.ImagePullPolicy,
+									Name:            name,
 									// let spark job use its default values
-									Env:       util.RemoveEmptyVars(envVars),
-									EnvFrom:   envFromSource,
-									Resources: commonSpec.Resources,
+									Env:          envVars,
+									EnvFrom:      envFromSource,
+									VolumeMounts: commonSpec.VolumeMounts,
+									Resources:    commonSpec.Resources,
 								},
 							},
 							RestartPolicy:      corev1.RestartPolicyNever,
+							Volumes:            commonSpec.Volumes,
 							Affinity:           commonSpec.Affinity,
 							Tolerations:        commonSpec.Tolerations,
+							NodeSelector:       commonSpec.NodeSelector,
 							SecurityContext:    commonSpec.SecurityContext,
 							ServiceAccountName: account.JaegerServiceAccountFor(jaeger, account.DependenciesComponent),
 						},
@@ -115,8 +114,28 @@ func CreateSparkDependencies(jaeger *v1.Jaeger) *batchv1beta1.CronJob {
 	}
 }
 
+// javaOpts returns the JVM options for the job, with the Spark tuning settings ahead of the explicit options, so
+// that the latter take precedence
+func javaOpts(spec v1.JaegerDependenciesSpec) string {
+	var opts []string
+	if len(spec.DriverMemory) > 0 {
+		opts = append(opts, "-Xmx"+spec.DriverMemory)
+	}
+	if len(spec.ExecutorMemory) > 0 {
+		opts = append(opts, "-Dspark.executor.memory="+spec.ExecutorMemory)
+	}
+	if spec.Parallelism != nil {
+		opts = append(opts, fmt.Sprintf("-Dspark.default.parallelism=%d", *spec.Parallelism))
+	}
+	if len(spec.JavaOpts) > 0 {
+		opts = append(opts, spec.JavaOpts)
+	}
+	return strings.Join(opts, " ")
+}
+
 func getStorageEnvs(s v1.JaegerStorageSpec) []corev1.EnvVar {
-	sFlagsMap := s.Options.Map()
+	sOpts := s.EffectiveOptions()
+	sFlagsMap := sOpts.Map()
 	switch s.Type {
 	case v1.JaegerCassandraStorage:
 		keyspace := sFlagsMap["cassandra.keyspace"]
@@ -152,7 +171,8 @@ func getStorageEnvs(s v1.JaegerStorageSpec) []corev1.EnvVar {
 }
 
 func logTLSNotSupported(j *v1.Jaeger) {
-	sFlagsMap := j.Spec.Storage.Options.Map()
+	sOpts := j.Spec.Storage.EffectiveOptions()
+	sFlagsMap := sOpts.Map()
 	if strings.EqualFold(sFlagsMap["es.tls.enabled"], "true") || strings.EqualFold(sFlagsMap["es.tls"], "true") {
 		j.Logger().Warn("Spark dependencies does not support TLS with Elasticsearch, consider disabling dependencies")
 	}
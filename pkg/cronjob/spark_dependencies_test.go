@@ -139,6 +139,23 @@ func TestDependenciesLabels(t *testing.T) {
 	assert.Equal(t, cjob.ObjectMeta.Labels, cjob.Spec.JobTemplate.Spec.Template.ObjectMeta.Labels)
 }
 
+func TestDependenciesScheduling(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestDependenciesScheduling"})
+	jaeger.Spec.Tolerations = []corev1.Toleration{{Key: "general"}}
+	jaeger.Spec.NodeSelector = map[string]string{"disktype": "ssd", "zone": "a"}
+	jaeger.Spec.Storage.Dependencies.Tolerations = []corev1.Toleration{{Key: "specific"}}
+	jaeger.Spec.Storage.Dependencies.NodeSelector = map[string]string{"zone": "b"}
+	jaeger.Spec.Storage.Dependencies.Affinity = &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}}
+
+	podSpec := CreateSparkDependencies(jaeger).Spec.JobTemplate.Spec.Template.Spec
+
+	assert.Len(t, podSpec.Tolerations, 2)
+	assert.Equal(t, "specific", podSpec.Tolerations[0].Key)
+	assert.Equal(t, "general", podSpec.Tolerations[1].Key)
+	assert.Equal(t, map[string]string{"disktype": "ssd", "zone": "b"}, podSpec.NodeSelector)
+	assert.NotNil(t, podSpec.Affinity)
+}
+
 func TestSparkDependenciesResources(t *testing.T) {
 
 	parentResources := corev1.ResourceRequirements{
@@ -224,3 +241,39 @@ func TestCustomSparkDependenciesImage(t *testing.T) {
 	assert.Empty(t, jaeger.Spec.Storage.Dependencies.Image)
 	assert.Equal(t, "org/custom-spark-dependencies-image", cjob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Image)
 }
+
+func TestSparkDependenciesJavaOpts(t *testing.T) {
+	parallelism := int32(8)
+	j := &v1.Jaeger{Spec: v1.JaegerSpec{Storage: v1.JaegerStorageSpec{Type: v1.JaegerESStorage}}}
+	j.Spec.Storage.Dependencies.DriverMemory = "4g"
+	j.Spec.Storage.Dependencies.ExecutorMemory = "2g"
+	j.Spec.Storage.Dependencies.Parallelism = &parallelism
+	j.Spec.Storage.Dependencies.JavaOpts = "-Xss2m"
+
+	cjob := CreateSparkDependencies(j)
+
+	envs := cjob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Env
+	assert.Contains(t, envs, corev1.EnvVar{Name: "JAVA_OPTS", Value: "-Xmx4g -Dspark.executor.memory=2g -Dspark.default.parallelism=8 -Xss2m"})
+}
+
+func TestSparkDependenciesJavaOptsDefault(t *testing.T) {
+	j := &v1.Jaeger{Spec: v1.JaegerSpec{Storage: v1.JaegerStorageSpec{Type: v1.JaegerESStorage}}}
+
+	cjob := CreateSparkDependencies(j)
+
+	for _, env := range cjob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Env {
+		assert.NotEqual(t, "JAVA_OPTS", env.Name)
+	}
+}
+
+func TestSparkDependenciesAWSWebIdentity(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestSparkDependenciesAWSWebIdentity"})
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Storage.AWSWebIdentity.RoleARN = "arn:aws:iam::123456789012:role/jaeger"
+
+	podSpec := CreateSparkDependencies(jaeger).Spec.JobTemplate.Spec.Template.Spec
+
+	assert.Contains(t, podSpec.Containers[0].Env, corev1.EnvVar{Name: "AWS_WEB_IDENTITY_TOKEN_FILE", Value: "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"})
+	assert.Equal(t, "aws-iam-token", podSpec.Volumes[len(podSpec.Volumes)-1].Name)
+	assert.Equal(t, "aws-iam-token", podSpec.Containers[0].VolumeMounts[len(podSpec.Containers[0].VolumeMounts)-1].Name)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/jaegertracing/jaeger-operator/pkg/config/otelconfig"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -31,6 +32,49 @@ func NewAgent(jaeger *v1.Jaeger) *Agent {
 	return &Agent{jaeger: jaeger}
 }
 
+// Validate makes sure that the agent's settings are consistent, before the daemon set gets created
+func (a *Agent) Validate() error {
+	spec := a.jaeger.Spec.Agent
+
+	if size := spec.ProcessorQueueSize; size != nil && *size <= 0 {
+		return errors.Errorf("the agent's processor queue size has to be a positive number, got %d", *size)
+	}
+
+	if workers := spec.ProcessorWorkers; workers != nil && *workers <= 0 {
+		return errors.Errorf("the number of the agent's processor workers has to be a positive number, got %d", *workers)
+	}
+
+	if err := util.ValidateTags(spec.AgentTags); err != nil {
+		return errors.Wrap(err, "invalid agent.agentTags")
+	}
+
+	if strategy := spec.UpdateStrategy; strategy != nil {
+		switch strategy.Type {
+		case "", appsv1.RollingUpdateDaemonSetStrategyType:
+		case appsv1.OnDeleteDaemonSetStrategyType:
+			if strategy.RollingUpdate != nil {
+				return errors.New("agent.updateStrategy.rollingUpdate can only be used with the RollingUpdate strategy")
+			}
+		default:
+			return errors.Errorf("agent.updateStrategy.type has to be either %s or %s, got %s",
+				appsv1.RollingUpdateDaemonSetStrategyType, appsv1.OnDeleteDaemonSetStrategyType, strategy.Type)
+		}
+
+		if strategy.RollingUpdate != nil && strategy.RollingUpdate.MaxUnavailable != nil {
+			maxUnavailable := strategy.RollingUpdate.MaxUnavailable
+			value, err := intstr.GetValueFromIntOrPercent(maxUnavailable, 100, true)
+			if err != nil {
+				return errors.Wrap(err, "invalid agent.updateStrategy.rollingUpdate.maxUnavailable")
+			}
+			if value <= 0 || (maxUnavailable.Type == intstr.String && value > 100) {
+				return errors.Errorf("agent.updateStrategy.rollingUpdate.maxUnavailable has to be a positive number or a percentage between 1%% and 100%%, got %s", maxUnavailable.String())
+			}
+		}
+	}
+
+	return nil
+}
+
 // Get returns a Agent pod
 func (a *Agent) Get() *appsv1.DaemonSet {
 	if !strings.EqualFold(a.jaeger.Spec.Agent.Strategy, "daemonset") {
@@ -54,13 +98,18 @@ func (a *Agent) Get() *appsv1.DaemonSet {
 		}
 	}
 
+	args = append(args, AgentProcessorArgs(a.jaeger.Spec.Agent, args)...)
+
+	if len(a.jaeger.Spec.Agent.AgentTags) > 0 && len(util.FindItem("--agent.tags=", args)) == 0 && len(util.FindItem("--jaeger.tags=", args)) == 0 {
+		args = append(args, fmt.Sprintf("--agent.tags=%s", util.SerializeTags(a.jaeger.Spec.Agent.AgentTags)))
+	}
+
 	zkCompactTrft := util.GetPort("--processor.zipkin-compact.server-host-port=", args, 5775)
 	configRest := util.GetPort("--http-server.host-port=", args, 5778)
 	jgCompactTrft := util.GetPort("--processor.jaeger-compact.server-host-port=", args, 6831)
 	jgBinaryTrft := util.GetPort("--processor.jaeger-binary.server-host-port=", args, 6832)
 	adminPort := util.GetAdminPort(args, 14271)
 
-	trueVar := true
 	falseVar := false
 	labels := util.Labels(a.name(), "agent", *a.jaeger)
 
@@ -75,6 +124,7 @@ func (a *Agent) Get() *appsv1.DaemonSet {
 	}
 
 	commonSpec := util.Merge([]v1.JaegerCommonSpec{a.jaeger.Spec.Agent.JaegerCommonSpec, a.jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
+	util.PodSecurityDefaults(commonSpec)
 
 	ca.Update(a.jaeger, commonSpec)
 	ca.AddServiceCA(a.jaeger, commonSpec)
@@ -88,6 +138,25 @@ func (a *Agent) Get() *appsv1.DaemonSet {
 		otelconfig.Sync(a.jaeger, "agent", a.jaeger.Spec.Agent.Options, otelConf, commonSpec, &args)
 	}
 
+	// exposed to be referenced by the agent tags
+	env := []corev1.EnvVar{
+		{
+			Name:      "POD_NAME",
+			ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}},
+		},
+		{
+			Name:      "NODE_NAME",
+			ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"}},
+		},
+		{
+			Name:      "HOST_IP",
+			ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.hostIP"}},
+		},
+	}
+	env = append(env, util.CommonEnvVars(*commonSpec, env)...)
+
+	args = append(args, util.LogArgs(*commonSpec, args)...)
+
 	// ensure we have a consistent order of the arguments
 	// see https://github.com/jaegertracing/jaeger-operator/issues/334
 	sort.Strings(args)
@@ -103,21 +172,17 @@ func (a *Agent) Get() *appsv1.DaemonSet {
 			Kind:       "DaemonSet",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-agent-daemonset", a.jaeger.Name),
-			Namespace: a.jaeger.Namespace,
-			Labels:    commonSpec.Labels,
-			OwnerReferences: []metav1.OwnerReference{{
-				APIVersion: a.jaeger.APIVersion,
-				Kind:       a.jaeger.Kind,
-				Name:       a.jaeger.Name,
-				UID:        a.jaeger.UID,
-				Controller: &trueVar,
-			}},
+			Name:            fmt.Sprintf("%s-agent-daemonset", a.jaeger.Name),
+			Namespace:       a.jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			Annotations:     commonSpec.Annotations,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(a.jaeger)},
 		},
 		Spec: appsv1.DaemonSetSpec{
 			Selector: &metav1.LabelSelector{
 				MatchLabels: labels,
 			},
+			UpdateStrategy: a.updateStrategy(),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels:      commonSpec.Labels,
@@ -125,9 +190,11 @@ func (a *Agent) Get() *appsv1.DaemonSet {
 				},
 				Spec: corev1.PodSpec{
 					Containers: []corev1.Container{{
-						Image: util.ImageName(a.jaeger.Spec.Agent.Image, "jaeger-agent-image"),
-						Name:  "jaeger-agent-daemonset",
-						Args:  args,
+						Image:           util.ImageName(a.jaeger.Spec.Agent.Image, "jaeger-agent-image"),
+						ImagePullPolicy: commonSpec.ImagePullPolicy,
+						Name:            "jaeger-agent-daemonset",
+						Args:            args,
+						Env:             env,
 						Ports: []corev1.ContainerPort{
 							{
 								ContainerPort: zkCompactTrft,
@@ -158,6 +225,9 @@ func (a *Agent) Get() *appsv1.DaemonSet {
 								Name:          "admin-http",
 							},
 						},
+						SecurityContext:          util.ContainerSecurityContext(*commonSpec),
+						TerminationMessagePolicy: util.TerminationMessagePolicy(*commonSpec),
+						StartupProbe:             commonSpec.StartupProbe,
 						LivenessProbe: &corev1.Probe{
 							Handler: corev1.Handler{
 								HTTPGet: &corev1.HTTPGetAction{
@@ -185,7 +255,9 @@ func (a *Agent) Get() *appsv1.DaemonSet {
 					Volumes:            commonSpec.Volumes,
 					Affinity:           commonSpec.Affinity,
 					Tolerations:        commonSpec.Tolerations,
+					NodeSelector:       commonSpec.NodeSelector,
 					SecurityContext:    commonSpec.SecurityContext,
+					RuntimeClassName:   commonSpec.RuntimeClassName,
 					ServiceAccountName: account.JaegerServiceAccountFor(a.jaeger, account.AgentComponent),
 					EnableServiceLinks: &falseVar,
 				},
@@ -197,3 +269,29 @@ func (a *Agent) Get() *appsv1.DaemonSet {
 func (a *Agent) name() string {
 	return fmt.Sprintf("%s-agent", a.jaeger.Name)
 }
+
+// agentProcessors are the names of the UDP processors of the agent
+var agentProcessors = []string{"jaeger-compact", "jaeger-binary", "zipkin-compact"}
+
+// AgentProcessorArgs returns the arguments tuning the queue size and the workers of the agent's processors, skipping
+// the processors that have an explicit value in the given arguments
+func AgentProcessorArgs(spec v1.JaegerAgentSpec, args []string) []string {
+	var processorArgs []string
+	for _, processor := range agentProcessors {
+		if spec.ProcessorQueueSize != nil && len(util.FindItem(fmt.Sprintf("--processor.%s.server-queue-size=", processor), args)) == 0 {
+			processorArgs = append(processorArgs, fmt.Sprintf("--processor.%s.server-queue-size=%d", processor, *spec.ProcessorQueueSize))
+		}
+		if spec.ProcessorWorkers != nil && len(util.FindItem(fmt.Sprintf("--processor.%s.workers=", processor), args)) == 0 {
+			processorArgs = append(processorArgs, fmt.Sprintf("--processor.%s.workers=%d", processor, *spec.ProcessorWorkers))
+		}
+	}
+	return processorArgs
+}
+
+// updateStrategy returns the strategy replacing the agent pods, defaulting to a rolling update of one node at a time
+func (a *Agent) updateStrategy() appsv1.DaemonSetUpdateStrategy {
+	if a.jaeger.Spec.Agent.UpdateStrategy == nil {
+		return appsv1.DaemonSetUpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType}
+	}
+	return *a.jaeger.Spec.Agent.UpdateStrategy.DeepCopy()
+}
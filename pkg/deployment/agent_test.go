@@ -7,9 +7,11 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
@@ -258,3 +260,127 @@ func TestAgentHostNetwork(t *testing.T) {
 	dep := a.Get()
 	assert.Equal(t, trueVar, dep.Spec.Template.Spec.HostNetwork)
 }
+
+func TestDaemonSetAgentUpdateStrategy(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Agent.Strategy = "daemonset"
+
+	dep := NewAgent(jaeger).Get()
+	assert.Equal(t, appsv1.RollingUpdateDaemonSetStrategyType, dep.Spec.UpdateStrategy.Type)
+
+	maxUnavailable := intstr.FromString("10%")
+	jaeger.Spec.Agent.UpdateStrategy = &appsv1.DaemonSetUpdateStrategy{
+		Type:          appsv1.RollingUpdateDaemonSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDaemonSet{MaxUnavailable: &maxUnavailable},
+	}
+	dep = NewAgent(jaeger).Get()
+	assert.Equal(t, &maxUnavailable, dep.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable)
+
+	jaeger.Spec.Agent.UpdateStrategy = &appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType}
+	dep = NewAgent(jaeger).Get()
+	assert.Equal(t, appsv1.OnDeleteDaemonSetStrategyType, dep.Spec.UpdateStrategy.Type)
+}
+
+func TestAgentProcessorArgs(t *testing.T) {
+	queueSize := int32(5000)
+	workers := int32(20)
+
+	assert.Empty(t, AgentProcessorArgs(v1.JaegerAgentSpec{}, nil))
+
+	args := AgentProcessorArgs(v1.JaegerAgentSpec{ProcessorQueueSize: &queueSize, ProcessorWorkers: &workers},
+		[]string{"--processor.jaeger-binary.server-queue-size=100"})
+	assert.Equal(t, []string{
+		"--processor.jaeger-compact.server-queue-size=5000",
+		"--processor.jaeger-compact.workers=20",
+		"--processor.jaeger-binary.workers=20",
+		"--processor.zipkin-compact.server-queue-size=5000",
+		"--processor.zipkin-compact.workers=20",
+	}, args)
+}
+
+func TestDaemonSetAgentProcessorTuning(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestDaemonSetAgentProcessorTuning"})
+	jaeger.Spec.Agent.Strategy = "daemonset"
+	workers := int32(20)
+	jaeger.Spec.Agent.ProcessorWorkers = &workers
+
+	ds := NewAgent(jaeger).Get()
+
+	assert.Contains(t, ds.Spec.Template.Spec.Containers[0].Args, "--processor.jaeger-compact.workers=20")
+	assert.Empty(t, util.FindItem("--processor.jaeger-compact.server-queue-size=", ds.Spec.Template.Spec.Containers[0].Args))
+}
+
+func TestDaemonSetAgentTags(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestDaemonSetAgentTags"})
+	jaeger.Spec.Agent.Strategy = "daemonset"
+	jaeger.Spec.Agent.AgentTags = map[string]string{"node": "${NODE_NAME:}", "zone": "a"}
+
+	ds := NewAgent(jaeger).Get()
+
+	container := ds.Spec.Template.Spec.Containers[0]
+	assert.Contains(t, container.Args, "--agent.tags=node=${NODE_NAME:},zone=a")
+	assert.Contains(t, container.Env, corev1.EnvVar{
+		Name:      "NODE_NAME",
+		ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"}},
+	})
+}
+
+func TestDaemonSetAgentTagsExplicitOption(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestDaemonSetAgentTagsExplicitOption"})
+	jaeger.Spec.Agent.Strategy = "daemonset"
+	jaeger.Spec.Agent.AgentTags = map[string]string{"node": "${NODE_NAME:}"}
+	jaeger.Spec.Agent.Options = v1.NewOptions(map[string]interface{}{"agent.tags": "key=val"})
+
+	ds := NewAgent(jaeger).Get()
+
+	assert.Contains(t, ds.Spec.Template.Spec.Containers[0].Args, "--agent.tags=key=val")
+	assert.NotContains(t, ds.Spec.Template.Spec.Containers[0].Args, "--agent.tags=node=${NODE_NAME:}")
+}
+
+func TestAgentValidate(t *testing.T) {
+	int32p := func(v int32) *int32 { return &v }
+	rollingUpdate := func(maxUnavailable intstr.IntOrString) *appsv1.DaemonSetUpdateStrategy {
+		return &appsv1.DaemonSetUpdateStrategy{
+			Type:          appsv1.RollingUpdateDaemonSetStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDaemonSet{MaxUnavailable: &maxUnavailable},
+		}
+	}
+
+	for _, tt := range []struct {
+		name  string
+		spec  v1.JaegerAgentSpec
+		valid bool
+	}{
+		{name: "defaults", valid: true},
+		{name: "processors", spec: v1.JaegerAgentSpec{ProcessorQueueSize: int32p(10), ProcessorWorkers: int32p(10)}, valid: true},
+		{name: "zero processor queue size", spec: v1.JaegerAgentSpec{ProcessorQueueSize: int32p(0)}, valid: false},
+		{name: "zero processor workers", spec: v1.JaegerAgentSpec{ProcessorWorkers: int32p(0)}, valid: false},
+		{name: "tags", spec: v1.JaegerAgentSpec{AgentTags: map[string]string{"node": "${NODE_NAME:}"}}, valid: true},
+		// the agent's tags parser doesn't support escaping
+		{name: "tag with comma", spec: v1.JaegerAgentSpec{AgentTags: map[string]string{"zones": "a,b"}}, valid: false},
+		{name: "tag with equals sign", spec: v1.JaegerAgentSpec{AgentTags: map[string]string{"query": "k=v"}}, valid: false},
+		{name: "on delete", spec: v1.JaegerAgentSpec{UpdateStrategy: &appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType}}, valid: true},
+		{name: "max unavailable", spec: v1.JaegerAgentSpec{UpdateStrategy: rollingUpdate(intstr.FromInt(5))}, valid: true},
+		{name: "max unavailable percentage", spec: v1.JaegerAgentSpec{UpdateStrategy: rollingUpdate(intstr.FromString("10%"))}, valid: true},
+		{name: "zero max unavailable", spec: v1.JaegerAgentSpec{UpdateStrategy: rollingUpdate(intstr.FromInt(0))}, valid: false},
+		{name: "max unavailable percentage too high", spec: v1.JaegerAgentSpec{UpdateStrategy: rollingUpdate(intstr.FromString("150%"))}, valid: false},
+		{name: "max unavailable not a percentage", spec: v1.JaegerAgentSpec{UpdateStrategy: rollingUpdate(intstr.FromString("ten"))}, valid: false},
+		{name: "unknown update strategy", spec: v1.JaegerAgentSpec{UpdateStrategy: &appsv1.DaemonSetUpdateStrategy{Type: "Recreate"}}, valid: false},
+		{name: "rolling update parameters with on delete", spec: v1.JaegerAgentSpec{UpdateStrategy: &appsv1.DaemonSetUpdateStrategy{
+			Type:          appsv1.OnDeleteDaemonSetStrategyType,
+			RollingUpdate: rollingUpdate(intstr.FromInt(1)).RollingUpdate,
+		}}, valid: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestAgentValidate"})
+			jaeger.Spec.Agent = tt.spec
+
+			err := NewAgent(jaeger).Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/jaegertracing/jaeger-operator/pkg/account"
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/aws"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/sampling"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/tls"
@@ -36,7 +37,6 @@ func NewAllInOne(jaeger *v1.Jaeger) *AllInOne {
 // Get returns a pod for the current all-in-one configuration
 func (a *AllInOne) Get() *appsv1.Deployment {
 	a.jaeger.Logger().Debug("Assembling an all-in-one deployment")
-	trueVar := true
 	falseVar := false
 
 	args := append(a.jaeger.Spec.AllInOne.Options.ToArgs())
@@ -59,15 +59,20 @@ func (a *AllInOne) Get() *appsv1.Deployment {
 	}
 
 	commonSpec := util.Merge([]v1.JaegerCommonSpec{a.jaeger.Spec.AllInOne.JaegerCommonSpec, a.jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
+	util.PodSecurityDefaults(commonSpec)
 
+	storageOpts := a.jaeger.Spec.Storage.EffectiveOptions()
 	options := allArgs(a.jaeger.Spec.AllInOne.Options,
-		a.jaeger.Spec.Storage.Options.Filter(a.jaeger.Spec.Storage.Type.OptionsPrefix()))
+		storageOpts.Filter(a.jaeger.Spec.Storage.Type.OptionsPrefix()))
 
+	updateConfigFile(a.name(), a.jaeger.Spec.AllInOne.ConfigFile, commonSpec, &options)
 	configmap.Update(a.jaeger, commonSpec, &options)
 	sampling.Update(a.jaeger, commonSpec, &options)
+	options = append(options, samplingReloadArgs(a.jaeger, options)...)
 	tls.Update(a.jaeger, commonSpec, &options)
 	ca.Update(a.jaeger, commonSpec)
 	ca.AddServiceCA(a.jaeger, commonSpec)
+	aws.Update(a.jaeger, commonSpec)
 
 	// Enable tls by default for openshift platform
 	// even though the agent is in the same process as the collector, they communicate via gRPC, and the collector has TLS enabled,
@@ -89,6 +94,28 @@ func (a *AllInOne) Get() *appsv1.Deployment {
 		otelconfig.Sync(a.jaeger, "all-in-one", a.jaeger.Spec.AllInOne.Options, otelConf, commonSpec, &options)
 	}
 
+	env := []corev1.EnvVar{
+		{
+			Name:  "SPAN_STORAGE_TYPE",
+			Value: string(a.jaeger.Spec.Storage.Type),
+		},
+		{
+			Name:  "COLLECTOR_ZIPKIN_HTTP_PORT",
+			Value: "9411",
+		},
+		{
+			Name:  "JAEGER_DISABLED",
+			Value: strconv.FormatBool(jaegerDisabled),
+		},
+	}
+	env = append(env, aws.EnvVars(a.jaeger)...)
+	if !jaegerDisabled {
+		env = append(env, util.InternalTracingEnvVars(*commonSpec)...)
+	}
+	env = append(env, util.CommonEnvVars(*commonSpec, env)...)
+
+	options = append(options, util.LogArgs(*commonSpec, options)...)
+
 	// ensure we have a consistent order of the arguments
 	// see https://github.com/jaegertracing/jaeger-operator/issues/334
 	sort.Strings(options)
@@ -110,17 +137,11 @@ func (a *AllInOne) Get() *appsv1.Deployment {
 			Kind:       "Deployment",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        a.jaeger.Name,
-			Namespace:   a.jaeger.Namespace,
-			Labels:      commonSpec.Labels,
-			Annotations: commonSpec.Annotations,
-			OwnerReferences: []metav1.OwnerReference{{
-				APIVersion: a.jaeger.APIVersion,
-				Kind:       a.jaeger.Kind,
-				Name:       a.jaeger.Name,
-				UID:        a.jaeger.UID,
-				Controller: &trueVar,
-			}},
+			Name:            a.jaeger.Name,
+			Namespace:       a.jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			Annotations:     commonSpec.Annotations,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(a.jaeger)},
 		},
 		Spec: appsv1.DeploymentSpec{
 			Selector: &metav1.LabelSelector{
@@ -132,71 +153,18 @@ func (a *AllInOne) Get() *appsv1.Deployment {
 					Annotations: commonSpec.Annotations,
 				},
 				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{{
-						Image: util.ImageName(a.jaeger.Spec.AllInOne.Image, "jaeger-all-in-one-image"),
-						Name:  "jaeger",
-						Args:  options,
-						Env: []corev1.EnvVar{
-							{
-								Name:  "SPAN_STORAGE_TYPE",
-								Value: string(a.jaeger.Spec.Storage.Type),
-							},
-							{
-								Name:  "COLLECTOR_ZIPKIN_HTTP_PORT",
-								Value: "9411",
-							},
-							{
-								Name:  "JAEGER_DISABLED",
-								Value: strconv.FormatBool(jaegerDisabled),
-							},
-						},
-						VolumeMounts: commonSpec.VolumeMounts,
-						EnvFrom:      envFromSource,
-						Ports: []corev1.ContainerPort{
-							{
-								ContainerPort: 5775,
-								Name:          "zk-compact-trft", // max 15 chars!
-								Protocol:      corev1.ProtocolUDP,
-							},
-							{
-								ContainerPort: 5778,
-								Name:          "config-rest",
-							},
-							{
-								ContainerPort: 6831,
-								Name:          "jg-compact-trft",
-								Protocol:      corev1.ProtocolUDP,
-							},
-							{
-								ContainerPort: 6832,
-								Name:          "jg-binary-trft",
-								Protocol:      corev1.ProtocolUDP,
-							},
-							{
-								ContainerPort: 9411,
-								Name:          "zipkin",
-							},
-							{
-								ContainerPort: 14267,
-								Name:          "c-tchan-trft", // for collector
-							},
-							{
-								ContainerPort: 14268,
-								Name:          "c-binary-trft",
-							},
-							{
-								ContainerPort: 16686,
-								Name:          "query",
-							},
-							{
-								ContainerPort: adminPort,
-								Name:          "admin-http",
-							},
-							{
-								ContainerPort: 14250,
-								Name:          "grpc",
-							},
-						},
+					Containers: append([]corev1.Container{{
+						Image:                    util.ImageName(a.jaeger.Spec.AllInOne.Image, "jaeger-all-in-one-image"),
+						ImagePullPolicy:          commonSpec.ImagePullPolicy,
+						Name:                     "jaeger",
+						Args:                     options,
+						Env:                      env,
+						VolumeMounts:             commonSpec.VolumeMounts,
+						EnvFrom:                  envFromSource,
+						Ports:                    a.ports(adminPort),
+						SecurityContext:          util.ContainerSecurityContext(*commonSpec),
+						TerminationMessagePolicy: util.TerminationMessagePolicy(*commonSpec),
+						StartupProbe:             commonSpec.StartupProbe,
 						LivenessProbe: &corev1.Probe{
 							Handler: corev1.Handler{
 								HTTPGet: &corev1.HTTPGetAction{
@@ -218,12 +186,14 @@ func (a *AllInOne) Get() *appsv1.Deployment {
 							InitialDelaySeconds: 1,
 						},
 						Resources: commonSpec.Resources,
-					}},
+					}}, commonSpec.Sidecars...),
 					Volumes:            commonSpec.Volumes,
 					ServiceAccountName: account.JaegerServiceAccountFor(a.jaeger, account.AllInOneComponent),
 					Affinity:           commonSpec.Affinity,
 					Tolerations:        commonSpec.Tolerations,
+					NodeSelector:       commonSpec.NodeSelector,
 					SecurityContext:    commonSpec.SecurityContext,
+					RuntimeClassName:   commonSpec.RuntimeClassName,
 					EnableServiceLinks: &falseVar,
 				},
 			},
@@ -234,12 +204,83 @@ func (a *AllInOne) Get() *appsv1.Deployment {
 // Services returns a list of services to be deployed along with the all-in-one deployment
 func (a *AllInOne) Services() []*corev1.Service {
 	labels := a.labels()
-	return append(service.NewCollectorServices(a.jaeger, labels),
+	collectorServices := service.NewCollectorServices(a.jaeger, labels)
+	if a.jaeger.Spec.AllInOne.OTLPEnabled {
+		for _, svc := range collectorServices {
+			svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+				Name: "grpc-otlp",
+				Port: service.OTLPGRPCPort,
+			}, corev1.ServicePort{
+				Name: "http-otlp",
+				Port: service.OTLPHTTPPort,
+			})
+		}
+	}
+	return append(collectorServices,
 		service.NewQueryService(a.jaeger, labels),
 		service.NewAgentService(a.jaeger, labels),
 	)
 }
 
+// ports returns the container ports of the all-in-one, including the OTLP receivers when enabled
+func (a *AllInOne) ports(adminPort int32) []corev1.ContainerPort {
+	ports := []corev1.ContainerPort{
+		{
+			ContainerPort: 5775,
+			Name:          "zk-compact-trft", // max 15 chars!
+			Protocol:      corev1.ProtocolUDP,
+		},
+		{
+			ContainerPort: 5778,
+			Name:          "config-rest",
+		},
+		{
+			ContainerPort: 6831,
+			Name:          "jg-compact-trft",
+			Protocol:      corev1.ProtocolUDP,
+		},
+		{
+			ContainerPort: 6832,
+			Name:          "jg-binary-trft",
+			Protocol:      corev1.ProtocolUDP,
+		},
+		{
+			ContainerPort: 9411,
+			Name:          "zipkin",
+		},
+		{
+			ContainerPort: 14267,
+			Name:          "c-tchan-trft", // for collector
+		},
+		{
+			ContainerPort: 14268,
+			Name:          "c-binary-trft",
+		},
+		{
+			ContainerPort: 16686,
+			Name:          "query",
+		},
+		{
+			ContainerPort: adminPort,
+			Name:          "admin-http",
+		},
+		{
+			ContainerPort: 14250,
+			Name:          "grpc",
+		},
+	}
+	if a.jaeger.Spec.AllInOne.OTLPEnabled {
+		ports = append(ports, corev1.ContainerPort{
+			ContainerPort: service.OTLPGRPCPort,
+			Name:          "otlp-grpc",
+		}, corev1.ContainerPort{
+			ContainerPort: service.OTLPHTTPPort,
+			Name:          "otlp-http",
+		})
+	}
+	return ports
+}
+
 func (a *AllInOne) labels() map[string]string {
 	return util.Labels(a.name(), "all-in-one", *a.jaeger)
 }
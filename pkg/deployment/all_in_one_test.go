@@ -374,3 +374,104 @@ func getEnvVarByName(vars []corev1.EnvVar, name string) corev1.EnvVar {
 	}
 	return envVar
 }
+
+func TestAllInOneRuntimeClassName(t *testing.T) {
+	gvisor := "gvisor"
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestAllInOneRuntimeClassName"})
+	jaeger.Spec.RuntimeClassName = &gvisor
+
+	dep := NewAllInOne(jaeger).Get()
+
+	assert.Equal(t, &gvisor, dep.Spec.Template.Spec.RuntimeClassName)
+}
+
+func TestAllInOneOTLPDisabledByDefault(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestAllInOneOTLPDisabledByDefault"})
+	jaeger.Spec.AllInOne.Config = v1.NewFreeForm(map[string]interface{}{"foo": "bar"})
+
+	dep := NewAllInOne(jaeger).Get()
+	for _, port := range dep.Spec.Template.Spec.Containers[0].Ports {
+		assert.NotEqual(t, int32(4317), port.ContainerPort)
+	}
+}
+
+func TestAllInOneOTLPEnabled(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestAllInOneOTLPEnabled"})
+	jaeger.Spec.AllInOne.OTLPEnabled = true
+	jaeger.Spec.AllInOne.Config = v1.NewFreeForm(map[string]interface{}{"foo": "bar"})
+	a := NewAllInOne(jaeger)
+
+	dep := a.Get()
+	assert.Empty(t, util.FindItem("--collector.otlp.enabled=", dep.Spec.Template.Spec.Containers[0].Args))
+	assert.True(t, hasArgument("--config=/etc/jaeger/otel/config.yaml", dep.Spec.Template.Spec.Containers[0].Args))
+
+	ports := map[string]int32{}
+	for _, port := range dep.Spec.Template.Spec.Containers[0].Ports {
+		ports[port.Name] = port.ContainerPort
+	}
+	assert.Equal(t, int32(4317), ports["otlp-grpc"])
+	assert.Equal(t, int32(4318), ports["otlp-http"])
+
+	for _, svc := range a.Services() {
+		if svc.Name != "testallinoneotlpenabled-collector" && svc.Name != "testallinoneotlpenabled-collector-headless" {
+			continue
+		}
+		svcPorts := map[string]int32{}
+		for _, port := range svc.Spec.Ports {
+			svcPorts[port.Name] = port.Port
+		}
+		assert.Equal(t, int32(4317), svcPorts["grpc-otlp"], svc.Name)
+		assert.Equal(t, int32(4318), svcPorts["http-otlp"], svc.Name)
+	}
+}
+
+func TestAllInOneSamplingReloadInterval(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestAllInOneSamplingReloadInterval"})
+	jaeger.Spec.Sampling.ReloadInterval = "30s"
+
+	d := NewAllInOne(jaeger).Get()
+	assert.Contains(t, d.Spec.Template.Spec.Containers[0].Args, "--sampling.strategies-reload-interval=30s")
+}
+
+func TestAllInOneConfigFile(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestAllInOneConfigFile"})
+	jaeger.Spec.AllInOne.ConfigFile = &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "jaeger-config"},
+		Key:                  "config.yaml",
+	}
+
+	podSpec := NewAllInOne(jaeger).Get().Spec.Template.Spec
+
+	assert.Contains(t, podSpec.Containers[0].Args, "--config-file=/etc/jaeger/config-file/config.yaml")
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name: "testallinoneconfigfile-config-file",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "jaeger-config"},
+				Items:                []corev1.KeyToPath{{Key: "config.yaml", Path: "config.yaml"}},
+			},
+		},
+	})
+	assert.Contains(t, podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      "testallinoneconfigfile-config-file",
+		MountPath: "/etc/jaeger/config-file",
+		ReadOnly:  true,
+	})
+}
+
+func TestAllInOneConfigFileExplicitOption(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestAllInOneConfigFileExplicitOption"})
+	jaeger.Spec.AllInOne.Options = v1.NewOptions(map[string]interface{}{"config-file": "/etc/custom/config.yaml"})
+	jaeger.Spec.AllInOne.ConfigFile = &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "jaeger-config"},
+		Key:                  "config.yaml",
+	}
+
+	podSpec := NewAllInOne(jaeger).Get().Spec.Template.Spec
+
+	assert.Contains(t, podSpec.Containers[0].Args, "--config-file=/etc/custom/config.yaml")
+	assert.NotContains(t, podSpec.Containers[0].Args, "--config-file=/etc/jaeger/config-file/config.yaml")
+	for _, v := range podSpec.Volumes {
+		assert.NotEqual(t, "testallinoneconfigfileexplicitoption-config-file", v.Name)
+	}
+}
@@ -0,0 +1,91 @@
+package deployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestPodAnnotationsPrecedence(t *testing.T) {
+	for _, tt := range []struct {
+		component string
+		spec      func(jaeger *v1.Jaeger) *v1.JaegerCommonSpec
+		get       func(jaeger *v1.Jaeger) (metav1.ObjectMeta, metav1.ObjectMeta)
+	}{
+		{
+			component: "agent",
+			spec:      func(jaeger *v1.Jaeger) *v1.JaegerCommonSpec { return &jaeger.Spec.Agent.JaegerCommonSpec },
+			get: func(jaeger *v1.Jaeger) (metav1.ObjectMeta, metav1.ObjectMeta) {
+				jaeger.Spec.Agent.Strategy = "daemonset"
+				ds := NewAgent(jaeger).Get()
+				return ds.ObjectMeta, ds.Spec.Template.ObjectMeta
+			},
+		},
+		{
+			component: "all-in-one",
+			spec:      func(jaeger *v1.Jaeger) *v1.JaegerCommonSpec { return &jaeger.Spec.AllInOne.JaegerCommonSpec },
+			get: func(jaeger *v1.Jaeger) (metav1.ObjectMeta, metav1.ObjectMeta) {
+				dep := NewAllInOne(jaeger).Get()
+				return dep.ObjectMeta, dep.Spec.Template.ObjectMeta
+			},
+		},
+		{
+			component: "collector",
+			spec:      func(jaeger *v1.Jaeger) *v1.JaegerCommonSpec { return &jaeger.Spec.Collector.JaegerCommonSpec },
+			get: func(jaeger *v1.Jaeger) (metav1.ObjectMeta, metav1.ObjectMeta) {
+				dep := NewCollector(jaeger).Get()
+				return dep.ObjectMeta, dep.Spec.Template.ObjectMeta
+			},
+		},
+		{
+			component: "ingester",
+			spec:      func(jaeger *v1.Jaeger) *v1.JaegerCommonSpec { return &jaeger.Spec.Ingester.JaegerCommonSpec },
+			get: func(jaeger *v1.Jaeger) (metav1.ObjectMeta, metav1.ObjectMeta) {
+				jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+				dep := NewIngester(jaeger).Get()
+				return dep.ObjectMeta, dep.Spec.Template.ObjectMeta
+			},
+		},
+		{
+			component: "query",
+			spec:      func(jaeger *v1.Jaeger) *v1.JaegerCommonSpec { return &jaeger.Spec.Query.JaegerCommonSpec },
+			get: func(jaeger *v1.Jaeger) (metav1.ObjectMeta, metav1.ObjectMeta) {
+				dep := NewQuery(jaeger).Get()
+				return dep.ObjectMeta, dep.Spec.Template.ObjectMeta
+			},
+		},
+	} {
+		t.Run(tt.component, func(t *testing.T) {
+			// prepare
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestPodAnnotationsPrecedence"})
+			jaeger.Spec.Annotations = map[string]string{
+				"sidecar.istio.io/inject":           "true",
+				"traffic.sidecar.istio.io/excludes": "global",
+				"global-only":                       "global",
+			}
+			tt.spec(jaeger).Annotations = map[string]string{
+				"traffic.sidecar.istio.io/excludes": "component",
+			}
+
+			// test
+			meta, podMeta := tt.get(jaeger)
+
+			// verify
+			for _, m := range []metav1.ObjectMeta{meta, podMeta} {
+				assert.Equal(t, "true", m.Annotations["sidecar.istio.io/inject"])
+				assert.Equal(t, "component", m.Annotations["traffic.sidecar.istio.io/excludes"])
+				assert.Equal(t, "global", m.Annotations["global-only"])
+				assert.Equal(t, "disabled", m.Annotations["linkerd.io/inject"])
+			}
+
+			// and the component can opt out again
+			tt.spec(jaeger).Annotations["sidecar.istio.io/inject"] = "false"
+			_, podMeta = tt.get(jaeger)
+			assert.Equal(t, "false", podMeta.Annotations["sidecar.istio.io/inject"])
+		})
+	}
+}
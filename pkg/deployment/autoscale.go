@@ -1,6 +1,8 @@
 package deployment
 
 import (
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,12 +17,16 @@ const (
 
 	// for both memory and cpu
 	defaultAvgUtilization = int32(90)
+
+	// in seconds, the window the autoscaler considers before scaling the ingester down
+	defaultIngesterScaleDownStabilizationWindow = int32(600)
 )
 
 type component interface {
 	name() string
 	hpaLabels() map[string]string
 	replicas() *int32
+	scaleDownRules() *autoscalingv2beta2.HPAScalingRules
 	commonSpec() v1.JaegerCommonSpec
 	autoscalingSpec() v1.AutoScaleSpec
 	jaegerInstance() *v1.Jaeger
@@ -55,26 +61,49 @@ func autoscalers(component component) []autoscalingv2beta2.HorizontalPodAutoscal
 	}
 
 	avgUtilization := defaultAvgUtilization
-	trueVar := true
 	jaeger := component.jaegerInstance()
 	commonSpec := util.Merge([]v1.JaegerCommonSpec{component.commonSpec(), jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
 
-	// scale up when either CPU or memory is above 90%
-	return []autoscalingv2beta2.HorizontalPodAutoscaler{{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:        component.name(),
-			Namespace:   jaeger.Namespace,
-			Labels:      commonSpec.Labels,
-			Annotations: commonSpec.Annotations,
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion: jaeger.APIVersion,
-					Kind:       jaeger.Kind,
-					Name:       jaeger.Name,
-					UID:        jaeger.UID,
-					Controller: &trueVar,
+	// the behavior field is rejected by clusters not supporting it
+	var behavior *autoscalingv2beta2.HorizontalPodAutoscalerBehavior
+	if rules := component.scaleDownRules(); rules != nil && viper.GetBool("hpa-behavior-available") {
+		behavior = &autoscalingv2beta2.HorizontalPodAutoscalerBehavior{ScaleDown: rules}
+	}
+
+	metrics := autoScaleSpec.Metrics
+	if len(metrics) == 0 {
+		// scale up when either CPU or memory is above 90%
+		metrics = []autoscalingv2beta2.MetricSpec{
+			{
+				Type: autoscalingv2beta2.ResourceMetricSourceType,
+				Resource: &autoscalingv2beta2.ResourceMetricSource{
+					Name: corev1.ResourceCPU,
+					Target: autoscalingv2beta2.MetricTarget{
+						Type:               autoscalingv2beta2.UtilizationMetricType,
+						AverageUtilization: &avgUtilization,
+					},
 				},
 			},
+			{
+				Type: autoscalingv2beta2.ResourceMetricSourceType,
+				Resource: &autoscalingv2beta2.ResourceMetricSource{
+					Name: corev1.ResourceMemory,
+					Target: autoscalingv2beta2.MetricTarget{
+						Type:               autoscalingv2beta2.UtilizationMetricType,
+						AverageUtilization: &avgUtilization,
+					},
+				},
+			},
+		}
+	}
+
+	return []autoscalingv2beta2.HorizontalPodAutoscaler{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            component.name(),
+			Namespace:       jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			Annotations:     commonSpec.Annotations,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 		Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
 			ScaleTargetRef: autoscalingv2beta2.CrossVersionObjectReference{
@@ -84,28 +113,25 @@ func autoscalers(component component) []autoscalingv2beta2.HorizontalPodAutoscal
 			},
 			MinReplicas: autoScaleSpec.MinReplicas,
 			MaxReplicas: maxReplicas,
-			Metrics: []autoscalingv2beta2.MetricSpec{
-				{
-					Type: autoscalingv2beta2.ResourceMetricSourceType,
-					Resource: &autoscalingv2beta2.ResourceMetricSource{
-						Name: corev1.ResourceCPU,
-						Target: autoscalingv2beta2.MetricTarget{
-							Type:               autoscalingv2beta2.UtilizationMetricType,
-							AverageUtilization: &avgUtilization,
-						},
-					},
-				},
-				{
-					Type: autoscalingv2beta2.ResourceMetricSourceType,
-					Resource: &autoscalingv2beta2.ResourceMetricSource{
-						Name: corev1.ResourceMemory,
-						Target: autoscalingv2beta2.MetricTarget{
-							Type:               autoscalingv2beta2.UtilizationMetricType,
-							AverageUtilization: &avgUtilization,
-						},
-					},
-				},
-			},
+			Behavior:    behavior,
+			Metrics:     metrics,
 		},
 	}}
 }
+
+// validateAutoscaleMetrics makes sure that an explicit list of metrics isn't empty when autoscaling is enabled, as
+// the HPA needs at least one metric to calculate the desired number of replicas
+func validateAutoscaleMetrics(spec v1.AutoScaleSpec) error {
+	if spec.Metrics == nil || (spec.Autoscale != nil && !*spec.Autoscale) {
+		return nil
+	}
+	if len(spec.Metrics) == 0 {
+		return errors.New("at least one metric has to be provided when autoscaling is enabled")
+	}
+	for i, metric := range spec.Metrics {
+		if len(metric.Type) == 0 {
+			return errors.Errorf("the metric at position %d has no type", i)
+		}
+	}
+	return nil
+}
@@ -2,11 +2,15 @@ package deployment
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jaegertracing/jaeger-operator/pkg/config/otelconfig"
 
+	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
@@ -15,6 +19,7 @@ import (
 
 	"github.com/jaegertracing/jaeger-operator/pkg/account"
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/aws"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/sampling"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/tls"
@@ -22,6 +27,9 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
+// CollectorTrackLabel is the label telling the canary collector pods apart from the stable ones
+const CollectorTrackLabel = "collector.jaegertracing.io/track"
+
 // Collector builds pods for jaegertracing/jaeger-collector
 type Collector struct {
 	jaeger *v1.Jaeger
@@ -32,17 +40,121 @@ func NewCollector(jaeger *v1.Jaeger) *Collector {
 	return &Collector{jaeger: jaeger}
 }
 
+// Validate makes sure that the collector's settings are consistent, before any of its objects get created
+func (c *Collector) Validate() error {
+	spec := c.jaeger.Spec.Collector
+
+	if canary := spec.Canary; canary != nil {
+		if strings.TrimSpace(canary.Image) == "" {
+			return errors.New("the image for the collector's canary must not be empty")
+		}
+		if canary.Replicas != nil && *canary.Replicas <= 0 {
+			return errors.Errorf("the number of the collector's canary replicas has to be a positive number, got %d", *canary.Replicas)
+		}
+	}
+
+	if err := util.ValidateTags(spec.Tags); err != nil {
+		return errors.Wrap(err, "invalid collector.tags")
+	}
+
+	switch backend := spec.MetricsBackend; backend {
+	case "", "prometheus", "expvar", "none":
+	default:
+		return errors.Errorf("unknown metrics backend %q for the collector, possible values: prometheus, expvar, none", backend)
+	}
+
+	if port := spec.MetricsPort; port != nil {
+		if *port <= 0 || *port > 65535 {
+			return errors.Errorf("the collector's metrics port has to be between 1 and 65535, got %d", *port)
+		}
+		for _, used := range []int32{service.ZipkinPort, 14250, 14267, service.GetThriftHTTPPortForCollector(c.jaeger)} {
+			if *port == used {
+				return errors.Errorf("the collector's metrics port %d collides with another port of the collector", *port)
+			}
+		}
+	}
+
+	if port := spec.ThriftHTTPPort; port != nil {
+		if *port <= 0 || *port > 65535 {
+			return errors.Errorf("the collector's Thrift HTTP port has to be between 1 and 65535, got %d", *port)
+		}
+		for _, used := range []int32{service.ZipkinPort, 14250, 14267, service.GetAdminPortForCollector(c.jaeger)} {
+			if *port == used {
+				return errors.Errorf("the collector's Thrift HTTP port %d collides with another port of the collector", *port)
+			}
+		}
+	}
+
+	if size := spec.QueueSize; size != nil && *size <= 0 {
+		return errors.Errorf("the collector's queue size has to be a positive number, got %d", *size)
+	}
+
+	if size := spec.QueueSizeMemory; size != nil && *size <= 0 {
+		return errors.Errorf("the collector's queue size memory has to be a positive number, got %d", *size)
+	}
+
+	if spec.QueueSize != nil && spec.QueueSizeMemory != nil {
+		return errors.New("only one of the collector's queueSize and queueSizeMemory can be set")
+	}
+
+	for name, limit := range spec.ServiceRateLimits {
+		if limit <= 0 {
+			return errors.Errorf("the rate limit for the service %s has to be a positive number, got %d", name, limit)
+		}
+	}
+
+	for name, value := range map[string]string{
+		"maxConnectionIdle":     spec.OTLPKeepalive.MaxConnectionIdle,
+		"maxConnectionAge":      spec.OTLPKeepalive.MaxConnectionAge,
+		"maxConnectionAgeGrace": spec.OTLPKeepalive.MaxConnectionAgeGrace,
+		"time":                  spec.OTLPKeepalive.Time,
+		"timeout":               spec.OTLPKeepalive.Timeout,
+	} {
+		if value != "" {
+			if _, err := time.ParseDuration(value); err != nil {
+				return errors.Wrapf(err, "failed to parse collector.otlpKeepalive.%s to time.Duration", name)
+			}
+		}
+	}
+
+	for _, origin := range spec.OTLPCORS.AllowedOrigins {
+		if err := validateCORSOrigin(origin); err != nil {
+			return errors.Wrap(err, "invalid collector.otlpCors.allowedOrigins")
+		}
+	}
+	for _, header := range spec.OTLPCORS.AllowedHeaders {
+		if strings.TrimSpace(header) == "" {
+			return errors.New("collector.otlpCors.allowedHeaders must not contain empty headers")
+		}
+	}
+
+	if err := validateKafkaBrokersSource("kafka.producer.brokers", spec.KafkaBrokersFrom, spec.Options, c.jaeger.Spec.Storage.Options); err != nil {
+		return errors.Wrap(err, "invalid collector.kafkaBrokersFrom")
+	}
+
+	if err := validateAutoscaleMetrics(spec.AutoScaleSpec); err != nil {
+		return errors.Wrap(err, "failed to validate the autoscaling metrics for collector")
+	}
+
+	return nil
+}
+
 // Get returns a collector pod
 func (c *Collector) Get() *appsv1.Deployment {
 	c.jaeger.Logger().Debug("assembling a collector deployment")
 
 	labels := c.labels()
-	trueVar := true
 	falseVar := false
 
 	args := append(c.jaeger.Spec.Collector.Options.ToArgs())
 
 	adminPort := util.GetAdminPort(args, 14269)
+	// the metrics port is only used when the options don't have an admin port
+	metricsPort := c.jaeger.Spec.Collector.MetricsPort != nil &&
+		len(util.FindItem("--admin.http.host-port=", args)) == 0 && len(util.FindItem("--admin-http-port=", args)) == 0
+	if metricsPort {
+		adminPort = *c.jaeger.Spec.Collector.MetricsPort
+	}
 
 	baseCommonSpec := v1.JaegerCommonSpec{
 		Annotations: map[string]string{
@@ -55,6 +167,7 @@ func (c *Collector) Get() *appsv1.Deployment {
 	}
 
 	commonSpec := util.Merge([]v1.JaegerCommonSpec{c.jaeger.Spec.Collector.JaegerCommonSpec, c.jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
+	util.PodSecurityDefaults(commonSpec)
 
 	var envFromSource []corev1.EnvFromSource
 	if len(c.jaeger.Spec.Storage.SecretName) > 0 {
@@ -73,12 +186,46 @@ func (c *Collector) Get() *appsv1.Deployment {
 	if c.jaeger.Spec.Strategy == v1.DeploymentStrategyStreaming {
 		storageType = v1.JaegerKafkaStorage
 	}
+	storageOpts := c.jaeger.Spec.Storage.EffectiveOptions()
 	options := allArgs(c.jaeger.Spec.Collector.Options,
-		c.jaeger.Spec.Storage.Options.Filter(storageType.OptionsPrefix()))
+		storageOpts.Filter(storageType.OptionsPrefix()))
+
+	// we only add the tags if there's no explicit value yet
+	if len(c.jaeger.Spec.Collector.Tags) > 0 && len(util.FindItem("--collector.tags=", options)) == 0 {
+		options = append(options, fmt.Sprintf("--collector.tags=%s", util.SerializeTags(c.jaeger.Spec.Collector.Tags)))
+	}
+
+	// the producer topic only makes sense when the spans are written to Kafka
+	if storageType == v1.JaegerKafkaStorage && len(c.jaeger.Spec.Collector.KafkaTopic) > 0 && len(util.FindItem("--kafka.producer.topic=", options)) == 0 {
+		options = append(options, fmt.Sprintf("--kafka.producer.topic=%s", c.jaeger.Spec.Collector.KafkaTopic))
+	}
+
+	if c.jaeger.Spec.Collector.QueueSize != nil && len(util.FindItem("--collector.queue-size=", options)) == 0 {
+		options = append(options, fmt.Sprintf("--collector.queue-size=%d", *c.jaeger.Spec.Collector.QueueSize))
+	}
+
+	if c.jaeger.Spec.Collector.QueueSizeMemory != nil && len(util.FindItem("--collector.queue-size-memory=", options)) == 0 {
+		options = append(options, fmt.Sprintf("--collector.queue-size-memory=%d", *c.jaeger.Spec.Collector.QueueSizeMemory))
+	}
+
+	if len(c.jaeger.Spec.Collector.MetricsBackend) > 0 && len(util.FindItem("--metrics-backend=", options)) == 0 {
+		options = append(options, fmt.Sprintf("--metrics-backend=%s", c.jaeger.Spec.Collector.MetricsBackend))
+	}
+
+	if metricsPort {
+		options = append(options, fmt.Sprintf("--admin.http.host-port=:%d", adminPort))
+	}
+
+	if port := c.jaeger.Spec.Collector.ThriftHTTPPort; port != nil && len(util.FindItem("--collector.http-server.host-port=", options)) == 0 {
+		options = append(options, fmt.Sprintf("--collector.http-server.host-port=:%d", *port))
+	}
 
+	updateConfigFile(c.name(), c.jaeger.Spec.Collector.ConfigFile, commonSpec, &options)
 	sampling.Update(c.jaeger, commonSpec, &options)
+	options = append(options, samplingReloadArgs(c.jaeger, options)...)
 	tls.Update(c.jaeger, commonSpec, &options)
 	ca.Update(c.jaeger, commonSpec)
+	aws.Update(c.jaeger, commonSpec)
 
 	otelConf, err := c.jaeger.Spec.Collector.Config.GetMap()
 	if err != nil {
@@ -89,27 +236,39 @@ func (c *Collector) Get() *appsv1.Deployment {
 		otelconfig.Sync(c.jaeger, "collector", c.jaeger.Spec.Collector.Options, otelConf, commonSpec, &options)
 	}
 
+	options = append(options, util.LogArgs(*commonSpec, options)...)
+
 	// ensure we have a consistent order of the arguments
 	// see https://github.com/jaegertracing/jaeger-operator/issues/334
 	sort.Strings(options)
 
+	env := []corev1.EnvVar{
+		{
+			Name:  "SPAN_STORAGE_TYPE",
+			Value: string(storageType),
+		},
+		{
+			Name:  "COLLECTOR_ZIPKIN_HTTP_PORT",
+			Value: "9411",
+		},
+	}
+	if storageType == v1.JaegerKafkaStorage {
+		env = append(env, kafkaBrokersEnvVars("KAFKA_PRODUCER_BROKERS", c.jaeger.Spec.Collector.KafkaBrokersFrom)...)
+	}
+	env = append(env, aws.EnvVars(c.jaeger)...)
+	env = append(env, util.CommonEnvVars(*commonSpec, env)...)
+
 	return &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "apps/v1",
 			Kind:       "Deployment",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        c.name(),
-			Namespace:   c.jaeger.Namespace,
-			Labels:      commonSpec.Labels,
-			Annotations: commonSpec.Annotations,
-			OwnerReferences: []metav1.OwnerReference{{
-				APIVersion: c.jaeger.APIVersion,
-				Kind:       c.jaeger.Kind,
-				Name:       c.jaeger.Name,
-				UID:        c.jaeger.UID,
-				Controller: &trueVar,
-			}},
+			Name:            c.name(),
+			Namespace:       c.jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			Annotations:     commonSpec.Annotations,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(c.jaeger)},
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: c.jaeger.Spec.Collector.Replicas,
@@ -122,22 +281,14 @@ func (c *Collector) Get() *appsv1.Deployment {
 					Annotations: commonSpec.Annotations,
 				},
 				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{{
-						Image: util.ImageName(c.jaeger.Spec.Collector.Image, "jaeger-collector-image"),
-						Name:  "jaeger-collector",
-						Args:  options,
-						Env: []corev1.EnvVar{
-							{
-								Name:  "SPAN_STORAGE_TYPE",
-								Value: string(storageType),
-							},
-							{
-								Name:  "COLLECTOR_ZIPKIN_HTTP_PORT",
-								Value: "9411",
-							},
-						},
-						VolumeMounts: commonSpec.VolumeMounts,
-						EnvFrom:      envFromSource,
+					Containers: append([]corev1.Container{{
+						Image:           util.ImageName(c.jaeger.Spec.Collector.Image, "jaeger-collector-image"),
+						ImagePullPolicy: commonSpec.ImagePullPolicy,
+						Name:            "jaeger-collector",
+						Args:            options,
+						Env:             env,
+						VolumeMounts:    commonSpec.VolumeMounts,
+						EnvFrom:         envFromSource,
 						Ports: []corev1.ContainerPort{
 							{
 								ContainerPort: 9411,
@@ -148,7 +299,7 @@ func (c *Collector) Get() *appsv1.Deployment {
 								Name:          "c-tchan-trft", // for collector
 							},
 							{
-								ContainerPort: 14268,
+								ContainerPort: service.GetThriftHTTPPortForCollector(c.jaeger),
 								Name:          "c-binary-trft",
 							},
 							{
@@ -160,6 +311,9 @@ func (c *Collector) Get() *appsv1.Deployment {
 								Name:          "grpc",
 							},
 						},
+						SecurityContext:          util.ContainerSecurityContext(*commonSpec),
+						TerminationMessagePolicy: util.TerminationMessagePolicy(*commonSpec),
+						StartupProbe:             commonSpec.StartupProbe,
 						LivenessProbe: &corev1.Probe{
 							Handler: corev1.Handler{
 								HTTPGet: &corev1.HTTPGetAction{
@@ -181,12 +335,14 @@ func (c *Collector) Get() *appsv1.Deployment {
 							InitialDelaySeconds: 1,
 						},
 						Resources: commonSpec.Resources,
-					}},
+					}}, commonSpec.Sidecars...),
 					Volumes:            commonSpec.Volumes,
 					ServiceAccountName: account.JaegerServiceAccountFor(c.jaeger, account.CollectorComponent),
-					Affinity:           commonSpec.Affinity,
+					Affinity:           c.affinity(commonSpec.Affinity, labels),
 					Tolerations:        commonSpec.Tolerations,
+					NodeSelector:       commonSpec.NodeSelector,
 					SecurityContext:    commonSpec.SecurityContext,
+					RuntimeClassName:   commonSpec.RuntimeClassName,
 					EnableServiceLinks: &falseVar,
 				},
 			},
@@ -194,6 +350,46 @@ func (c *Collector) Get() *appsv1.Deployment {
 	}
 }
 
+// Canary returns a deployment running the canary image of the collector, or nil when no canary has been requested.
+// The canary pods keep the labels of the stable pods, so that the collector services route to both.
+func (c *Collector) Canary() *appsv1.Deployment {
+	canary := c.jaeger.Spec.Collector.Canary
+	if canary == nil {
+		return nil
+	}
+
+	dep := c.Get()
+	dep.Name = fmt.Sprintf("%s-canary", c.name())
+
+	replicas := int32(1)
+	if canary.Replicas != nil {
+		replicas = *canary.Replicas
+	}
+	dep.Spec.Replicas = &replicas
+
+	// the canary's selector is narrower than the stable one, so that it doesn't claim the stable pods
+	selector := map[string]string{CollectorTrackLabel: "canary"}
+	for k, v := range dep.Spec.Selector.MatchLabels {
+		selector[k] = v
+	}
+	dep.Spec.Selector = &metav1.LabelSelector{MatchLabels: selector}
+
+	podLabels := map[string]string{CollectorTrackLabel: "canary"}
+	for k, v := range dep.Spec.Template.Labels {
+		podLabels[k] = v
+	}
+	dep.Spec.Template.Labels = podLabels
+
+	depLabels := map[string]string{CollectorTrackLabel: "canary"}
+	for k, v := range dep.Labels {
+		depLabels[k] = v
+	}
+	dep.Labels = depLabels
+
+	dep.Spec.Template.Spec.Containers[0].Image = canary.Image
+	return dep
+}
+
 // Services returns a list of services to be deployed along with the all-in-one deployment
 func (c *Collector) Services() []*corev1.Service {
 	return service.NewCollectorServices(c.jaeger, c.labels())
@@ -204,6 +400,27 @@ func (c *Collector) Autoscalers() []autoscalingv2beta2.HorizontalPodAutoscaler {
 	return autoscalers(c)
 }
 
+// affinity returns the given affinity, falling back to a preferred anti-affinity among the collector replicas when requested
+func (c *Collector) affinity(affinity *corev1.Affinity, labels map[string]string) *corev1.Affinity {
+	if affinity != nil || !c.jaeger.Spec.Collector.DefaultAntiAffinity {
+		return affinity
+	}
+
+	return &corev1.Affinity{
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+				Weight: 100,
+				PodAffinityTerm: corev1.PodAffinityTerm{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: labels,
+					},
+					TopologyKey: "kubernetes.io/hostname",
+				},
+			}},
+		},
+	}
+}
+
 func (c *Collector) labels() map[string]string {
 	return util.Labels(c.name(), "collector", *c.jaeger)
 }
@@ -226,6 +443,11 @@ func (c *Collector) autoscalingSpec() v1.AutoScaleSpec {
 	return c.jaeger.Spec.Collector.AutoScaleSpec
 }
 
+func (c *Collector) scaleDownRules() *autoscalingv2beta2.HPAScalingRules {
+	// the default scale down behavior of the autoscaler is adequate for the collector
+	return nil
+}
+
 func (c *Collector) jaegerInstance() *v1.Jaeger {
 	return c.jaeger
 }
@@ -233,3 +455,28 @@ func (c *Collector) jaegerInstance() *v1.Jaeger {
 func (c *Collector) replicas() *int32 {
 	return c.jaeger.Spec.Collector.Replicas
 }
+
+// samplingReloadArgs returns the argument making the sampling strategies file be reloaded periodically, unless the
+// given arguments have an explicit value
+func samplingReloadArgs(jaeger *v1.Jaeger, args []string) []string {
+	if len(jaeger.Spec.Sampling.ReloadInterval) == 0 || len(util.FindItem("--sampling.strategies-reload-interval=", args)) > 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf("--sampling.strategies-reload-interval=%s", jaeger.Spec.Sampling.ReloadInterval)}
+}
+
+// validateCORSOrigin makes sure that the origin is either "*" or a scheme and host, optionally with a port. The host may
+// contain wildcards, like in "https://*.example.com".
+func validateCORSOrigin(origin string) error {
+	if origin == "*" {
+		return nil
+	}
+	u, err := url.Parse(strings.Replace(origin, "*", "wildcard", -1))
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse the origin %q", origin)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.Path != "" || u.RawQuery != "" || u.Fragment != "" {
+		return errors.Errorf("the origin %q must have the form scheme://host[:port], with the http or https scheme", origin)
+	}
+	return nil
+}
@@ -9,10 +9,12 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
@@ -385,6 +387,21 @@ func TestCollectorWithKafkaStorageType(t *testing.T) {
 	assert.Equal(t, "--kafka.producer.topic=mytopic", dep.Spec.Template.Spec.Containers[0].Args[1])
 }
 
+func TestCollectorStructuredStorageSettings(t *testing.T) {
+	workers := int32(4)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"es.server-urls": "http://somewhere"})
+	jaeger.Spec.Storage.EsBulk = &v1.JaegerEsBulkSpec{Workers: &workers, FlushInterval: "1s"}
+
+	dep := NewCollector(jaeger).Get()
+
+	args := dep.Spec.Template.Spec.Containers[0].Args
+	assert.Contains(t, args, "--es.bulk.workers=4")
+	assert.Contains(t, args, "--es.bulk.flush-interval=1s")
+	assert.Equal(t, map[string]string{"es.server-urls": "http://somewhere"}, jaeger.Spec.Storage.Options.Map())
+}
+
 func TestCollectorWithIngesterNoOptionsStorageType(t *testing.T) {
 	jaeger := &v1.Jaeger{
 		ObjectMeta: metav1.ObjectMeta{
@@ -459,6 +476,17 @@ func TestCollectorAutoscalersOnByDefault(t *testing.T) {
 	assert.Equal(t, int32(90), *a[0].Spec.Metrics[1].Resource.Target.AverageUtilization)
 }
 
+func TestCollectorAutoscalersDefaultBehavior(t *testing.T) {
+	viper.Set("hpa-behavior-available", true)
+	defer viper.Reset()
+
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	a := NewCollector(jaeger).Autoscalers()
+
+	assert.Len(t, a, 1)
+	assert.Nil(t, a[0].Spec.Behavior)
+}
+
 func TestCollectorAutoscalersDisabledByExplicitReplicaSize(t *testing.T) {
 	// prepare
 	tests := []int32{int32(0), int32(1)}
@@ -505,6 +533,44 @@ func TestCollectorAutoscalersSetMaxReplicas(t *testing.T) {
 	assert.Equal(t, maxReplicas, a[0].Spec.MaxReplicas)
 }
 
+func TestCollectorAutoscalersCustomMetrics(t *testing.T) {
+	// prepare
+	avgUtilization := int32(70)
+	lag := resource.MustParse("1000")
+	metrics := []autoscalingv2beta2.MetricSpec{
+		{
+			Type: autoscalingv2beta2.ResourceMetricSourceType,
+			Resource: &autoscalingv2beta2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2beta2.MetricTarget{
+					Type:               autoscalingv2beta2.UtilizationMetricType,
+					AverageUtilization: &avgUtilization,
+				},
+			},
+		},
+		{
+			Type: autoscalingv2beta2.ExternalMetricSourceType,
+			External: &autoscalingv2beta2.ExternalMetricSource{
+				Metric: autoscalingv2beta2.MetricIdentifier{Name: "kafka_consumergroup_lag"},
+				Target: autoscalingv2beta2.MetricTarget{
+					Type:         autoscalingv2beta2.AverageValueMetricType,
+					AverageValue: &lag,
+				},
+			},
+		},
+	}
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Collector.Metrics = metrics
+	c := NewCollector(jaeger)
+
+	// test
+	a := c.Autoscalers()
+
+	// verify
+	assert.Len(t, a, 1)
+	assert.Equal(t, metrics, a[0].Spec.Metrics)
+}
+
 func TestCollectoArgumentsOpenshiftTLS(t *testing.T) {
 	viper.Set("platform", v1.FlagPlatformOpenShift)
 	defer viper.Reset()
@@ -539,6 +605,164 @@ func TestCollectorOTELConfig(t *testing.T) {
 	assert.True(t, hasVolumeMount("instance-collector-otel-config", d.Spec.Template.Spec.Containers[0].VolumeMounts))
 }
 
+func TestCollectorTags(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Collector.Tags = map[string]string{
+		"region": "us-east-1",
+		"env":    "prod",
+	}
+
+	dep := NewCollector(jaeger).Get()
+	assert.True(t, hasArgument("--collector.tags=env=prod,region=us-east-1", dep.Spec.Template.Spec.Containers[0].Args))
+}
+
+func TestCollectorTagsExplicitOption(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Collector.Tags = map[string]string{"region": "us-east-1"}
+	jaeger.Spec.Collector.Options = v1.NewOptions(map[string]interface{}{
+		"collector.tags": "env=dev",
+	})
+
+	dep := NewCollector(jaeger).Get()
+	assert.True(t, hasArgument("--collector.tags=env=dev", dep.Spec.Template.Spec.Containers[0].Args))
+	assert.False(t, hasArgument("--collector.tags=region=us-east-1", dep.Spec.Template.Spec.Containers[0].Args))
+}
+
+func TestCollectorKafkaTopic(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+	jaeger.Spec.Collector.KafkaTopic = "my-instance-spans"
+
+	dep := NewCollector(jaeger).Get()
+	assert.True(t, hasArgument("--kafka.producer.topic=my-instance-spans", dep.Spec.Template.Spec.Containers[0].Args))
+}
+
+func TestCollectorKafkaTopicExplicitOption(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+	jaeger.Spec.Collector.KafkaTopic = "my-instance-spans"
+	jaeger.Spec.Collector.Options = v1.NewOptions(map[string]interface{}{
+		"kafka.producer.topic": "explicit-spans",
+	})
+
+	dep := NewCollector(jaeger).Get()
+	assert.True(t, hasArgument("--kafka.producer.topic=explicit-spans", dep.Spec.Template.Spec.Containers[0].Args))
+	assert.False(t, hasArgument("--kafka.producer.topic=my-instance-spans", dep.Spec.Template.Spec.Containers[0].Args))
+}
+
+func TestCollectorKafkaTopicIgnoredWithoutKafka(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyProduction
+	jaeger.Spec.Collector.KafkaTopic = "my-instance-spans"
+
+	dep := NewCollector(jaeger).Get()
+	assert.Len(t, util.FindItem("--kafka.producer.topic=", dep.Spec.Template.Spec.Containers[0].Args), 0)
+}
+
+func TestCollectorKafkaBrokersFrom(t *testing.T) {
+	ref := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "kafka"}, Key: "brokers"}
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+	jaeger.Spec.Collector.KafkaBrokersFrom = &v1.JaegerKafkaBrokersSource{SecretKeyRef: ref}
+
+	dep := NewCollector(jaeger).Get()
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{
+		Name:      "KAFKA_PRODUCER_BROKERS",
+		ValueFrom: &corev1.EnvVarSource{SecretKeyRef: ref},
+	})
+}
+
+func TestCollectorKafkaBrokersFromIgnoredWithoutKafka(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyProduction
+	jaeger.Spec.Collector.KafkaBrokersFrom = &v1.JaegerKafkaBrokersSource{
+		SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "kafka"}, Key: "brokers"},
+	}
+
+	dep := NewCollector(jaeger).Get()
+	for _, env := range dep.Spec.Template.Spec.Containers[0].Env {
+		assert.NotEqual(t, "KAFKA_PRODUCER_BROKERS", env.Name)
+	}
+}
+
+func TestCollectorMetrics(t *testing.T) {
+	port := int32(9090)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Collector.MetricsBackend = "prometheus"
+	jaeger.Spec.Collector.MetricsPort = &port
+
+	dep := NewCollector(jaeger).Get()
+	container := dep.Spec.Template.Spec.Containers[0]
+	assert.True(t, hasArgument("--metrics-backend=prometheus", container.Args))
+	assert.True(t, hasArgument("--admin.http.host-port=:9090", container.Args))
+	assert.Equal(t, "9090", dep.Spec.Template.Annotations["prometheus.io/port"])
+	assert.Equal(t, intstr.FromInt(9090), container.LivenessProbe.HTTPGet.Port)
+	assert.Contains(t, container.Ports, corev1.ContainerPort{ContainerPort: 9090, Name: "admin-http"})
+}
+
+func TestCollectorMetricsExplicitOptions(t *testing.T) {
+	port := int32(9090)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Collector.MetricsBackend = "prometheus"
+	jaeger.Spec.Collector.MetricsPort = &port
+	jaeger.Spec.Collector.Options = v1.NewOptions(map[string]interface{}{
+		"metrics-backend":      "expvar",
+		"admin.http.host-port": ":9091",
+	})
+
+	dep := NewCollector(jaeger).Get()
+	container := dep.Spec.Template.Spec.Containers[0]
+	assert.True(t, hasArgument("--metrics-backend=expvar", container.Args))
+	assert.False(t, hasArgument("--metrics-backend=prometheus", container.Args))
+	assert.True(t, hasArgument("--admin.http.host-port=:9091", container.Args))
+	assert.False(t, hasArgument("--admin.http.host-port=:9090", container.Args))
+	assert.Equal(t, "9091", dep.Spec.Template.Annotations["prometheus.io/port"])
+}
+
+func TestCollectorThriftHTTPPort(t *testing.T) {
+	port := int32(8080)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Collector.ThriftHTTPPort = &port
+
+	dep := NewCollector(jaeger).Get()
+	container := dep.Spec.Template.Spec.Containers[0]
+	assert.True(t, hasArgument("--collector.http-server.host-port=:8080", container.Args))
+	assert.Contains(t, container.Ports, corev1.ContainerPort{ContainerPort: 8080, Name: "c-binary-trft"})
+}
+
+func TestCollectorThriftHTTPPortExplicitOption(t *testing.T) {
+	port := int32(8080)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Collector.ThriftHTTPPort = &port
+	jaeger.Spec.Collector.Options = v1.NewOptions(map[string]interface{}{"collector.http-server.host-port": ":8081"})
+
+	dep := NewCollector(jaeger).Get()
+	container := dep.Spec.Template.Spec.Containers[0]
+	assert.True(t, hasArgument("--collector.http-server.host-port=:8081", container.Args))
+	assert.False(t, hasArgument("--collector.http-server.host-port=:8080", container.Args))
+	assert.Contains(t, container.Ports, corev1.ContainerPort{ContainerPort: 8081, Name: "c-binary-trft"})
+}
+
+func TestCollectorSamplingReloadInterval(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Sampling.ReloadInterval = "30s"
+
+	dep := NewCollector(jaeger).Get()
+	assert.True(t, hasArgument("--sampling.strategies-reload-interval=30s", dep.Spec.Template.Spec.Containers[0].Args))
+}
+
+func TestCollectorSamplingReloadIntervalExplicitOption(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Sampling.ReloadInterval = "30s"
+	jaeger.Spec.Collector.Options = v1.NewOptions(map[string]interface{}{
+		"sampling.strategies-reload-interval": "1m",
+	})
+
+	dep := NewCollector(jaeger).Get()
+	assert.True(t, hasArgument("--sampling.strategies-reload-interval=1m", dep.Spec.Template.Spec.Containers[0].Args))
+	assert.False(t, hasArgument("--sampling.strategies-reload-interval=30s", dep.Spec.Template.Spec.Containers[0].Args))
+}
+
 func TestCollectorServiceLinks(t *testing.T) {
 	c := NewCollector(v1.NewJaeger(types.NamespacedName{Name: "my-instance"}))
 	dep := c.Get()
@@ -572,3 +796,314 @@ func hasArgument(arg string, args []string) bool {
 	}
 	return false
 }
+
+func TestCollectorTerminationMessagePolicy(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorTerminationMessagePolicy"})
+
+	dep := NewCollector(jaeger).Get()
+	assert.Equal(t, corev1.TerminationMessageFallbackToLogsOnError, dep.Spec.Template.Spec.Containers[0].TerminationMessagePolicy)
+
+	jaeger.Spec.Collector.TerminationMessagePolicy = corev1.TerminationMessageReadFile
+	dep = NewCollector(jaeger).Get()
+	assert.Equal(t, corev1.TerminationMessageReadFile, dep.Spec.Template.Spec.Containers[0].TerminationMessagePolicy)
+}
+
+func TestCollectorImagePullPolicy(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorImagePullPolicy"})
+
+	dep := NewCollector(jaeger).Get()
+	assert.Empty(t, dep.Spec.Template.Spec.Containers[0].ImagePullPolicy)
+
+	jaeger.Spec.ImagePullPolicy = corev1.PullAlways
+	jaeger.Spec.Collector.ImagePullPolicy = corev1.PullIfNotPresent
+	dep = NewCollector(jaeger).Get()
+	assert.Equal(t, corev1.PullIfNotPresent, dep.Spec.Template.Spec.Containers[0].ImagePullPolicy)
+}
+
+func TestCollectorStartupProbe(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorStartupProbe"})
+
+	dep := NewCollector(jaeger).Get()
+	assert.Nil(t, dep.Spec.Template.Spec.Containers[0].StartupProbe)
+
+	probe := &corev1.Probe{
+		Handler: corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{Path: "/", Port: intstr.FromInt(14269)},
+		},
+		PeriodSeconds:    10,
+		FailureThreshold: 30,
+	}
+	jaeger.Spec.Collector.StartupProbe = probe
+
+	dep = NewCollector(jaeger).Get()
+	assert.Equal(t, probe, dep.Spec.Template.Spec.Containers[0].StartupProbe)
+}
+
+func TestCollectorSidecars(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorSidecars"})
+	jaeger.Spec.Sidecars = []corev1.Container{{Name: "log-shipper", Image: "fluent-bit"}}
+	jaeger.Spec.Collector.Sidecars = []corev1.Container{{Name: "log-shipper", Image: "fluentd"}}
+
+	dep := NewCollector(jaeger).Get()
+
+	containers := dep.Spec.Template.Spec.Containers
+	assert.Len(t, containers, 2)
+	assert.Equal(t, "jaeger-collector", containers[0].Name)
+	assert.Equal(t, "log-shipper", containers[1].Name)
+	assert.Equal(t, "fluentd", containers[1].Image)
+}
+
+func TestCollectorQueueSize(t *testing.T) {
+	size := int32(5000)
+	memory := int32(256)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorQueueSize"})
+
+	dep := NewCollector(jaeger).Get()
+	assert.Len(t, util.FindItem("--collector.queue-size", dep.Spec.Template.Spec.Containers[0].Args), 0)
+
+	jaeger.Spec.Collector.QueueSize = &size
+	jaeger.Spec.Collector.QueueSizeMemory = &memory
+	dep = NewCollector(jaeger).Get()
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--collector.queue-size=5000")
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--collector.queue-size-memory=256")
+
+	jaeger.Spec.Collector.Options = v1.NewOptions(map[string]interface{}{"collector.queue-size": "100"})
+	dep = NewCollector(jaeger).Get()
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--collector.queue-size=100")
+	assert.NotContains(t, dep.Spec.Template.Spec.Containers[0].Args, "--collector.queue-size=5000")
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--collector.queue-size-memory=256")
+}
+
+func TestCollectorCapabilities(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorCapabilities"})
+
+	dep := NewCollector(jaeger).Get()
+	assert.Equal(t, &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}}, dep.Spec.Template.Spec.Containers[0].SecurityContext.Capabilities)
+
+	capabilities := &corev1.Capabilities{Add: []corev1.Capability{"NET_BIND_SERVICE"}, Drop: []corev1.Capability{"ALL"}}
+	jaeger.Spec.Collector.Capabilities = capabilities
+
+	dep = NewCollector(jaeger).Get()
+	assert.Equal(t, capabilities, dep.Spec.Template.Spec.Containers[0].SecurityContext.Capabilities)
+}
+
+func TestCollectorPodSecurityDefaults(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorPodSecurityDefaults"})
+
+	dep := NewCollector(jaeger).Get()
+
+	podSpec := dep.Spec.Template.Spec
+	assert.True(t, *podSpec.SecurityContext.RunAsNonRoot)
+	assert.Equal(t, util.DefaultRunAsUser, *podSpec.SecurityContext.RunAsUser)
+	assert.False(t, *podSpec.Containers[0].SecurityContext.AllowPrivilegeEscalation)
+	assert.Equal(t, "runtime/default", dep.Spec.Template.Annotations["seccomp.security.alpha.kubernetes.io/pod"])
+}
+
+func TestCollectorAWSWebIdentity(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorAWSWebIdentity"})
+	jaeger.Spec.Storage.AWSWebIdentity.RoleARN = "arn:aws:iam::123456789012:role/jaeger"
+
+	podSpec := NewCollector(jaeger).Get().Spec.Template.Spec
+
+	assert.Contains(t, podSpec.Containers[0].Env, corev1.EnvVar{Name: "AWS_ROLE_ARN", Value: "arn:aws:iam::123456789012:role/jaeger"})
+	assert.Contains(t, podSpec.Containers[0].Env, corev1.EnvVar{Name: "AWS_WEB_IDENTITY_TOKEN_FILE", Value: "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"})
+	assert.True(t, hasVolume("aws-iam-token", podSpec.Volumes))
+	assert.True(t, hasVolumeMount("aws-iam-token", podSpec.Containers[0].VolumeMounts))
+}
+
+func TestCollectorRuntimeClassName(t *testing.T) {
+	general := "runc"
+	gvisor := "gvisor"
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorRuntimeClassName"})
+	jaeger.Spec.RuntimeClassName = &general
+	jaeger.Spec.Collector.RuntimeClassName = &gvisor
+
+	dep := NewCollector(jaeger).Get()
+
+	assert.Equal(t, &gvisor, dep.Spec.Template.Spec.RuntimeClassName)
+}
+
+func TestCollectorDefaultAntiAffinity(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorDefaultAntiAffinity"})
+
+	dep := NewCollector(jaeger).Get()
+	assert.Nil(t, dep.Spec.Template.Spec.Affinity)
+
+	jaeger.Spec.Collector.DefaultAntiAffinity = true
+	dep = NewCollector(jaeger).Get()
+
+	terms := dep.Spec.Template.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+	assert.Len(t, terms, 1)
+	assert.Equal(t, "kubernetes.io/hostname", terms[0].PodAffinityTerm.TopologyKey)
+	assert.Equal(t, dep.Spec.Selector.MatchLabels, terms[0].PodAffinityTerm.LabelSelector.MatchLabels)
+}
+
+func TestCollectorDefaultAntiAffinityExplicitAffinityWins(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorDefaultAntiAffinityExplicitAffinityWins"})
+	jaeger.Spec.Collector.DefaultAntiAffinity = true
+	affinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{
+						Key:      "node-role",
+						Operator: corev1.NodeSelectorOpIn,
+						Values:   []string{"tracing"},
+					}},
+				}},
+			},
+		},
+	}
+	jaeger.Spec.Affinity = affinity
+
+	dep := NewCollector(jaeger).Get()
+
+	assert.Equal(t, affinity, dep.Spec.Template.Spec.Affinity)
+}
+
+func TestCollectorCanary(t *testing.T) {
+	replicas := int32(2)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Collector.Canary = &v1.JaegerCollectorCanarySpec{Image: "jaegertracing/jaeger-collector:canary", Replicas: &replicas}
+	collector := NewCollector(jaeger)
+
+	stable := collector.Get()
+	canary := collector.Canary()
+
+	assert.Equal(t, "my-instance-collector-canary", canary.Name)
+	assert.Equal(t, &replicas, canary.Spec.Replicas)
+	assert.Equal(t, "jaegertracing/jaeger-collector:canary", canary.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, stable.Spec.Template.Spec.Containers[0].Args, canary.Spec.Template.Spec.Containers[0].Args)
+
+	// the canary doesn't claim the stable pods
+	assert.Equal(t, "canary", canary.Spec.Selector.MatchLabels[CollectorTrackLabel])
+	assert.NotContains(t, stable.Spec.Template.Labels, CollectorTrackLabel)
+
+	// the services route to both the stable and the canary pods
+	for _, svc := range collector.Services() {
+		for k, v := range svc.Spec.Selector {
+			assert.Equal(t, v, stable.Spec.Template.Labels[k])
+			assert.Equal(t, v, canary.Spec.Template.Labels[k])
+		}
+	}
+}
+
+func TestCollectorCanaryDefaults(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	assert.Nil(t, NewCollector(jaeger).Canary())
+
+	jaeger.Spec.Collector.Canary = &v1.JaegerCollectorCanarySpec{Image: "jaegertracing/jaeger-collector:canary"}
+	canary := NewCollector(jaeger).Canary()
+	assert.Equal(t, int32(1), *canary.Spec.Replicas)
+}
+
+func TestCollectorWithoutInternalTracing(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+	jaeger.Spec.InternalTracing = &v1.JaegerInternalTracingSpec{Endpoint: "http://meta:14268/api/traces"}
+
+	collector := NewCollector(jaeger).Get()
+	ingester := NewIngester(jaeger).Get()
+
+	for _, env := range append(collector.Spec.Template.Spec.Containers[0].Env, ingester.Spec.Template.Spec.Containers[0].Env...) {
+		assert.NotEqual(t, "JAEGER_ENDPOINT", env.Name)
+	}
+}
+
+func TestCollectorEnv(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorEnv"})
+	jaeger.Spec.Env = []corev1.EnvVar{{Name: "HTTPS_PROXY", Value: "http://proxy:3128"}, {Name: "SPAN_STORAGE_TYPE", Value: "ignored"}}
+
+	env := NewCollector(jaeger).Get().Spec.Template.Spec.Containers[0].Env
+
+	assert.Contains(t, env, corev1.EnvVar{Name: "HTTPS_PROXY", Value: "http://proxy:3128"})
+	assert.NotContains(t, env, corev1.EnvVar{Name: "SPAN_STORAGE_TYPE", Value: "ignored"})
+}
+
+func TestCollectorConfigFile(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorConfigFile"})
+	jaeger.Spec.Collector.ConfigFile = &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "collector-config"},
+		Key:                  "collector.yaml",
+	}
+
+	podSpec := NewCollector(jaeger).Get().Spec.Template.Spec
+
+	assert.Contains(t, podSpec.Containers[0].Args, "--config-file=/etc/jaeger/config-file/collector.yaml")
+	assert.Contains(t, podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      "testcollectorconfigfile-collector-config-file",
+		MountPath: "/etc/jaeger/config-file",
+		ReadOnly:  true,
+	})
+}
+
+func TestCollectorValidate(t *testing.T) {
+	int32p := func(v int32) *int32 { return &v }
+	secretRef := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "kafka"}, Key: "brokers"}
+
+	for _, tt := range []struct {
+		name  string
+		spec  v1.JaegerCollectorSpec
+		valid bool
+	}{
+		{name: "defaults", valid: true},
+		{name: "canary", spec: v1.JaegerCollectorSpec{Canary: &v1.JaegerCollectorCanarySpec{Image: "jaegertracing/jaeger-collector:canary", Replicas: int32p(1)}}, valid: true},
+		{name: "canary without image", spec: v1.JaegerCollectorSpec{Canary: &v1.JaegerCollectorCanarySpec{}}, valid: false},
+		{name: "canary without replicas", spec: v1.JaegerCollectorSpec{Canary: &v1.JaegerCollectorCanarySpec{Image: "jaegertracing/jaeger-collector:canary", Replicas: int32p(0)}}, valid: false},
+		{name: "tags", spec: v1.JaegerCollectorSpec{Tags: map[string]string{"region": "us-east-1"}}, valid: true},
+		// the collector's tags parser doesn't support escaping
+		{name: "tag with comma", spec: v1.JaegerCollectorSpec{Tags: map[string]string{"zones": "a,b"}}, valid: false},
+		{name: "tag with equals sign", spec: v1.JaegerCollectorSpec{Tags: map[string]string{"query": "k=v"}}, valid: false},
+		{name: "metrics backend and port", spec: v1.JaegerCollectorSpec{MetricsBackend: "prometheus", MetricsPort: int32p(9090)}, valid: true},
+		{name: "unknown metrics backend", spec: v1.JaegerCollectorSpec{MetricsBackend: "statsd"}, valid: false},
+		{name: "metrics port out of range", spec: v1.JaegerCollectorSpec{MetricsPort: int32p(70000)}, valid: false},
+		{name: "metrics port collides", spec: v1.JaegerCollectorSpec{MetricsPort: int32p(14268)}, valid: false},
+		{name: "thrift port", spec: v1.JaegerCollectorSpec{ThriftHTTPPort: int32p(8080)}, valid: true},
+		{name: "zero thrift port", spec: v1.JaegerCollectorSpec{ThriftHTTPPort: int32p(0)}, valid: false},
+		{name: "thrift port out of range", spec: v1.JaegerCollectorSpec{ThriftHTTPPort: int32p(70000)}, valid: false},
+		{name: "thrift port collides with grpc", spec: v1.JaegerCollectorSpec{ThriftHTTPPort: int32p(14250)}, valid: false},
+		{name: "thrift port collides with admin", spec: v1.JaegerCollectorSpec{ThriftHTTPPort: int32p(14269)}, valid: false},
+		{name: "thrift port on moved admin port", spec: v1.JaegerCollectorSpec{ThriftHTTPPort: int32p(14269), MetricsPort: int32p(9090)}, valid: true},
+		{name: "thrift port collides with metrics", spec: v1.JaegerCollectorSpec{ThriftHTTPPort: int32p(9090), MetricsPort: int32p(9090)}, valid: false},
+		{name: "queue size", spec: v1.JaegerCollectorSpec{QueueSize: int32p(5000)}, valid: true},
+		{name: "zero queue size", spec: v1.JaegerCollectorSpec{QueueSize: int32p(0)}, valid: false},
+		{name: "queue size memory", spec: v1.JaegerCollectorSpec{QueueSizeMemory: int32p(256)}, valid: true},
+		{name: "negative queue size memory", spec: v1.JaegerCollectorSpec{QueueSizeMemory: int32p(-1)}, valid: false},
+		// the fixed and the memory based queue sizes are mutually exclusive
+		{name: "both queue sizes", spec: v1.JaegerCollectorSpec{QueueSize: int32p(5000), QueueSizeMemory: int32p(256)}, valid: false},
+		{name: "service rate limits", spec: v1.JaegerCollectorSpec{ServiceRateLimits: map[string]int32{"noisy": 10}}, valid: true},
+		{name: "zero service rate limit", spec: v1.JaegerCollectorSpec{ServiceRateLimits: map[string]int32{"noisy": 10, "chatty": 0}}, valid: false},
+		{name: "otlp keepalive", spec: v1.JaegerCollectorSpec{OTLPKeepalive: v1.JaegerCollectorOTLPKeepaliveSpec{MaxConnectionAge: "5m", Timeout: "20s"}}, valid: true},
+		{name: "invalid otlp keepalive", spec: v1.JaegerCollectorSpec{OTLPKeepalive: v1.JaegerCollectorOTLPKeepaliveSpec{Time: "often"}}, valid: false},
+		{name: "otlp cors", spec: v1.JaegerCollectorSpec{OTLPCORS: v1.JaegerCollectorOTLPCORSSpec{
+			AllowedOrigins: []string{"*", "https://app.example.com", "http://localhost:8080", "https://*.example.com"},
+			AllowedHeaders: []string{"X-Custom-Header"},
+		}}, valid: true},
+		{name: "otlp cors origin without scheme", spec: v1.JaegerCollectorSpec{OTLPCORS: v1.JaegerCollectorOTLPCORSSpec{AllowedOrigins: []string{"app.example.com"}}}, valid: false},
+		{name: "otlp cors origin with other scheme", spec: v1.JaegerCollectorSpec{OTLPCORS: v1.JaegerCollectorOTLPCORSSpec{AllowedOrigins: []string{"ftp://app.example.com"}}}, valid: false},
+		{name: "otlp cors origin with path", spec: v1.JaegerCollectorSpec{OTLPCORS: v1.JaegerCollectorOTLPCORSSpec{AllowedOrigins: []string{"https://app.example.com/"}}}, valid: false},
+		{name: "empty otlp cors origin", spec: v1.JaegerCollectorSpec{OTLPCORS: v1.JaegerCollectorOTLPCORSSpec{AllowedOrigins: []string{""}}}, valid: false},
+		{name: "empty otlp cors header", spec: v1.JaegerCollectorSpec{OTLPCORS: v1.JaegerCollectorOTLPCORSSpec{AllowedHeaders: []string{"X-Custom-Header", " "}}}, valid: false},
+		{name: "kafka brokers from secret", spec: v1.JaegerCollectorSpec{KafkaBrokersFrom: &v1.JaegerKafkaBrokersSource{SecretKeyRef: secretRef}}, valid: true},
+		{name: "kafka brokers from secret and option", spec: v1.JaegerCollectorSpec{
+			KafkaBrokersFrom: &v1.JaegerKafkaBrokersSource{SecretKeyRef: secretRef},
+			Options:          v1.NewOptions(map[string]interface{}{"kafka.producer.brokers": "my-cluster-kafka-brokers:9092"}),
+		}, valid: false},
+		{name: "autoscale metrics", spec: v1.JaegerCollectorSpec{AutoScaleSpec: v1.AutoScaleSpec{Metrics: []autoscalingv2beta2.MetricSpec{
+			{Type: autoscalingv2beta2.ResourceMetricSourceType, Resource: &autoscalingv2beta2.ResourceMetricSource{Name: corev1.ResourceCPU}},
+		}}}, valid: true},
+		{name: "empty autoscale metrics", spec: v1.JaegerCollectorSpec{AutoScaleSpec: v1.AutoScaleSpec{Metrics: []autoscalingv2beta2.MetricSpec{}}}, valid: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorValidate"})
+			jaeger.Spec.Collector = tt.spec
+
+			err := NewCollector(jaeger).Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package deployment
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+const configFileMountPath = "/etc/jaeger/config-file"
+
+// updateConfigFile mounts the referenced config map entry and passes it to the binary via the --config-file flag,
+// unless the flag has been set explicitly
+func updateConfigFile(name string, ref *corev1.ConfigMapKeySelector, commonSpec *v1.JaegerCommonSpec, options *[]string) {
+	if ref == nil || len(util.FindItem("--config-file=", *options)) > 0 {
+		return
+	}
+
+	volumeName := util.DNSName(util.Truncate("%s-config-file", 63, name))
+	commonSpec.Volumes = append(commonSpec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: ref.LocalObjectReference,
+				Items:                []corev1.KeyToPath{{Key: ref.Key, Path: ref.Key}},
+				Optional:             ref.Optional,
+			},
+		},
+	})
+	commonSpec.VolumeMounts = append(commonSpec.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: configFileMountPath,
+		ReadOnly:  true,
+	})
+	*options = append(*options, fmt.Sprintf("--config-file=%s/%s", configFileMountPath, ref.Key))
+}
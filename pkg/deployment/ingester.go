@@ -2,11 +2,14 @@ package deployment
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/jaegertracing/jaeger-operator/pkg/config/otelconfig"
 
+	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
@@ -15,6 +18,7 @@ import (
 
 	"github.com/jaegertracing/jaeger-operator/pkg/account"
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/aws"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
@@ -29,6 +33,52 @@ func NewIngester(jaeger *v1.Jaeger) *Ingester {
 	return &Ingester{jaeger: jaeger}
 }
 
+// Validate makes sure that the ingester's settings are consistent, before any of its objects get created
+func (i *Ingester) Validate() error {
+	spec := i.jaeger.Spec.Ingester
+
+	if spec.DeadlockInterval != "" {
+		if _, err := time.ParseDuration(spec.DeadlockInterval); err != nil {
+			return errors.Wrap(err, "failed to parse ingester.deadlockInterval to time.Duration")
+		}
+	}
+
+	if parallelism := spec.Parallelism; parallelism != nil && *parallelism <= 0 {
+		return errors.Errorf("the ingester's parallelism has to be a positive number, got %d", *parallelism)
+	}
+
+	if gracePeriod := spec.TerminationGracePeriodSeconds; gracePeriod != nil && *gracePeriod < 0 {
+		return errors.Errorf("the ingester's termination grace period can't be negative, got %d", *gracePeriod)
+	}
+
+	if spec.DrainDelay != "" {
+		delay, err := time.ParseDuration(spec.DrainDelay)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse ingester.drainDelay to time.Duration")
+		}
+		if delay < 0 {
+			return errors.Errorf("the ingester's drain delay can't be negative, got %s", delay)
+		}
+		if gracePeriod := spec.TerminationGracePeriodSeconds; gracePeriod != nil && delay >= time.Duration(*gracePeriod)*time.Second {
+			return errors.Errorf("the ingester's termination grace period (%ds) has to be longer than its drain delay (%s)", *gracePeriod, delay)
+		}
+	}
+
+	if partitions := spec.KafkaPartitions; partitions != nil && *partitions <= 0 {
+		return errors.Errorf("the number of Kafka partitions for the ingester has to be a positive number, got %d", *partitions)
+	}
+
+	if err := validateKafkaBrokersSource("kafka.consumer.brokers", spec.KafkaBrokersFrom, spec.Options); err != nil {
+		return errors.Wrap(err, "invalid ingester.kafkaBrokersFrom")
+	}
+
+	if err := validateAutoscaleMetrics(spec.AutoScaleSpec); err != nil {
+		return errors.Wrap(err, "failed to validate the autoscaling metrics for ingester")
+	}
+
+	return nil
+}
+
 // Autoscalers returns a list of HPAs based on this ingester
 func (i *Ingester) Autoscalers() []autoscalingv2beta2.HorizontalPodAutoscaler {
 	return autoscalers(i)
@@ -43,7 +93,6 @@ func (i *Ingester) Get() *appsv1.Deployment {
 	i.jaeger.Logger().Debug("Assembling an ingester deployment")
 
 	labels := i.labels()
-	trueVar := true
 	falseVar := false
 
 	args := append(i.jaeger.Spec.Ingester.Options.ToArgs())
@@ -61,6 +110,7 @@ func (i *Ingester) Get() *appsv1.Deployment {
 	}
 
 	commonSpec := util.Merge([]v1.JaegerCommonSpec{i.jaeger.Spec.Ingester.JaegerCommonSpec, i.jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
+	util.PodSecurityDefaults(commonSpec)
 
 	var envFromSource []corev1.EnvFromSource
 	if len(i.jaeger.Spec.Storage.SecretName) > 0 {
@@ -73,10 +123,26 @@ func (i *Ingester) Get() *appsv1.Deployment {
 		})
 	}
 
+	storageOpts := i.jaeger.Spec.Storage.EffectiveOptions()
 	options := allArgs(i.jaeger.Spec.Ingester.Options,
-		i.jaeger.Spec.Storage.Options.Filter(i.jaeger.Spec.Storage.Type.OptionsPrefix()))
+		storageOpts.Filter(i.jaeger.Spec.Storage.Type.OptionsPrefix()))
+
+	// we only add the topic, group and tuning settings if there's no explicit value yet
+	if len(i.jaeger.Spec.Ingester.KafkaTopic) > 0 && len(util.FindItem("--kafka.consumer.topic=", options)) == 0 {
+		options = append(options, fmt.Sprintf("--kafka.consumer.topic=%s", i.jaeger.Spec.Ingester.KafkaTopic))
+	}
+	if len(i.jaeger.Spec.Ingester.ConsumerGroup) > 0 && len(util.FindItem("--kafka.consumer.group-id=", options)) == 0 {
+		options = append(options, fmt.Sprintf("--kafka.consumer.group-id=%s", i.jaeger.Spec.Ingester.ConsumerGroup))
+	}
+	if len(i.jaeger.Spec.Ingester.DeadlockInterval) > 0 && len(util.FindItem("--ingester.deadlockInterval=", options)) == 0 {
+		options = append(options, fmt.Sprintf("--ingester.deadlockInterval=%s", i.jaeger.Spec.Ingester.DeadlockInterval))
+	}
+	if i.jaeger.Spec.Ingester.Parallelism != nil && len(util.FindItem("--ingester.parallelism=", options)) == 0 {
+		options = append(options, fmt.Sprintf("--ingester.parallelism=%d", *i.jaeger.Spec.Ingester.Parallelism))
+	}
 
 	ca.Update(i.jaeger, commonSpec)
+	aws.Update(i.jaeger, commonSpec)
 
 	otelConf, err := i.jaeger.Spec.Ingester.Config.GetMap()
 	if err != nil {
@@ -87,26 +153,33 @@ func (i *Ingester) Get() *appsv1.Deployment {
 		otelconfig.Sync(i.jaeger, "ingester", i.jaeger.Spec.Ingester.Options, otelConf, commonSpec, &options)
 	}
 
+	options = append(options, util.LogArgs(*commonSpec, options)...)
+
 	// ensure we have a consistent order of the arguments
 	// see https://github.com/jaegertracing/jaeger-operator/issues/334
 	sort.Strings(options)
 
+	lifecycle, terminationGracePeriod := i.drain()
+
+	env := []corev1.EnvVar{{
+		Name:  "SPAN_STORAGE_TYPE",
+		Value: string(i.jaeger.Spec.Storage.Type),
+	}}
+	env = append(env, kafkaBrokersEnvVars("KAFKA_CONSUMER_BROKERS", i.jaeger.Spec.Ingester.KafkaBrokersFrom)...)
+	env = append(env, aws.EnvVars(i.jaeger)...)
+	env = append(env, util.CommonEnvVars(*commonSpec, env)...)
+
 	return &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "apps/v1",
 			Kind:       "Deployment",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      i.name(),
-			Namespace: i.jaeger.Namespace,
-			Labels:    commonSpec.Labels,
-			OwnerReferences: []metav1.OwnerReference{{
-				APIVersion: i.jaeger.APIVersion,
-				Kind:       i.jaeger.Kind,
-				Name:       i.jaeger.Name,
-				UID:        i.jaeger.UID,
-				Controller: &trueVar,
-			}},
+			Name:            i.name(),
+			Namespace:       i.jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			Annotations:     commonSpec.Annotations,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(i.jaeger)},
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: i.jaeger.Spec.Ingester.Replicas,
@@ -119,22 +192,23 @@ func (i *Ingester) Get() *appsv1.Deployment {
 					Annotations: commonSpec.Annotations,
 				},
 				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{{
-						Image: util.ImageName(i.jaeger.Spec.Ingester.Image, "jaeger-ingester-image"),
-						Name:  "jaeger-ingester",
-						Args:  options,
-						Env: []corev1.EnvVar{{
-							Name:  "SPAN_STORAGE_TYPE",
-							Value: string(i.jaeger.Spec.Storage.Type),
-						}},
-						VolumeMounts: commonSpec.VolumeMounts,
-						EnvFrom:      envFromSource,
+					Containers: append([]corev1.Container{{
+						Image:           util.ImageName(i.jaeger.Spec.Ingester.Image, "jaeger-ingester-image"),
+						ImagePullPolicy: commonSpec.ImagePullPolicy,
+						Name:            "jaeger-ingester",
+						Args:            options,
+						Env:             env,
+						VolumeMounts:    commonSpec.VolumeMounts,
+						EnvFrom:         envFromSource,
 						Ports: []corev1.ContainerPort{
 							{
 								ContainerPort: adminPort,
 								Name:          "admin-http",
 							},
 						},
+						SecurityContext:          util.ContainerSecurityContext(*commonSpec),
+						TerminationMessagePolicy: util.TerminationMessagePolicy(*commonSpec),
+						StartupProbe:             commonSpec.StartupProbe,
 						LivenessProbe: &corev1.Probe{
 							Handler: corev1.Handler{
 								HTTPGet: &corev1.HTTPGetAction{
@@ -156,19 +230,52 @@ func (i *Ingester) Get() *appsv1.Deployment {
 							InitialDelaySeconds: 1,
 						},
 						Resources: commonSpec.Resources,
-					}},
-					Volumes:            commonSpec.Volumes,
-					ServiceAccountName: account.JaegerServiceAccountFor(i.jaeger, account.IngesterComponent),
-					Affinity:           commonSpec.Affinity,
-					Tolerations:        commonSpec.Tolerations,
-					SecurityContext:    commonSpec.SecurityContext,
-					EnableServiceLinks: &falseVar,
+						Lifecycle: lifecycle,
+					}}, commonSpec.Sidecars...),
+					Volumes:                       commonSpec.Volumes,
+					ServiceAccountName:            account.JaegerServiceAccountFor(i.jaeger, account.IngesterComponent),
+					Affinity:                      commonSpec.Affinity,
+					Tolerations:                   commonSpec.Tolerations,
+					NodeSelector:                  commonSpec.NodeSelector,
+					SecurityContext:               commonSpec.SecurityContext,
+					RuntimeClassName:              commonSpec.RuntimeClassName,
+					TerminationGracePeriodSeconds: terminationGracePeriod,
+					EnableServiceLinks:            &falseVar,
 				},
 			},
 		},
 	}
 }
 
+// drain returns the preStop hook delaying the termination of the ingester pods, along with the termination grace
+// period, which makes room for the delay unless it has been set explicitly
+func (i *Ingester) drain() (*corev1.Lifecycle, *int64) {
+	gracePeriod := i.jaeger.Spec.Ingester.TerminationGracePeriodSeconds
+	if len(i.jaeger.Spec.Ingester.DrainDelay) == 0 {
+		return nil, gracePeriod
+	}
+
+	delay, err := time.ParseDuration(i.jaeger.Spec.Ingester.DrainDelay)
+	if err != nil {
+		i.jaeger.Logger().WithError(err).Warn("invalid ingester drain delay, no preStop hook will be set")
+		return nil, gracePeriod
+	}
+
+	seconds := int64(math.Ceil(delay.Seconds()))
+	if gracePeriod == nil {
+		defaultPeriod := seconds + corev1.DefaultTerminationGracePeriodSeconds
+		gracePeriod = &defaultPeriod
+	}
+
+	return &corev1.Lifecycle{
+		PreStop: &corev1.Handler{
+			Exec: &corev1.ExecAction{
+				Command: []string{"sleep", strconv.FormatInt(seconds, 10)},
+			},
+		},
+	}, gracePeriod
+}
+
 func (i *Ingester) labels() map[string]string {
 	return util.Labels(i.name(), "ingester", *i.jaeger)
 }
@@ -188,7 +295,31 @@ func (i *Ingester) commonSpec() v1.JaegerCommonSpec {
 }
 
 func (i *Ingester) autoscalingSpec() v1.AutoScaleSpec {
-	return i.jaeger.Spec.Ingester.AutoScaleSpec
+	spec := i.jaeger.Spec.Ingester.AutoScaleSpec
+
+	// replicas beyond the number of partitions wouldn't get any partition assigned
+	if spec.MaxReplicas == nil && i.jaeger.Spec.Ingester.KafkaPartitions != nil {
+		maxReplicas := *i.jaeger.Spec.Ingester.KafkaPartitions
+		spec.MaxReplicas = &maxReplicas
+	}
+	return spec
+}
+
+func (i *Ingester) scaleDownRules() *autoscalingv2beta2.HPAScalingRules {
+	if i.jaeger.Spec.Ingester.ScaleDown != nil {
+		return i.jaeger.Spec.Ingester.ScaleDown
+	}
+
+	// each pod removal causes a consumer group rebalance, so, we scale down slowly
+	stabilizationWindow := defaultIngesterScaleDownStabilizationWindow
+	return &autoscalingv2beta2.HPAScalingRules{
+		StabilizationWindowSeconds: &stabilizationWindow,
+		Policies: []autoscalingv2beta2.HPAScalingPolicy{{
+			Type:          autoscalingv2beta2.PodsScalingPolicy,
+			Value:         1,
+			PeriodSeconds: 300,
+		}},
+	}
 }
 
 func (i *Ingester) jaegerInstance() *v1.Jaeger {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -334,6 +335,72 @@ func TestIngesterWithStorageType(t *testing.T) {
 	assert.Equal(t, "--kafka.consumer.topic=mytopic", dep.Spec.Template.Spec.Containers[0].Args[2])
 }
 
+func TestIngesterKafkaTopicAndConsumerGroup(t *testing.T) {
+	jaeger := newIngesterJaeger("my-instance")
+	jaeger.Spec.Ingester.KafkaTopic = "my-instance-spans"
+	jaeger.Spec.Ingester.ConsumerGroup = "my-instance-ingester"
+
+	dep := NewIngester(jaeger).Get()
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--kafka.consumer.topic=my-instance-spans")
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--kafka.consumer.group-id=my-instance-ingester")
+}
+
+func TestIngesterKafkaBrokersFrom(t *testing.T) {
+	ref := &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "kafka"}, Key: "brokers"}
+	jaeger := newIngesterJaeger("my-instance")
+	jaeger.Spec.Ingester.KafkaBrokersFrom = &v1.JaegerKafkaBrokersSource{ConfigMapKeyRef: ref}
+
+	dep := NewIngester(jaeger).Get()
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{
+		Name:      "KAFKA_CONSUMER_BROKERS",
+		ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: ref},
+	})
+}
+
+func TestIngesterKafkaTopicAndConsumerGroupExplicitOptions(t *testing.T) {
+	jaeger := newIngesterJaeger("my-instance")
+	jaeger.Spec.Ingester.KafkaTopic = "my-instance-spans"
+	jaeger.Spec.Ingester.ConsumerGroup = "my-instance-ingester"
+	jaeger.Spec.Ingester.Options = v1.NewOptions(map[string]interface{}{
+		"kafka.consumer.topic":    "explicit-spans",
+		"kafka.consumer.group-id": "explicit-group",
+	})
+
+	dep := NewIngester(jaeger).Get()
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--kafka.consumer.topic=explicit-spans")
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--kafka.consumer.group-id=explicit-group")
+	assert.NotContains(t, dep.Spec.Template.Spec.Containers[0].Args, "--kafka.consumer.topic=my-instance-spans")
+	assert.NotContains(t, dep.Spec.Template.Spec.Containers[0].Args, "--kafka.consumer.group-id=my-instance-ingester")
+}
+
+func TestIngesterTuning(t *testing.T) {
+	parallelism := int32(500)
+	jaeger := newIngesterJaeger("my-instance")
+	jaeger.Spec.Ingester.DeadlockInterval = "5m"
+	jaeger.Spec.Ingester.Parallelism = &parallelism
+
+	dep := NewIngester(jaeger).Get()
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--ingester.deadlockInterval=5m")
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--ingester.parallelism=500")
+}
+
+func TestIngesterTuningExplicitOptions(t *testing.T) {
+	parallelism := int32(500)
+	jaeger := newIngesterJaeger("my-instance")
+	jaeger.Spec.Ingester.DeadlockInterval = "5m"
+	jaeger.Spec.Ingester.Parallelism = &parallelism
+	jaeger.Spec.Ingester.Options = v1.NewOptions(map[string]interface{}{
+		"ingester.deadlockInterval": "1m",
+		"ingester.parallelism":      "100",
+	})
+
+	dep := NewIngester(jaeger).Get()
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--ingester.deadlockInterval=1m")
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--ingester.parallelism=100")
+	assert.NotContains(t, dep.Spec.Template.Spec.Containers[0].Args, "--ingester.deadlockInterval=5m")
+	assert.NotContains(t, dep.Spec.Template.Spec.Containers[0].Args, "--ingester.parallelism=500")
+}
+
 func TestIngesterStandardLabels(t *testing.T) {
 	ingester := NewIngester(newIngesterJaeger("TestIngesterStandardLabels"))
 	dep := ingester.Get()
@@ -426,6 +493,81 @@ func TestIngesterAutoscalersSetMaxReplicas(t *testing.T) {
 	assert.Equal(t, maxReplicas, a[0].Spec.MaxReplicas)
 }
 
+func TestIngesterAutoscalersMaxReplicasFromKafkaPartitions(t *testing.T) {
+	// prepare
+	partitions := int32(12)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Ingester.KafkaPartitions = &partitions
+	c := NewIngester(jaeger)
+
+	// test
+	a := c.Autoscalers()
+
+	// verify
+	assert.Len(t, a, 1)
+	assert.Equal(t, partitions, a[0].Spec.MaxReplicas)
+
+	// an explicit maximum takes precedence
+	maxReplicas := int32(20)
+	jaeger.Spec.Ingester.MaxReplicas = &maxReplicas
+	a = NewIngester(jaeger).Autoscalers()
+	assert.Equal(t, maxReplicas, a[0].Spec.MaxReplicas)
+}
+
+func TestIngesterAutoscalersScaleDownBehavior(t *testing.T) {
+	// prepare
+	viper.Set("hpa-behavior-available", true)
+	defer viper.Reset()
+
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	// test
+	a := NewIngester(jaeger).Autoscalers()
+
+	// verify
+	assert.Len(t, a, 1)
+	assert.NotNil(t, a[0].Spec.Behavior)
+	assert.Equal(t, int32(600), *a[0].Spec.Behavior.ScaleDown.StabilizationWindowSeconds)
+	assert.Equal(t, []autoscalingv2beta2.HPAScalingPolicy{{Type: autoscalingv2beta2.PodsScalingPolicy, Value: 1, PeriodSeconds: 300}}, a[0].Spec.Behavior.ScaleDown.Policies)
+	assert.Nil(t, a[0].Spec.Behavior.ScaleUp)
+}
+
+func TestIngesterAutoscalersCustomScaleDownBehavior(t *testing.T) {
+	// prepare
+	viper.Set("hpa-behavior-available", true)
+	defer viper.Reset()
+
+	window := int32(900)
+	rules := &autoscalingv2beta2.HPAScalingRules{
+		StabilizationWindowSeconds: &window,
+		Policies: []autoscalingv2beta2.HPAScalingPolicy{{
+			Type:          autoscalingv2beta2.PercentScalingPolicy,
+			Value:         10,
+			PeriodSeconds: 600,
+		}},
+	}
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Ingester.ScaleDown = rules
+
+	// test
+	a := NewIngester(jaeger).Autoscalers()
+
+	// verify
+	assert.Equal(t, rules, a[0].Spec.Behavior.ScaleDown)
+}
+
+func TestIngesterAutoscalersNoBehaviorWhenUnsupported(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	// test
+	a := NewIngester(jaeger).Autoscalers()
+
+	// verify
+	assert.Len(t, a, 1)
+	assert.Nil(t, a[0].Spec.Behavior)
+}
+
 func newIngesterJaeger(name string) *v1.Jaeger {
 	return &v1.Jaeger{
 		ObjectMeta: metav1.ObjectMeta{
@@ -460,3 +602,88 @@ func TestIngesterServiceLinks(t *testing.T) {
 	falseVar := false
 	assert.Equal(t, &falseVar, dep.Spec.Template.Spec.EnableServiceLinks)
 }
+
+func TestIngesterNoDrainByDefault(t *testing.T) {
+	dep := NewIngester(newIngesterJaeger("TestIngesterNoDrainByDefault")).Get()
+	assert.Nil(t, dep.Spec.Template.Spec.Containers[0].Lifecycle)
+	assert.Nil(t, dep.Spec.Template.Spec.TerminationGracePeriodSeconds)
+}
+
+func TestIngesterDrain(t *testing.T) {
+	jaeger := newIngesterJaeger("TestIngesterDrain")
+	jaeger.Spec.Ingester.DrainDelay = "20s"
+
+	dep := NewIngester(jaeger).Get()
+
+	lifecycle := dep.Spec.Template.Spec.Containers[0].Lifecycle
+	assert.NotNil(t, lifecycle)
+	assert.Equal(t, []string{"sleep", "20"}, lifecycle.PreStop.Exec.Command)
+	assert.Equal(t, int64(50), *dep.Spec.Template.Spec.TerminationGracePeriodSeconds)
+}
+
+func TestIngesterDrainExplicitGracePeriod(t *testing.T) {
+	gracePeriod := int64(120)
+	jaeger := newIngesterJaeger("TestIngesterDrainExplicitGracePeriod")
+	jaeger.Spec.Ingester.DrainDelay = "20s"
+	jaeger.Spec.Ingester.TerminationGracePeriodSeconds = &gracePeriod
+
+	dep := NewIngester(jaeger).Get()
+
+	assert.Equal(t, []string{"sleep", "20"}, dep.Spec.Template.Spec.Containers[0].Lifecycle.PreStop.Exec.Command)
+	assert.Equal(t, int64(120), *dep.Spec.Template.Spec.TerminationGracePeriodSeconds)
+}
+
+func TestIngesterValidate(t *testing.T) {
+	int32p := func(v int32) *int32 { return &v }
+	int64p := func(v int64) *int64 { return &v }
+	configMapRef := &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "kafka"}, Key: "brokers"}
+	secretRef := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "kafka"}, Key: "brokers"}
+
+	for _, tt := range []struct {
+		name  string
+		spec  v1.JaegerIngesterSpec
+		valid bool
+	}{
+		{name: "defaults", valid: true},
+		{name: "tuning", spec: v1.JaegerIngesterSpec{DeadlockInterval: "5m", Parallelism: int32p(100)}, valid: true},
+		{name: "deadlock interval without unit", spec: v1.JaegerIngesterSpec{DeadlockInterval: "5"}, valid: false},
+		{name: "zero parallelism", spec: v1.JaegerIngesterSpec{Parallelism: int32p(0)}, valid: false},
+		{name: "drain delay", spec: v1.JaegerIngesterSpec{DrainDelay: "20s", TerminationGracePeriodSeconds: int64p(60)}, valid: true},
+		{name: "drain delay without unit", spec: v1.JaegerIngesterSpec{DrainDelay: "20"}, valid: false},
+		{name: "negative drain delay", spec: v1.JaegerIngesterSpec{DrainDelay: "-20s"}, valid: false},
+		// the pod would be killed before the drain delay is over
+		{name: "drain delay exceeding grace period", spec: v1.JaegerIngesterSpec{DrainDelay: "1m", TerminationGracePeriodSeconds: int64p(60)}, valid: false},
+		{name: "negative grace period", spec: v1.JaegerIngesterSpec{TerminationGracePeriodSeconds: int64p(-1)}, valid: false},
+		{name: "kafka partitions", spec: v1.JaegerIngesterSpec{KafkaPartitions: int32p(6)}, valid: true},
+		{name: "zero kafka partitions", spec: v1.JaegerIngesterSpec{KafkaPartitions: int32p(0)}, valid: false},
+		{name: "kafka brokers from config map", spec: v1.JaegerIngesterSpec{KafkaBrokersFrom: &v1.JaegerKafkaBrokersSource{ConfigMapKeyRef: configMapRef}}, valid: true},
+		{name: "kafka brokers from secret", spec: v1.JaegerIngesterSpec{KafkaBrokersFrom: &v1.JaegerKafkaBrokersSource{SecretKeyRef: secretRef}}, valid: true},
+		{name: "empty kafka brokers source", spec: v1.JaegerIngesterSpec{KafkaBrokersFrom: &v1.JaegerKafkaBrokersSource{}}, valid: false},
+		{name: "kafka brokers from config map and secret", spec: v1.JaegerIngesterSpec{KafkaBrokersFrom: &v1.JaegerKafkaBrokersSource{ConfigMapKeyRef: configMapRef, SecretKeyRef: secretRef}}, valid: false},
+		{name: "kafka brokers from secret without key", spec: v1.JaegerIngesterSpec{KafkaBrokersFrom: &v1.JaegerKafkaBrokersSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "kafka"}},
+		}}, valid: false},
+		{name: "kafka brokers from secret and option", spec: v1.JaegerIngesterSpec{
+			KafkaBrokersFrom: &v1.JaegerKafkaBrokersSource{SecretKeyRef: secretRef},
+			Options:          v1.NewOptions(map[string]interface{}{"kafka.consumer.brokers": "my-cluster-kafka-brokers:9092"}),
+		}, valid: false},
+		{name: "empty autoscale metrics", spec: v1.JaegerIngesterSpec{AutoScaleSpec: v1.AutoScaleSpec{Metrics: []autoscalingv2beta2.MetricSpec{}}}, valid: false},
+		{name: "autoscale metric without type", spec: v1.JaegerIngesterSpec{AutoScaleSpec: v1.AutoScaleSpec{Metrics: []autoscalingv2beta2.MetricSpec{{}}}}, valid: false},
+		{name: "empty autoscale metrics without autoscaling", spec: v1.JaegerIngesterSpec{AutoScaleSpec: v1.AutoScaleSpec{
+			Autoscale: new(bool),
+			Metrics:   []autoscalingv2beta2.MetricSpec{},
+		}}, valid: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestIngesterValidate"})
+			jaeger.Spec.Ingester = tt.spec
+
+			err := NewIngester(jaeger).Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package deployment
+
+import (
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+// kafkaBrokersEnvVars returns the environment variable with the given name, holding the Kafka brokers read from the
+// given source. Jaeger reads the brokers flags from the matching environment variables when the flags aren't set.
+func kafkaBrokersEnvVars(name string, source *v1.JaegerKafkaBrokersSource) []corev1.EnvVar {
+	if source == nil {
+		return nil
+	}
+	return []corev1.EnvVar{{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			ConfigMapKeyRef: source.ConfigMapKeyRef,
+			SecretKeyRef:    source.SecretKeyRef,
+		},
+	}}
+}
+
+// validateKafkaBrokersSource makes sure that the brokers are read from exactly one key, and that the same brokers aren't
+// also given as an option, as the flag would silently take precedence over the environment variable
+func validateKafkaBrokersSource(flag string, source *v1.JaegerKafkaBrokersSource, options ...v1.Options) error {
+	if source == nil {
+		return nil
+	}
+
+	switch {
+	case source.ConfigMapKeyRef != nil && source.SecretKeyRef != nil:
+		return errors.New("only one of configMapKeyRef and secretKeyRef can be set")
+	case source.ConfigMapKeyRef != nil:
+		if source.ConfigMapKeyRef.Name == "" || source.ConfigMapKeyRef.Key == "" {
+			return errors.New("the name and the key of the config map must not be empty")
+		}
+	case source.SecretKeyRef != nil:
+		if source.SecretKeyRef.Name == "" || source.SecretKeyRef.Key == "" {
+			return errors.New("the name and the key of the secret must not be empty")
+		}
+	default:
+		return errors.New("either configMapKeyRef or secretKeyRef has to be set")
+	}
+
+	for _, opts := range options {
+		if _, ok := opts.Map()[flag]; ok {
+			return errors.Errorf("the option %s can't be used together with the brokers from a config map or secret", flag)
+		}
+	}
+	return nil
+}
@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,9 +15,12 @@ import (
 
 	"github.com/jaegertracing/jaeger-operator/pkg/account"
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/aws"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/tls"
 	configmap "github.com/jaegertracing/jaeger-operator/pkg/config/ui"
 	"github.com/jaegertracing/jaeger-operator/pkg/service"
+	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
@@ -28,11 +34,86 @@ func NewQuery(jaeger *v1.Jaeger) *Query {
 	return &Query{jaeger: jaeger}
 }
 
+// Validate makes sure that the query's settings are consistent with each other and with the storage
+func (q *Query) Validate() error {
+	spec := q.jaeger.Spec.Query
+	storageType := q.jaeger.Spec.Storage.Type
+
+	if queryStorage := spec.Storage; queryStorage != nil {
+		if storageType == "" {
+			storageType = v1.JaegerMemoryStorage
+		}
+		if queryStorage.Type != "" && queryStorage.Type != storageType {
+			return errors.Errorf("the query storage type %q doesn't match the storage type %q", queryStorage.Type, storageType)
+		}
+		if _, ok := queryStorage.Options.Map()["es.server-urls"]; ok && storage.ShouldDeployElasticsearch(q.jaeger.Spec.Storage) {
+			return errors.New("query.storage can't override es.server-urls when the Elasticsearch cluster is provisioned by the operator")
+		}
+		if queryStorage.TLS != nil {
+			if storageType != v1.JaegerESStorage && storageType != v1.JaegerCassandraStorage {
+				return errors.Errorf("query.storage.tls can't be used with the %q storage", storageType)
+			}
+			if storage.ShouldDeployElasticsearch(q.jaeger.Spec.Storage) {
+				return errors.New("query.storage.tls can't be used when the Elasticsearch cluster is provisioned by the operator")
+			}
+			if strings.TrimSpace(queryStorage.TLS.CASecretName) == "" && strings.TrimSpace(queryStorage.TLS.SecretName) == "" {
+				return errors.New("query.storage.tls has to reference a CA secret, a client certificate secret, or both")
+			}
+		}
+	}
+
+	for _, zone := range spec.Zones {
+		if strings.TrimSpace(zone) == "" {
+			return errors.New("query.zones must not contain empty zones")
+		}
+	}
+
+	if spec.MaxClockSkewAdjustment != "" {
+		if _, err := time.ParseDuration(spec.MaxClockSkewAdjustment); err != nil {
+			return errors.Wrap(err, "failed to parse query.maxClockSkewAdjustment to time.Duration")
+		}
+	}
+
+	if spec.StorageTimeout != "" {
+		if _, err := time.ParseDuration(spec.StorageTimeout); err != nil {
+			return errors.Wrap(err, "failed to parse query.storageTimeout to time.Duration")
+		}
+		if t := q.jaeger.Spec.Storage.Type; t != v1.JaegerESStorage && t != v1.JaegerCassandraStorage {
+			return errors.Errorf("query.storageTimeout can't be used with the %q storage", t)
+		}
+	}
+
+	if spec.MaxSpanAge != "" {
+		if _, err := time.ParseDuration(spec.MaxSpanAge); err != nil {
+			return errors.Wrap(err, "failed to parse query.maxSpanAge to time.Duration")
+		}
+		if t := q.jaeger.Spec.Storage.Type; t != v1.JaegerESStorage {
+			return errors.Errorf("query.maxSpanAge can't be used with the %q storage", t)
+		}
+	}
+
+	switch spec.SessionAffinity {
+	case "", corev1.ServiceAffinityNone, corev1.ServiceAffinityClientIP:
+	default:
+		return errors.Errorf("invalid query.sessionAffinity %q, possible values: %s, %s", spec.SessionAffinity, corev1.ServiceAffinityNone, corev1.ServiceAffinityClientIP)
+	}
+
+	if timeout := spec.SessionAffinityTimeoutSeconds; timeout != nil {
+		if spec.SessionAffinity != corev1.ServiceAffinityClientIP {
+			return errors.New("query.sessionAffinityTimeoutSeconds can only be used with the ClientIP session affinity")
+		}
+		if *timeout <= 0 || *timeout > 86400 {
+			return errors.Errorf("query.sessionAffinityTimeoutSeconds has to be between 1 and 86400, got %d", *timeout)
+		}
+	}
+
+	return nil
+}
+
 // Get returns a deployment specification for the current instance
 func (q *Query) Get() *appsv1.Deployment {
 	q.jaeger.Logger().Debug("Assembling a query deployment")
 	labels := q.labels()
-	trueVar := true
 	falseVar := false
 
 	args := append(q.jaeger.Spec.Query.Options.ToArgs())
@@ -62,24 +143,62 @@ func (q *Query) Get() *appsv1.Deployment {
 	}
 
 	commonSpec := util.Merge([]v1.JaegerCommonSpec{q.jaeger.Spec.Query.JaegerCommonSpec, q.jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
+	util.PodSecurityDefaults(commonSpec)
 
+	storageOptions := q.storageOptions()
 	options := allArgs(q.jaeger.Spec.Query.Options,
-		q.jaeger.Spec.Storage.Options.Filter(q.jaeger.Spec.Storage.Type.OptionsPrefix()))
+		storageOptions.Filter(q.jaeger.Spec.Storage.Type.OptionsPrefix()))
+
+	// we only add the clock skew adjustment if there's no explicit value yet
+	if len(q.jaeger.Spec.Query.MaxClockSkewAdjustment) > 0 && len(util.FindItem("--query.max-clock-skew-adjustment=", options)) == 0 {
+		options = append(options, fmt.Sprintf("--query.max-clock-skew-adjustment=%s", q.jaeger.Spec.Query.MaxClockSkewAdjustment))
+	}
+
+	switch q.jaeger.Spec.Storage.Type {
+	case v1.JaegerESStorage, v1.JaegerCassandraStorage:
+		flag := fmt.Sprintf("--%s.timeout=", q.jaeger.Spec.Storage.Type.OptionsPrefix())
+		if len(q.jaeger.Spec.Query.StorageTimeout) > 0 && len(util.FindItem(flag, options)) == 0 {
+			options = append(options, flag+q.jaeger.Spec.Query.StorageTimeout)
+		}
+	}
+	if q.jaeger.Spec.Storage.Type == v1.JaegerESStorage && len(q.jaeger.Spec.Query.MaxSpanAge) > 0 && len(util.FindItem("--es.max-span-age=", options)) == 0 {
+		options = append(options, fmt.Sprintf("--es.max-span-age=%s", q.jaeger.Spec.Query.MaxSpanAge))
+	}
 
 	configmap.Update(q.jaeger, commonSpec, &options)
+	tls.UpdateQueryStorage(q.jaeger, commonSpec, &options)
 	ca.Update(q.jaeger, commonSpec)
+	aws.Update(q.jaeger, commonSpec)
 
 	var envFromSource []corev1.EnvFromSource
-	if len(q.jaeger.Spec.Storage.SecretName) > 0 {
+	if secretName := q.storageSecretName(); len(secretName) > 0 {
 		envFromSource = append(envFromSource, corev1.EnvFromSource{
 			SecretRef: &corev1.SecretEnvSource{
 				LocalObjectReference: corev1.LocalObjectReference{
-					Name: q.jaeger.Spec.Storage.SecretName,
+					Name: secretName,
 				},
 			},
 		})
 	}
 
+	env := []corev1.EnvVar{
+		{
+			Name:  "SPAN_STORAGE_TYPE",
+			Value: string(q.jaeger.Spec.Storage.Type),
+		},
+		{
+			Name:  "JAEGER_DISABLED",
+			Value: strconv.FormatBool(jaegerDisabled),
+		},
+	}
+	env = append(env, aws.EnvVars(q.jaeger)...)
+	if !jaegerDisabled {
+		env = append(env, util.InternalTracingEnvVars(*commonSpec)...)
+	}
+	env = append(env, util.CommonEnvVars(*commonSpec, env)...)
+
+	options = append(options, util.LogArgs(*commonSpec, options)...)
+
 	// ensure we have a consistent order of the arguments
 	// see https://github.com/jaegertracing/jaeger-operator/issues/334
 	sort.Strings(options)
@@ -90,17 +209,11 @@ func (q *Query) Get() *appsv1.Deployment {
 			Kind:       "Deployment",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        fmt.Sprintf("%s-query", q.jaeger.Name),
-			Namespace:   q.jaeger.Namespace,
-			Labels:      commonSpec.Labels,
-			Annotations: commonSpec.Annotations,
-			OwnerReferences: []metav1.OwnerReference{{
-				APIVersion: q.jaeger.APIVersion,
-				Kind:       q.jaeger.Kind,
-				Name:       q.jaeger.Name,
-				UID:        q.jaeger.UID,
-				Controller: &trueVar,
-			}},
+			Name:            fmt.Sprintf("%s-query", q.jaeger.Name),
+			Namespace:       q.jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			Annotations:     commonSpec.Annotations,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(q.jaeger)},
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: q.jaeger.Spec.Query.Replicas,
@@ -113,22 +226,14 @@ func (q *Query) Get() *appsv1.Deployment {
 					Annotations: commonSpec.Annotations,
 				},
 				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{{
-						Image: util.ImageName(q.jaeger.Spec.Query.Image, "jaeger-query-image"),
-						Name:  "jaeger-query",
-						Args:  options,
-						Env: []corev1.EnvVar{
-							{
-								Name:  "SPAN_STORAGE_TYPE",
-								Value: string(q.jaeger.Spec.Storage.Type),
-							},
-							{
-								Name:  "JAEGER_DISABLED",
-								Value: strconv.FormatBool(jaegerDisabled),
-							},
-						},
-						VolumeMounts: commonSpec.VolumeMounts,
-						EnvFrom:      envFromSource,
+					Containers: append([]corev1.Container{{
+						Image:           util.ImageName(q.jaeger.Spec.Query.Image, "jaeger-query-image"),
+						ImagePullPolicy: commonSpec.ImagePullPolicy,
+						Name:            "jaeger-query",
+						Args:            options,
+						Env:             env,
+						VolumeMounts:    commonSpec.VolumeMounts,
+						EnvFrom:         envFromSource,
 						Ports: []corev1.ContainerPort{
 							{
 								ContainerPort: 16686,
@@ -139,6 +244,9 @@ func (q *Query) Get() *appsv1.Deployment {
 								Name:          "admin-http",
 							},
 						},
+						SecurityContext:          util.ContainerSecurityContext(*commonSpec),
+						TerminationMessagePolicy: util.TerminationMessagePolicy(*commonSpec),
+						StartupProbe:             commonSpec.StartupProbe,
 						LivenessProbe: &corev1.Probe{
 							Handler: corev1.Handler{
 								HTTPGet: &corev1.HTTPGetAction{
@@ -160,12 +268,14 @@ func (q *Query) Get() *appsv1.Deployment {
 							InitialDelaySeconds: 1,
 						},
 						Resources: commonSpec.Resources,
-					}},
+					}}, commonSpec.Sidecars...),
 					Volumes:            commonSpec.Volumes,
 					ServiceAccountName: account.JaegerServiceAccountFor(q.jaeger, account.QueryComponent),
-					Affinity:           commonSpec.Affinity,
+					Affinity:           q.affinity(commonSpec.Affinity),
 					Tolerations:        commonSpec.Tolerations,
+					NodeSelector:       commonSpec.NodeSelector,
 					SecurityContext:    commonSpec.SecurityContext,
+					RuntimeClassName:   commonSpec.RuntimeClassName,
 					EnableServiceLinks: &falseVar,
 				},
 			},
@@ -188,3 +298,53 @@ func (q *Query) labels() map[string]string {
 func (q *Query) name() string {
 	return fmt.Sprintf("%s-query", q.jaeger.Name)
 }
+
+// storageOptions returns the storage options, with the query-specific overrides applied on top of them
+func (q *Query) storageOptions() v1.Options {
+	storageOpts := q.jaeger.Spec.Storage.EffectiveOptions()
+	options := storageOpts.GenericMap()
+	if q.jaeger.Spec.Query.Storage != nil {
+		if q.jaeger.Spec.Query.Storage.TLS != nil {
+			// the query's own TLS material replaces the TLS settings it would inherit from the storage options
+			prefix := q.jaeger.Spec.Storage.Type.OptionsPrefix() + ".tls"
+			for k := range options {
+				if k == prefix || strings.HasPrefix(k, prefix+".") {
+					delete(options, k)
+				}
+			}
+		}
+		for k, v := range q.jaeger.Spec.Query.Storage.Options.GenericMap() {
+			options[k] = v
+		}
+	}
+	return v1.NewOptions(options)
+}
+
+// storageSecretName returns the name of the storage secret, which can be replaced for the query
+func (q *Query) storageSecretName() string {
+	if q.jaeger.Spec.Query.Storage != nil && len(q.jaeger.Spec.Query.Storage.SecretName) > 0 {
+		return q.jaeger.Spec.Query.Storage.SecretName
+	}
+	return q.jaeger.Spec.Storage.SecretName
+}
+
+// affinity returns the given affinity, falling back to a required node affinity for the configured zones
+func (q *Query) affinity(affinity *corev1.Affinity) *corev1.Affinity {
+	if affinity != nil || len(q.jaeger.Spec.Query.Zones) == 0 {
+		return affinity
+	}
+
+	return &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{
+						Key:      "topology.kubernetes.io/zone",
+						Operator: corev1.NodeSelectorOpIn,
+						Values:   q.jaeger.Spec.Query.Zones,
+					}},
+				}},
+			},
+		},
+	}
+}
@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
 )
@@ -331,3 +332,340 @@ func TestQueryTracingDisabled(t *testing.T) {
 	dep := query.Get()
 	assert.Equal(t, "true", getEnvVarByName(dep.Spec.Template.Spec.Containers[0].Env, "JAEGER_DISABLED").Value)
 }
+
+func TestQueryInternalTracing(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.InternalTracing = &v1.JaegerInternalTracingSpec{Endpoint: "http://meta:14268/api/traces"}
+	jaeger.Spec.Query.InternalTracing = &v1.JaegerInternalTracingSpec{Endpoint: "http://query-meta:14268/api/traces"}
+
+	dep := NewQuery(jaeger).Get()
+
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "JAEGER_ENDPOINT", Value: "http://query-meta:14268/api/traces"})
+	assert.Equal(t, "false", getEnvVarByName(dep.Spec.Template.Spec.Containers[0].Env, "JAEGER_DISABLED").Value)
+}
+
+func TestQueryInternalTracingSkippedWhenTracingDisabled(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.InternalTracing = &v1.JaegerInternalTracingSpec{Endpoint: "http://meta:14268/api/traces"}
+	falseVar := false
+	jaeger.Spec.Query.TracingEnabled = &falseVar
+
+	dep := NewQuery(jaeger).Get()
+
+	assert.Equal(t, "true", getEnvVarByName(dep.Spec.Template.Spec.Containers[0].Env, "JAEGER_DISABLED").Value)
+	for _, env := range dep.Spec.Template.Spec.Containers[0].Env {
+		assert.NotEqual(t, "JAEGER_ENDPOINT", env.Name)
+	}
+}
+
+func TestQueryLogSettings(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryLogSettings"})
+	jaeger.Spec.LogLevel = "info"
+	jaeger.Spec.Query.LogLevel = "debug"
+
+	dep := NewQuery(jaeger).Get()
+
+	args := dep.Spec.Template.Spec.Containers[0].Args
+	assert.Contains(t, args, "--log-level=debug")
+	assert.NotContains(t, args, "--log-level=info")
+}
+
+func TestQueryLogLevelIndependentOfCollector(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryLogLevelIndependentOfCollector"})
+	jaeger.Spec.LogLevel = "info"
+	jaeger.Spec.Query.LogLevel = "debug"
+
+	queryArgs := NewQuery(jaeger).Get().Spec.Template.Spec.Containers[0].Args
+	collectorArgs := NewCollector(jaeger).Get().Spec.Template.Spec.Containers[0].Args
+
+	assert.Contains(t, queryArgs, "--log-level=debug")
+	assert.NotContains(t, queryArgs, "--log-level=info")
+	assert.Contains(t, collectorArgs, "--log-level=info")
+	assert.NotContains(t, collectorArgs, "--log-level=debug")
+}
+
+func TestQueryLogLevelExplicitOption(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryLogLevelExplicitOption"})
+	jaeger.Spec.LogLevel = "info"
+	jaeger.Spec.Query.Options = v1.NewOptions(map[string]interface{}{"log-level": "error"})
+
+	dep := NewQuery(jaeger).Get()
+
+	args := dep.Spec.Template.Spec.Containers[0].Args
+	assert.Contains(t, args, "--log-level=error")
+	assert.NotContains(t, args, "--log-level=info")
+}
+
+func TestQueryStartupProbe(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryStartupProbe"})
+
+	dep := NewQuery(jaeger).Get()
+	assert.Nil(t, dep.Spec.Template.Spec.Containers[0].StartupProbe)
+
+	probe := &corev1.Probe{
+		Handler: corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{Path: "/", Port: intstr.FromInt(16687)},
+		},
+		FailureThreshold: 30,
+	}
+	jaeger.Spec.StartupProbe = probe
+
+	dep = NewQuery(jaeger).Get()
+	assert.Equal(t, probe, dep.Spec.Template.Spec.Containers[0].StartupProbe)
+}
+
+func TestQuerySidecars(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQuerySidecars"})
+	jaeger.Spec.Query.Sidecars = []corev1.Container{{Name: "log-shipper", Image: "fluent-bit"}}
+
+	dep := NewQuery(jaeger).Get()
+
+	containers := dep.Spec.Template.Spec.Containers
+	assert.Len(t, containers, 2)
+	assert.Equal(t, "jaeger-query", containers[0].Name)
+	assert.Equal(t, "log-shipper", containers[1].Name)
+}
+
+func TestQueryCapabilities(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryCapabilities"})
+	capabilities := &corev1.Capabilities{Drop: []corev1.Capability{"NET_RAW"}}
+	jaeger.Spec.Capabilities = capabilities
+
+	dep := NewQuery(jaeger).Get()
+
+	assert.Equal(t, capabilities, dep.Spec.Template.Spec.Containers[0].SecurityContext.Capabilities)
+}
+
+func TestQueryAWSWebIdentity(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryAWSWebIdentity"})
+	jaeger.Spec.Storage.AWSWebIdentity.RoleARN = "arn:aws:iam::123456789012:role/jaeger"
+
+	podSpec := NewQuery(jaeger).Get().Spec.Template.Spec
+
+	assert.Contains(t, podSpec.Containers[0].Env, corev1.EnvVar{Name: "AWS_ROLE_ARN", Value: "arn:aws:iam::123456789012:role/jaeger"})
+	assert.True(t, hasVolume("aws-iam-token", podSpec.Volumes))
+	assert.True(t, hasVolumeMount("aws-iam-token", podSpec.Containers[0].VolumeMounts))
+}
+
+func TestQueryMaxClockSkewAdjustment(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryMaxClockSkewAdjustment"})
+	jaeger.Spec.Query.MaxClockSkewAdjustment = "0s"
+
+	dep := NewQuery(jaeger).Get()
+
+	assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--query.max-clock-skew-adjustment=0s")
+}
+
+func TestQueryMaxClockSkewAdjustmentExplicitOption(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryMaxClockSkewAdjustmentExplicitOption"})
+	jaeger.Spec.Query.MaxClockSkewAdjustment = "0s"
+	jaeger.Spec.Query.Options = v1.NewOptions(map[string]interface{}{"query.max-clock-skew-adjustment": "1s"})
+
+	dep := NewQuery(jaeger).Get()
+
+	args := dep.Spec.Template.Spec.Containers[0].Args
+	assert.Contains(t, args, "--query.max-clock-skew-adjustment=1s")
+	assert.NotContains(t, args, "--query.max-clock-skew-adjustment=0s")
+}
+
+func TestQueryZones(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryZones"})
+	jaeger.Spec.Query.Zones = []string{"eu-west-1b"}
+
+	dep := NewQuery(jaeger).Get()
+
+	affinity := dep.Spec.Template.Spec.Affinity
+	assert.NotNil(t, affinity)
+	assert.Equal(t, []corev1.NodeSelectorRequirement{{
+		Key:      "topology.kubernetes.io/zone",
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   []string{"eu-west-1b"},
+	}}, affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions)
+}
+
+func TestQueryZonesExplicitAffinity(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryZonesExplicitAffinity"})
+	jaeger.Spec.Query.Zones = []string{"eu-west-1b"}
+	jaeger.Spec.Query.Affinity = &corev1.Affinity{PodAffinity: &corev1.PodAffinity{}}
+
+	dep := NewQuery(jaeger).Get()
+
+	assert.Equal(t, jaeger.Spec.Query.Affinity, dep.Spec.Template.Spec.Affinity)
+}
+
+func TestQueryStorageOverride(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryStorageOverride"})
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Storage.SecretName = "es-new"
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{
+		"es.server-urls": "http://es-new:9200",
+		"es.timeout":     "10s",
+	})
+	jaeger.Spec.Query.Storage = &v1.JaegerQueryStorageSpec{
+		SecretName: "es-old",
+		Options:    v1.NewOptions(map[string]interface{}{"es.server-urls": "http://es-old:9200"}),
+	}
+
+	container := NewQuery(jaeger).Get().Spec.Template.Spec.Containers[0]
+
+	assert.Contains(t, container.Args, "--es.server-urls=http://es-old:9200")
+	assert.NotContains(t, container.Args, "--es.server-urls=http://es-new:9200")
+	assert.Contains(t, container.Args, "--es.timeout=10s")
+	assert.Len(t, container.EnvFrom, 1)
+	assert.Equal(t, "es-old", container.EnvFrom[0].SecretRef.Name)
+
+	// the collector keeps writing to the main storage
+	collector := NewCollector(jaeger).Get().Spec.Template.Spec.Containers[0]
+	assert.Contains(t, collector.Args, "--es.server-urls=http://es-new:9200")
+}
+
+func TestQueryStorageTLS(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryStorageTLS"})
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"es.server-urls": "https://es:9200"})
+	jaeger.Spec.Query.Storage = &v1.JaegerQueryStorageSpec{
+		TLS: &v1.JaegerQueryStorageTLSSpec{CASecretName: "es-ca", SecretName: "query-es-client"},
+	}
+
+	container := NewQuery(jaeger).Get().Spec.Template.Spec.Containers[0]
+	assert.Contains(t, container.Args, "--es.tls.enabled=true")
+	assert.Contains(t, container.Args, "--es.tls.cert=/etc/query-storage-tls-config/tls.crt")
+
+	// the collector keeps its own TLS configuration
+	collector := NewCollector(jaeger).Get().Spec.Template.Spec.Containers[0]
+	assert.NotContains(t, collector.Args, "--es.tls.enabled=true")
+}
+
+func TestQueryStorageTLSOverridesStorageOptions(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryStorageTLSOverridesStorageOptions"})
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{
+		"es.server-urls": "https://es:9200",
+		"es.tls.enabled": "true",
+		"es.tls.ca":      "/etc/collector/ca.crt",
+		"es.tls.cert":    "/etc/collector/tls.crt",
+		"es-archive.tls": "true",
+	})
+	jaeger.Spec.Query.Storage = &v1.JaegerQueryStorageSpec{
+		Options: v1.NewOptions(map[string]interface{}{"es.tls.key": "/etc/custom/tls.key"}),
+		TLS:     &v1.JaegerQueryStorageTLSSpec{CASecretName: "es-ca", SecretName: "query-es-client"},
+	}
+
+	args := NewQuery(jaeger).Get().Spec.Template.Spec.Containers[0].Args
+	assert.Contains(t, args, "--es.tls.enabled=true")
+	assert.Contains(t, args, "--es.tls.ca=/etc/query-storage-tls-ca/ca.crt")
+	assert.Contains(t, args, "--es.tls.cert=/etc/query-storage-tls-config/tls.crt")
+	assert.NotContains(t, args, "--es.tls.ca=/etc/collector/ca.crt")
+	assert.NotContains(t, args, "--es.tls.cert=/etc/collector/tls.crt")
+
+	// the query's own options still take precedence
+	assert.Contains(t, args, "--es.tls.key=/etc/custom/tls.key")
+	assert.NotContains(t, args, "--es.tls.key=/etc/query-storage-tls-config/tls.key")
+
+	// the archive settings aren't part of the query's storage TLS
+	assert.Contains(t, args, "--es-archive.tls=true")
+}
+
+func TestQueryStorageTimeouts(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryStorageTimeouts"})
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Query.StorageTimeout = "30s"
+	jaeger.Spec.Query.MaxSpanAge = "168h"
+
+	args := NewQuery(jaeger).Get().Spec.Template.Spec.Containers[0].Args
+
+	assert.Contains(t, args, "--es.timeout=30s")
+	assert.Contains(t, args, "--es.max-span-age=168h")
+}
+
+func TestQueryStorageTimeoutCassandra(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryStorageTimeoutCassandra"})
+	jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+	jaeger.Spec.Query.StorageTimeout = "30s"
+
+	args := NewQuery(jaeger).Get().Spec.Template.Spec.Containers[0].Args
+
+	assert.Contains(t, args, "--cassandra.timeout=30s")
+	assert.NotContains(t, args, "--es.timeout=30s")
+}
+
+func TestQueryStorageTimeoutsExplicitOptions(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryStorageTimeoutsExplicitOptions"})
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"es.timeout": "10s", "es.max-span-age": "24h"})
+	jaeger.Spec.Query.StorageTimeout = "30s"
+	jaeger.Spec.Query.MaxSpanAge = "168h"
+
+	args := NewQuery(jaeger).Get().Spec.Template.Spec.Containers[0].Args
+
+	assert.Contains(t, args, "--es.timeout=10s")
+	assert.Contains(t, args, "--es.max-span-age=24h")
+	assert.NotContains(t, args, "--es.timeout=30s")
+	assert.NotContains(t, args, "--es.max-span-age=168h")
+}
+
+func TestQueryValidate(t *testing.T) {
+	int32p := func(v int32) *int32 { return &v }
+	externalES := v1.JaegerStorageSpec{Type: v1.JaegerESStorage, Options: v1.NewOptions(map[string]interface{}{"es.server-urls": "https://es:9200"})}
+	// the operator provisions the cluster, injecting its URL and setting up its TLS
+	provisionedES := v1.JaegerStorageSpec{Type: v1.JaegerESStorage}
+
+	for _, tt := range []struct {
+		name    string
+		storage v1.JaegerStorageSpec
+		query   v1.JaegerQuerySpec
+		valid   bool
+	}{
+		{name: "defaults", valid: true},
+		{name: "query storage", storage: externalES, query: v1.JaegerQuerySpec{Storage: &v1.JaegerQueryStorageSpec{
+			Type:    v1.JaegerESStorage,
+			Options: v1.NewOptions(map[string]interface{}{"es.server-urls": "http://es-old:9200"}),
+		}}, valid: true},
+		{name: "query storage of another type", storage: externalES, query: v1.JaegerQuerySpec{Storage: &v1.JaegerQueryStorageSpec{Type: v1.JaegerCassandraStorage}}, valid: false},
+		{name: "query storage overriding the provisioned cluster", storage: provisionedES, query: v1.JaegerQuerySpec{Storage: &v1.JaegerQueryStorageSpec{
+			Options: v1.NewOptions(map[string]interface{}{"es.server-urls": "http://es-old:9200"}),
+		}}, valid: false},
+		{name: "query storage tls", storage: externalES, query: v1.JaegerQuerySpec{Storage: &v1.JaegerQueryStorageSpec{
+			TLS: &v1.JaegerQueryStorageTLSSpec{SecretName: "query-es-client"},
+		}}, valid: true},
+		{name: "query storage tls without secrets", storage: externalES, query: v1.JaegerQuerySpec{Storage: &v1.JaegerQueryStorageSpec{
+			TLS: &v1.JaegerQueryStorageTLSSpec{},
+		}}, valid: false},
+		{name: "query storage tls for the provisioned cluster", storage: provisionedES, query: v1.JaegerQuerySpec{Storage: &v1.JaegerQueryStorageSpec{
+			TLS: &v1.JaegerQueryStorageTLSSpec{CASecretName: "es-ca"},
+		}}, valid: false},
+		{name: "query storage tls for the memory storage", query: v1.JaegerQuerySpec{Storage: &v1.JaegerQueryStorageSpec{
+			TLS: &v1.JaegerQueryStorageTLSSpec{CASecretName: "es-ca"},
+		}}, valid: false},
+		{name: "zones", query: v1.JaegerQuerySpec{Zones: []string{"eu-west-1a", "eu-west-1b"}}, valid: true},
+		{name: "empty zone", query: v1.JaegerQuerySpec{Zones: []string{"eu-west-1a", ""}}, valid: false},
+		{name: "max clock skew adjustment", query: v1.JaegerQuerySpec{MaxClockSkewAdjustment: "0s"}, valid: true},
+		{name: "invalid max clock skew adjustment", query: v1.JaegerQuerySpec{MaxClockSkewAdjustment: "1 second"}, valid: false},
+		{name: "storage timeouts", storage: externalES, query: v1.JaegerQuerySpec{StorageTimeout: "30s", MaxSpanAge: "168h"}, valid: true},
+		{name: "storage timeout without unit", storage: externalES, query: v1.JaegerQuerySpec{StorageTimeout: "30"}, valid: false},
+		{name: "storage timeout for the memory storage", query: v1.JaegerQuerySpec{StorageTimeout: "30s"}, valid: false},
+		{name: "storage timeout for cassandra", storage: v1.JaegerStorageSpec{Type: v1.JaegerCassandraStorage}, query: v1.JaegerQuerySpec{StorageTimeout: "30s"}, valid: true},
+		{name: "max span age in days", storage: externalES, query: v1.JaegerQuerySpec{MaxSpanAge: "7d"}, valid: false},
+		// the max span age is specific to Elasticsearch
+		{name: "max span age for cassandra", storage: v1.JaegerStorageSpec{Type: v1.JaegerCassandraStorage}, query: v1.JaegerQuerySpec{MaxSpanAge: "168h"}, valid: false},
+		{name: "no session affinity", query: v1.JaegerQuerySpec{SessionAffinity: corev1.ServiceAffinityNone}, valid: true},
+		{name: "client ip session affinity", query: v1.JaegerQuerySpec{SessionAffinity: corev1.ServiceAffinityClientIP, SessionAffinityTimeoutSeconds: int32p(3600)}, valid: true},
+		{name: "unknown session affinity", query: v1.JaegerQuerySpec{SessionAffinity: "Cookie"}, valid: false},
+		{name: "session affinity timeout without client ip", query: v1.JaegerQuerySpec{SessionAffinityTimeoutSeconds: int32p(3600)}, valid: false},
+		{name: "zero session affinity timeout", query: v1.JaegerQuerySpec{SessionAffinity: corev1.ServiceAffinityClientIP, SessionAffinityTimeoutSeconds: int32p(0)}, valid: false},
+		{name: "session affinity timeout too long", query: v1.JaegerQuerySpec{SessionAffinity: corev1.ServiceAffinityClientIP, SessionAffinityTimeoutSeconds: int32p(86401)}, valid: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryValidate"})
+			jaeger.Spec.Storage = tt.storage
+			jaeger.Spec.Query = tt.query
+
+			err := NewQuery(jaeger).Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package ingress
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	netv1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/service"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+// CollectorPath is the path of the collector's HTTP endpoint accepting spans in the Thrift format
+const CollectorPath = "/api/traces"
+
+// CollectorIngress builds an ingress for the collector endpoint of an all-in-one instance
+type CollectorIngress struct {
+	jaeger *v1.Jaeger
+}
+
+// NewCollectorIngress builds a new CollectorIngress struct based on the given spec
+func NewCollectorIngress(jaeger *v1.Jaeger) *CollectorIngress {
+	return &CollectorIngress{jaeger: jaeger}
+}
+
+// Validate makes sure that the collector's ingress can be routed without hosts of its own, as it then shares the
+// hosts of the query
+func (i *CollectorIngress) Validate() error {
+	collector := i.jaeger.Spec.Ingress.Collector
+	if collector.Enabled == nil || !*collector.Enabled || len(collector.Hosts) > 0 {
+		return nil
+	}
+
+	// the collector is then routed on its path only, next to the query
+	if collector.Port != 0 && collector.Port != service.GetThriftHTTPPortForCollector(i.jaeger) {
+		return errors.New("ingress.collector.hosts must be set when exposing a collector port other than the Thrift HTTP endpoint")
+	}
+	basePath := strings.Trim(i.jaeger.Spec.AllInOne.Options.Map()["query.base-path"], "/")
+	if len(i.jaeger.Spec.Ingress.Hosts) == 0 && basePath == "" {
+		return errors.Errorf("ingress.collector.hosts must be set when the query isn't served under a base path or its own hosts, as the collector's %s path would shadow the query's API", CollectorPath)
+	}
+	return nil
+}
+
+// Get returns an ingress specification for the current instance, or nil when the collector isn't meant to be exposed
+func (i *CollectorIngress) Get() *netv1beta1.Ingress {
+	if i.jaeger.Spec.Ingress.Enabled != nil && *i.jaeger.Spec.Ingress.Enabled == false {
+		return nil
+	}
+
+	if i.jaeger.Spec.Strategy != v1.DeploymentStrategyAllInOne {
+		return nil
+	}
+
+	if i.jaeger.Spec.Ingress.Collector.Enabled == nil || *i.jaeger.Spec.Ingress.Collector.Enabled == false {
+		return nil
+	}
+
+	baseCommonSpec := v1.JaegerCommonSpec{
+		Labels: util.Labels(fmt.Sprintf("%s-collector", i.jaeger.Name), "collector-ingress", *i.jaeger),
+	}
+
+	commonSpec := util.Merge([]v1.JaegerCommonSpec{i.jaeger.Spec.Ingress.JaegerCommonSpec, i.jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
+
+	spec := netv1beta1.IngressSpec{}
+	backend := netv1beta1.IngressBackend{
+		ServiceName: service.GetNameForCollectorService(i.jaeger),
+		ServicePort: intstr.FromInt(int(service.GetPortForCollectorIngress(i.jaeger))),
+	}
+
+	// without hosts of its own, the collector shares the hosts of the query, which keeps the default backend:
+	// only the path of the Thrift HTTP endpoint is routed to the collector then
+	path := ""
+	if len(i.jaeger.Spec.Ingress.Collector.Hosts) == 0 {
+		path = CollectorPath
+	}
+	spec.Rules = getRules(path, i.jaeger.Spec.Ingress.Collector.Hosts, &backend)
+
+	return &netv1beta1.Ingress{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Ingress",
+			APIVersion: "networking.k8s.io/v1beta1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-collector", i.jaeger.Name),
+			Namespace:       i.jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(i.jaeger)},
+			Annotations:     commonSpec.Annotations,
+		},
+		Spec: spec,
+	}
+}
@@ -0,0 +1,113 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestCollectorIngressDefaultsToQueryOnly(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorIngressDefaultsToQueryOnly"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
+
+	assert.Nil(t, NewCollectorIngress(jaeger).Get())
+}
+
+func TestCollectorIngressOnlyForAllInOne(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorIngressOnlyForAllInOne"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyProduction
+	jaeger.Spec.Ingress.Collector.Enabled = &enabled
+
+	assert.Nil(t, NewCollectorIngress(jaeger).Get())
+}
+
+func TestCollectorIngressDisabledIngress(t *testing.T) {
+	enabled := true
+	disabled := false
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorIngressDisabledIngress"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
+	jaeger.Spec.Ingress.Enabled = &disabled
+	jaeger.Spec.Ingress.Collector.Enabled = &enabled
+
+	assert.Nil(t, NewCollectorIngress(jaeger).Get())
+}
+
+func TestCollectorIngressEnabled(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorIngressEnabled"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
+	jaeger.Spec.Ingress.Collector.Enabled = &enabled
+
+	dep := NewCollectorIngress(jaeger).Get()
+
+	assert.NotNil(t, dep)
+	assert.Equal(t, "TestCollectorIngressEnabled-collector", dep.Name)
+	assert.Nil(t, dep.Spec.Backend)
+	assert.Len(t, dep.Spec.Rules, 1)
+	assert.Empty(t, dep.Spec.Rules[0].Host)
+	assert.Equal(t, "/api/traces", dep.Spec.Rules[0].HTTP.Paths[0].Path)
+	assert.Equal(t, "testcollectoringressenabled-collector", dep.Spec.Rules[0].HTTP.Paths[0].Backend.ServiceName)
+	assert.Equal(t, intstr.FromInt(14268), dep.Spec.Rules[0].HTTP.Paths[0].Backend.ServicePort)
+}
+
+func TestCollectorIngressWithHostsAndPort(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorIngressWithHostsAndPort"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
+	jaeger.Spec.Ingress.Hosts = []string{"query.example.com"}
+	jaeger.Spec.Ingress.Collector.Enabled = &enabled
+	jaeger.Spec.Ingress.Collector.Port = 9411
+	jaeger.Spec.Ingress.Collector.Hosts = []string{"collector.example.com"}
+
+	dep := NewCollectorIngress(jaeger).Get()
+
+	assert.Nil(t, dep.Spec.Backend)
+	assert.Len(t, dep.Spec.Rules, 1)
+	assert.Equal(t, "collector.example.com", dep.Spec.Rules[0].Host)
+	assert.Empty(t, dep.Spec.Rules[0].HTTP.Paths[0].Path)
+	assert.Equal(t, intstr.FromInt(9411), dep.Spec.Rules[0].HTTP.Paths[0].Backend.ServicePort)
+}
+
+func TestCollectorIngressValidate(t *testing.T) {
+	enabled := true
+	basePath := v1.NewOptions(map[string]interface{}{"query.base-path": "/jaeger"})
+
+	for _, tt := range []struct {
+		name      string
+		collector v1.JaegerIngressCollectorSpec
+		hosts     []string
+		options   v1.Options
+		valid     bool
+	}{
+		{name: "defaults", valid: true},
+		// the collector's path would shadow the query's API
+		{name: "shared hosts without base path", collector: v1.JaegerIngressCollectorSpec{Enabled: &enabled}, valid: false},
+		{name: "shared hosts with base path", collector: v1.JaegerIngressCollectorSpec{Enabled: &enabled}, options: basePath, valid: true},
+		{name: "query hosts", collector: v1.JaegerIngressCollectorSpec{Enabled: &enabled}, hosts: []string{"query.example.com"}, valid: true},
+		{name: "shared hosts for another port", collector: v1.JaegerIngressCollectorSpec{Enabled: &enabled, Port: 9411}, options: basePath, valid: false},
+		{name: "own hosts for another port", collector: v1.JaegerIngressCollectorSpec{
+			Enabled: &enabled,
+			Port:    9411,
+			Hosts:   []string{"collector.example.com"},
+		}, valid: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorIngressValidate"})
+			jaeger.Spec.Ingress.Collector = tt.collector
+			jaeger.Spec.Ingress.Hosts = tt.hosts
+			jaeger.Spec.AllInOne.Options = tt.options
+
+			err := NewCollectorIngress(jaeger).Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -28,8 +28,6 @@ func (i *QueryIngress) Get() *netv1beta1.Ingress {
 		return nil
 	}
 
-	trueVar := true
-
 	baseCommonSpec := v1.JaegerCommonSpec{
 		Labels: util.Labels(fmt.Sprintf("%s-query", i.jaeger.Name), "query-ingress", *i.jaeger),
 	}
@@ -52,19 +50,11 @@ func (i *QueryIngress) Get() *netv1beta1.Ingress {
 			APIVersion: "networking.k8s.io/v1beta1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-query", i.jaeger.Name),
-			Namespace: i.jaeger.Namespace,
-			Labels:    commonSpec.Labels,
-			OwnerReferences: []metav1.OwnerReference{
-				metav1.OwnerReference{
-					APIVersion: i.jaeger.APIVersion,
-					Kind:       i.jaeger.Kind,
-					Name:       i.jaeger.Name,
-					UID:        i.jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
-			Annotations: commonSpec.Annotations,
+			Name:            fmt.Sprintf("%s-query", i.jaeger.Name),
+			Namespace:       i.jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(i.jaeger)},
+			Annotations:     commonSpec.Annotations,
 		},
 		Spec: spec,
 	}
@@ -75,7 +65,7 @@ func (i *QueryIngress) addRulesSpec(spec *netv1beta1.IngressSpec, backend *netv1
 
 	if allInOneQueryBasePath, ok := i.jaeger.Spec.AllInOne.Options.Map()["query.base-path"]; ok && i.jaeger.Spec.Strategy == v1.DeploymentStrategyAllInOne {
 		path = allInOneQueryBasePath
-	} else if queryBasePath, ok := i.jaeger.Spec.Query.Options.Map()["query.base-path"]; ok && i.jaeger.Spec.Strategy == v1.DeploymentStrategyProduction {
+	} else if queryBasePath, ok := i.jaeger.Spec.Query.Options.Map()["query.base-path"]; ok && (i.jaeger.Spec.Strategy == v1.DeploymentStrategyProduction || i.jaeger.Spec.Strategy == v1.DeploymentStrategyStreaming) {
 		path = queryBasePath
 	}
 
@@ -86,6 +86,22 @@ func TestQueryIngressQueryBasePath(t *testing.T) {
 	assert.NotNil(t, dep.Spec.Rules[0].HTTP.Paths[0].Backend)
 }
 
+func TestQueryIngressQueryBasePathForStreaming(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryIngressQueryBasePathForStreaming"})
+	jaeger.Spec.Ingress.Enabled = &enabled
+	jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+	jaeger.Spec.Query.Options = v1.NewOptions(map[string]interface{}{"query.base-path": "/jaeger"})
+
+	dep := NewQueryIngress(jaeger).Get()
+
+	assert.NotNil(t, dep)
+	assert.Nil(t, dep.Spec.Backend)
+	assert.Len(t, dep.Spec.Rules, 1)
+	assert.Len(t, dep.Spec.Rules[0].HTTP.Paths, 1)
+	assert.Equal(t, "/jaeger", dep.Spec.Rules[0].HTTP.Paths[0].Path)
+}
+
 func TestQueryIngressAnnotations(t *testing.T) {
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryIngressAnnotations"})
 	jaeger.Spec.Annotations = map[string]string{
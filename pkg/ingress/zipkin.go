@@ -0,0 +1,89 @@
+package ingress
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	netv1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/service"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+// ZipkinIngress builds an ingress for the Zipkin endpoint of the collector
+type ZipkinIngress struct {
+	jaeger *v1.Jaeger
+}
+
+// NewZipkinIngress builds a new ZipkinIngress struct based on the given spec
+func NewZipkinIngress(jaeger *v1.Jaeger) *ZipkinIngress {
+	return &ZipkinIngress{jaeger: jaeger}
+}
+
+// Validate makes sure that the Zipkin endpoint is exposed on non-empty hosts of its own
+func (i *ZipkinIngress) Validate() error {
+	zipkin := i.jaeger.Spec.Ingress.Zipkin
+	if zipkin.Enabled == nil || !*zipkin.Enabled {
+		return nil
+	}
+
+	if len(zipkin.Hosts) == 0 {
+		return errors.New("ingress.zipkin.hosts must be set when exposing the Zipkin endpoint")
+	}
+	for _, host := range zipkin.Hosts {
+		if strings.TrimSpace(host) == "" {
+			return errors.New("ingress.zipkin.hosts must not contain empty hosts")
+		}
+	}
+	return nil
+}
+
+// Get returns an ingress specification for the current instance, or nil when the Zipkin endpoint isn't meant to be exposed
+func (i *ZipkinIngress) Get() *netv1beta1.Ingress {
+	if i.jaeger.Spec.Ingress.Enabled != nil && *i.jaeger.Spec.Ingress.Enabled == false {
+		return nil
+	}
+
+	if i.jaeger.Spec.Ingress.Zipkin.Enabled == nil || *i.jaeger.Spec.Ingress.Zipkin.Enabled == false {
+		return nil
+	}
+
+	baseCommonSpec := v1.JaegerCommonSpec{
+		Labels: util.Labels(fmt.Sprintf("%s-zipkin", i.jaeger.Name), "zipkin-ingress", *i.jaeger),
+	}
+
+	commonSpec := util.Merge([]v1.JaegerCommonSpec{i.jaeger.Spec.Ingress.JaegerCommonSpec, i.jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
+
+	spec := netv1beta1.IngressSpec{}
+	backend := netv1beta1.IngressBackend{
+		ServiceName: service.GetNameForCollectorService(i.jaeger),
+		ServicePort: intstr.FromInt(service.ZipkinPort),
+	}
+	spec.Rules = getRules("", i.jaeger.Spec.Ingress.Zipkin.Hosts, &backend)
+
+	for _, tls := range i.jaeger.Spec.Ingress.Zipkin.TLS {
+		spec.TLS = append(spec.TLS, netv1beta1.IngressTLS{
+			Hosts:      tls.Hosts,
+			SecretName: tls.SecretName,
+		})
+	}
+
+	return &netv1beta1.Ingress{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Ingress",
+			APIVersion: "networking.k8s.io/v1beta1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-zipkin", i.jaeger.Name),
+			Namespace:       i.jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(i.jaeger)},
+			Annotations:     commonSpec.Annotations,
+		},
+		Spec: spec,
+	}
+}
@@ -0,0 +1,79 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	netv1beta1 "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestZipkinIngressDisabledByDefault(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestZipkinIngressDisabledByDefault"})
+
+	assert.Nil(t, NewZipkinIngress(jaeger).Get())
+}
+
+func TestZipkinIngressDisabledIngress(t *testing.T) {
+	enabled := true
+	disabled := false
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestZipkinIngressDisabledIngress"})
+	jaeger.Spec.Ingress.Enabled = &disabled
+	jaeger.Spec.Ingress.Zipkin.Enabled = &enabled
+	jaeger.Spec.Ingress.Zipkin.Hosts = []string{"zipkin.example.com"}
+
+	assert.Nil(t, NewZipkinIngress(jaeger).Get())
+}
+
+func TestZipkinIngressEnabled(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestZipkinIngressEnabled"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyProduction
+	jaeger.Spec.Ingress.Hosts = []string{"query.example.com"}
+	jaeger.Spec.Ingress.Zipkin.Enabled = &enabled
+	jaeger.Spec.Ingress.Zipkin.Hosts = []string{"zipkin.example.com"}
+	jaeger.Spec.Ingress.Zipkin.TLS = []v1.JaegerIngressTLSSpec{{
+		Hosts:      []string{"zipkin.example.com"},
+		SecretName: "zipkin-tls",
+	}}
+
+	ingress := NewZipkinIngress(jaeger).Get()
+
+	assert.Equal(t, "TestZipkinIngressEnabled-zipkin", ingress.Name)
+	assert.Nil(t, ingress.Spec.Backend)
+	assert.Len(t, ingress.Spec.Rules, 1)
+	assert.Equal(t, "zipkin.example.com", ingress.Spec.Rules[0].Host)
+	assert.Equal(t, "testzipkiningressenabled-collector", ingress.Spec.Rules[0].HTTP.Paths[0].Backend.ServiceName)
+	assert.Equal(t, intstr.FromInt(9411), ingress.Spec.Rules[0].HTTP.Paths[0].Backend.ServicePort)
+	assert.Equal(t, []netv1beta1.IngressTLS{{Hosts: []string{"zipkin.example.com"}, SecretName: "zipkin-tls"}}, ingress.Spec.TLS)
+}
+
+func TestZipkinIngressValidate(t *testing.T) {
+	enabled := true
+	for _, tt := range []struct {
+		name  string
+		spec  v1.JaegerIngressZipkinSpec
+		valid bool
+	}{
+		{name: "defaults", valid: true},
+		{name: "disabled with empty host", spec: v1.JaegerIngressZipkinSpec{Hosts: []string{""}}, valid: true},
+		{name: "hosts", spec: v1.JaegerIngressZipkinSpec{Enabled: &enabled, Hosts: []string{"zipkin.example.com"}}, valid: true},
+		{name: "no hosts", spec: v1.JaegerIngressZipkinSpec{Enabled: &enabled}, valid: false},
+		{name: "empty host", spec: v1.JaegerIngressZipkinSpec{Enabled: &enabled, Hosts: []string{"zipkin.example.com", " "}}, valid: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestZipkinIngressValidate"})
+			jaeger.Spec.Ingress.Zipkin = tt.spec
+
+			err := NewZipkinIngress(jaeger).Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -95,7 +95,7 @@ func getOAuthProxyContainer(jaeger *v1.Jaeger) corev1.Container {
 	sort.Strings(args)
 
 	return corev1.Container{
-		Image:        viper.GetString("openshift-oauth-proxy-image"),
+		Image:        util.DefaultImage("openshift-oauth-proxy-image"),
 		Name:         "oauth-proxy",
 		Args:         args,
 		VolumeMounts: volumeMounts,
@@ -105,7 +105,7 @@ func getOAuthProxyContainer(jaeger *v1.Jaeger) corev1.Container {
 				Name:          "public",
 			},
 		},
-		Resources: commonSpec.Resources,
+		Resources: util.SidecarResources(util.SidecarOAuthProxy, jaeger.Spec.Ingress.JaegerCommonSpec, jaeger.Spec.JaegerCommonSpec),
 	}
 }
 
@@ -213,6 +213,32 @@ func TestOAuthProxyResourceLimits(t *testing.T) {
 	assert.Equal(t, *resource.NewQuantity(512, resource.DecimalSI), dep.Spec.Template.Spec.Containers[1].Resources.Requests[corev1.ResourceRequestsEphemeralStorage])
 }
 
+func TestOAuthProxySidecarResources(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.SidecarResources = &v1.JaegerSidecarResourcesSpec{
+		Default: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+		},
+		OAuthProxy: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+		},
+	}
+	jaeger.Spec.Ingress.Security = v1.IngressSecurityOAuthProxy
+
+	dep := OAuthProxy(jaeger, deployment.NewQuery(jaeger).Get())
+
+	assert.Equal(t, resource.MustParse("64Mi"), dep.Spec.Template.Spec.Containers[1].Resources.Limits[corev1.ResourceMemory])
+}
+
+func TestOAuthProxyDefaultResources(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Ingress.Security = v1.IngressSecurityOAuthProxy
+
+	dep := OAuthProxy(jaeger, deployment.NewQuery(jaeger).Get())
+
+	assert.NotEmpty(t, dep.Spec.Template.Spec.Containers[1].Resources.Requests)
+}
+
 func findCookieSecret(containers []corev1.Container) (string, bool) {
 	for _, container := range containers {
 		if container.Name == "oauth-proxy" {
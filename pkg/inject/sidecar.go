@@ -27,6 +27,12 @@ var (
 	Label = "sidecar.jaegertracing.io/injected"
 	// AnnotationLegacy holds the annotation name we had in the past, which we keep for backwards compatibility
 	AnnotationLegacy = "inject-jaeger-agent"
+	// AnnotationInstance is the annotation name used to force a specific Jaeger instance, as "<name>" or "<namespace>/<name>"
+	AnnotationInstance = "sidecar.jaegertracing.io/instance"
+	// AnnotationExcludeContainers is the annotation name holding a comma-separated list of container names that don't
+	// emit traces. It's evaluated only once the workload or its namespace enabled the injection: when all the
+	// containers of a workload without an agent are excluded, the injection is skipped, even for a forced instance.
+	AnnotationExcludeContainers = "sidecar.jaegertracing.io/exclude-containers"
 	// PrometheusDefaultAnnotations is a map containing annotations for prometheus to be inserted at sidecar in case it doesn't have any
 	PrometheusDefaultAnnotations = map[string]string{
 		"prometheus.io/scrape": "true",
@@ -79,6 +85,9 @@ func Sidecar(jaeger *v1.Jaeger, dep *appsv1.Deployment) *appsv1.Deployment {
 // Needed determines whether a pod needs to get a sidecar injected or not
 func Needed(dep *appsv1.Deployment, ns *corev1.Namespace) bool {
 	_, depExist := dep.Annotations[Annotation]
+	if _, forced := dep.Annotations[AnnotationInstance]; forced {
+		depExist = true
+	}
 	_, nsExist := ns.Annotations[Annotation]
 	if !depExist && !nsExist {
 		log.WithFields(log.Fields{
@@ -101,24 +110,64 @@ func Needed(dep *appsv1.Deployment, ns *corev1.Namespace) bool {
 		_, hasLabel := dep.Labels[Label]
 		return hasLabel
 	}
+
+	if !hasCandidateContainers(dep) {
+		log.WithFields(log.Fields{
+			"namespace":  dep.Namespace,
+			"deployment": dep.Name,
+		}).Debug("all containers are excluded, not injecting")
+		return false
+	}
+
 	// If no agent but has annotations
 	return true
 }
 
-// Select a suitable Jaeger from the JaegerList for the given Pod, or nil of none is suitable
-func Select(target *appsv1.Deployment, ns *corev1.Namespace, availableJaegerPods *v1.JaegerList) *v1.Jaeger {
+// hasCandidateContainers determines whether the deployment has containers that aren't excluded from the injection
+func hasCandidateContainers(dep *appsv1.Deployment) bool {
+	excluded := map[string]bool{}
+	for _, name := range strings.Split(dep.Annotations[AnnotationExcludeContainers], ",") {
+		if name = strings.TrimSpace(name); len(name) > 0 {
+			excluded[name] = true
+		}
+	}
+	for _, c := range dep.Spec.Template.Spec.Containers {
+		if !excluded[c.Name] {
+			return true
+		}
+	}
+	return false
+}
+
+// Select a suitable Jaeger from the JaegerList for the given Pod, or nil of none is suitable.
+// An error is returned when the deployment forces an instance that cannot be found.
+func Select(target *appsv1.Deployment, ns *corev1.Namespace, availableJaegerPods *v1.JaegerList) (*v1.Jaeger, error) {
+	if instance, ok := target.Annotations[AnnotationInstance]; ok {
+		// a forced instance has precedence over everything else
+		namespace, name := target.Namespace, instance
+		if parts := strings.SplitN(instance, "/", 2); len(parts) == 2 {
+			namespace, name = parts[0], parts[1]
+		}
+		for i := range availableJaegerPods.Items {
+			if jaeger := &availableJaegerPods.Items[i]; jaeger.Name == name && jaeger.Namespace == namespace {
+				return jaeger, nil
+			}
+		}
+		return nil, fmt.Errorf("the Jaeger instance '%s' set via the annotation '%s' could not be found in the namespace '%s'", name, AnnotationInstance, namespace)
+	}
+
 	jaegerNameDep := target.Annotations[Annotation]
 	jaegerNameNs := ns.Annotations[Annotation]
 
 	if jaegerNameDep != "" && !strings.EqualFold(jaegerNameDep, "true") {
 		// name on the deployment has precedence
-		if jaeger := getJaeger(jaegerNameDep, availableJaegerPods); jaeger != nil {
-			return jaeger
+		if jaeger := getJaeger(jaegerNameDep, target.Namespace, availableJaegerPods); jaeger != nil {
+			return jaeger, nil
 		}
-		return nil
+		return nil, nil
 	}
-	if jaeger := getJaeger(jaegerNameNs, availableJaegerPods); jaeger != nil {
-		return jaeger
+	if jaeger := getJaeger(jaegerNameNs, target.Namespace, availableJaegerPods); jaeger != nil {
+		return jaeger, nil
 	}
 
 	if strings.EqualFold(jaegerNameDep, "true") || strings.EqualFold(jaegerNameNs, "true") {
@@ -126,7 +175,7 @@ func Select(target *appsv1.Deployment, ns *corev1.Namespace, availableJaegerPods
 		// then that's what we'll use
 		if len(availableJaegerPods.Items) == 1 {
 			jaeger := &availableJaegerPods.Items[0]
-			return jaeger
+			return jaeger, nil
 		}
 		// If there is more than one available instance in all watched namespaces
 		// then we should find if there is only *one* on the same namespace
@@ -134,12 +183,12 @@ func Select(target *appsv1.Deployment, ns *corev1.Namespace, availableJaegerPods
 		instancesInNamespace := getJaegerFromNamespace(target.Namespace, availableJaegerPods)
 		if len(instancesInNamespace) == 1 {
 			jaeger := instancesInNamespace[0]
-			return jaeger
+			return jaeger, nil
 		}
 		// At this point, we have more than one instance that could be used to inject
 		// we should just not inject, as it's not clear which one should be used.
 	}
-	return nil
+	return nil, nil
 }
 
 func getJaegerFromNamespace(namespace string, jaegers *v1.JaegerList) []*v1.Jaeger {
@@ -153,14 +202,24 @@ func getJaegerFromNamespace(namespace string, jaegers *v1.JaegerList) []*v1.Jaeg
 	return instances
 }
 
-func getJaeger(name string, jaegers *v1.JaegerList) *v1.Jaeger {
-	for _, p := range jaegers.Items {
-		if p.Name == name {
+func getJaeger(name, namespace string, jaegers *v1.JaegerList) *v1.Jaeger {
+	var found *v1.Jaeger
+	for i := range jaegers.Items {
+		p := &jaegers.Items[i]
+		if p.Name != name {
+			continue
+		}
+		// instances with the same name might exist in different namespaces,
+		// in which case the one in the deployment's namespace is the one we want
+		if p.Namespace == namespace {
+			return p
+		}
+		if found == nil {
 			// matched the name!
-			return &p
+			found = p
 		}
 	}
-	return nil
+	return found
 }
 
 func container(jaeger *v1.Jaeger, dep *appsv1.Deployment, agentIdx int) corev1.Container {
@@ -179,6 +238,8 @@ func container(jaeger *v1.Jaeger, dep *appsv1.Deployment, agentIdx int) corev1.C
 		}
 	}
 
+	args = append(args, deployment.AgentProcessorArgs(jaeger.Spec.Agent, args)...)
+
 	zkCompactTrft := util.GetPort("--processor.zipkin-compact.server-host-port=", args, 5775)
 	configRest := util.GetPort("--http-server.host-port=", args, 5778)
 	jgCompactTrft := util.GetPort("--processor.jaeger-compact.server-host-port=", args, 6831)
@@ -200,6 +261,10 @@ func container(jaeger *v1.Jaeger, dep *appsv1.Deployment, agentIdx int) corev1.C
 			defaultAgentTagsMap["container.name"] = defaultContainerName
 		}
 
+		for key, value := range jaeger.Spec.Agent.AgentTags {
+			defaultAgentTagsMap[key] = value
+		}
+
 		if agentIdx > -1 {
 			existingAgentTags := parseAgentTags(dep.Spec.Template.Spec.Containers[agentIdx].Args)
 			// merge two maps
@@ -213,8 +278,6 @@ func container(jaeger *v1.Jaeger, dep *appsv1.Deployment, agentIdx int) corev1.C
 
 	}
 
-	commonSpec := util.Merge([]v1.JaegerCommonSpec{jaeger.Spec.Agent.JaegerCommonSpec, jaeger.Spec.JaegerCommonSpec})
-
 	// Use only the agent common spec for volumes and mounts.
 	// We don't want to mount all Jaeger internal volumes into user's deployments
 	volumesAndMountsSpec := jaeger.Spec.Agent.JaegerCommonSpec
@@ -283,7 +346,7 @@ func container(jaeger *v1.Jaeger, dep *appsv1.Deployment, agentIdx int) corev1.C
 				Name:          "admin-http",
 			},
 		},
-		Resources:       commonSpec.Resources,
+		Resources:       util.SidecarResources(util.SidecarAgent, jaeger.Spec.Agent.JaegerCommonSpec, jaeger.Spec.JaegerCommonSpec),
 		SecurityContext: jaeger.Spec.Agent.SidecarSecurityContext,
 		VolumeMounts:    volumesAndMountsSpec.VolumeMounts,
 	}
@@ -393,23 +456,11 @@ func parseAgentTags(args []string) map[string]string {
 	if tagsArg == "" {
 		return map[string]string{}
 	}
-	tagsParam := strings.SplitN(tagsArg, "=", 2)[1]
-	tagsMap := make(map[string]string)
-	tagsArr := strings.Split(tagsParam, ",")
-	for _, tagsPairStr := range tagsArr {
-		tagsPair := strings.SplitN(tagsPairStr, "=", 2)
-		tagsMap[tagsPair[0]] = tagsPair[1]
-	}
-	return tagsMap
+	return util.ParseTags(strings.SplitN(tagsArg, "=", 2)[1])
 }
 
 func joinTags(tags map[string]string) string {
-	tagsSlice := make([]string, 0)
-	for key, value := range tags {
-		tagsSlice = append(tagsSlice, fmt.Sprintf("%s=%s", key, value))
-	}
-	sort.Strings(tagsSlice)
-	return strings.Join(tagsSlice, ",")
+	return util.SerializeTags(tags)
 }
 
 func getContainerName(containers []corev1.Container, agentIdx int) string {
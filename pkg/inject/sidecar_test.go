@@ -355,6 +355,57 @@ func TestSidecarNeeded(t *testing.T) {
 	}
 }
 
+func TestSidecarNeededWithExcludedContainers(t *testing.T) {
+	tests := []struct {
+		name     string
+		dep      *appsv1.Deployment
+		ns       *corev1.Namespace
+		expected bool
+	}{
+		{
+			name:     "single container excluded",
+			dep:      dep(map[string]string{Annotation: "true", AnnotationExcludeContainers: "only_container"}, map[string]string{}),
+			ns:       ns(map[string]string{}),
+			expected: false,
+		},
+		{
+			name:     "single container excluded, enabled on the namespace",
+			dep:      dep(map[string]string{AnnotationExcludeContainers: "only_container"}, map[string]string{}),
+			ns:       ns(map[string]string{Annotation: "true"}),
+			expected: false,
+		},
+		{
+			name:     "single container excluded, forced instance",
+			dep:      dep(map[string]string{AnnotationInstance: "my-instance", AnnotationExcludeContainers: "only_container"}, map[string]string{}),
+			ns:       ns(map[string]string{}),
+			expected: false,
+		},
+		{
+			name:     "one of two containers excluded",
+			dep:      depWithTwoContainers(map[string]string{Annotation: "true", AnnotationExcludeContainers: "container_1"}, map[string]string{}),
+			ns:       ns(map[string]string{}),
+			expected: true,
+		},
+		{
+			name:     "all containers excluded",
+			dep:      depWithTwoContainers(map[string]string{Annotation: "true", AnnotationExcludeContainers: "container_0, container_1"}, map[string]string{}),
+			ns:       ns(map[string]string{}),
+			expected: false,
+		},
+		{
+			name:     "exclusion without injection",
+			dep:      dep(map[string]string{AnnotationExcludeContainers: "other"}, map[string]string{}),
+			ns:       ns(map[string]string{}),
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Needed(test.dep, test.ns))
+		})
+	}
+}
+
 func TestSelect(t *testing.T) {
 	jTest := v1.NewJaeger(types.NamespacedName{Name: "test"})
 	jProd := v1.NewJaeger(types.NamespacedName{Name: "prod"})
@@ -467,7 +518,8 @@ func TestSelect(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.cap, func(t *testing.T) {
-			jaeger := Select(test.dep, test.ns, test.jaegers)
+			jaeger, err := Select(test.dep, test.ns, test.jaegers)
+			assert.NoError(t, err)
 			assert.Equal(t, test.expected, jaeger)
 		})
 	}
@@ -491,12 +543,102 @@ func TestSelectBasedOnName(t *testing.T) {
 		},
 	}
 
-	jaeger := Select(dep, &corev1.Namespace{}, jaegerPods)
+	jaeger, err := Select(dep, &corev1.Namespace{}, jaegerPods)
+	assert.NoError(t, err)
 	assert.NotNil(t, jaeger)
 	assert.Equal(t, "the-second-jaeger-instance-available", jaeger.Name)
 	assert.Equal(t, "the-second-jaeger-instance-available", dep.Annotations[Annotation])
 }
 
+func TestSelectPrefersInstanceInSameNamespace(t *testing.T) {
+	dep := dep(map[string]string{Annotation: "prod"}, map[string]string{})
+	dep.Namespace = "nsprod"
+
+	jProdNsTest := v1.NewJaeger(types.NamespacedName{Name: "prod", Namespace: "nstest"})
+	jProdNsProd := v1.NewJaeger(types.NamespacedName{Name: "prod", Namespace: "nsprod"})
+
+	jaeger, err := Select(dep, ns(map[string]string{}), &v1.JaegerList{Items: []v1.Jaeger{*jProdNsTest, *jProdNsProd}})
+	assert.NoError(t, err)
+	assert.Equal(t, jProdNsProd, jaeger)
+}
+
+func TestSelectForcedInstance(t *testing.T) {
+	jProdNsTest := v1.NewJaeger(types.NamespacedName{Name: "prod", Namespace: "nstest"})
+	jProdNsProd := v1.NewJaeger(types.NamespacedName{Name: "prod", Namespace: "nsprod"})
+	jTestNsProd := v1.NewJaeger(types.NamespacedName{Name: "test", Namespace: "nsprod"})
+	jaegers := &v1.JaegerList{Items: []v1.Jaeger{*jProdNsTest, *jProdNsProd, *jTestNsProd}}
+
+	tests := []struct {
+		annotations map[string]string
+		nsAnnotated map[string]string
+		expected    *v1.Jaeger
+		cap         string
+	}{
+		{
+			annotations: map[string]string{AnnotationInstance: "prod"},
+			expected:    jProdNsProd,
+			cap:         "name only, same namespace as the deployment",
+		},
+		{
+			annotations: map[string]string{AnnotationInstance: "nstest/prod"},
+			expected:    jProdNsTest,
+			cap:         "namespace and name",
+		},
+		{
+			annotations: map[string]string{AnnotationInstance: "prod", Annotation: "test"},
+			expected:    jProdNsProd,
+			cap:         "forced instance has precedence over the inject annotation",
+		},
+		{
+			annotations: map[string]string{AnnotationInstance: "prod"},
+			nsAnnotated: map[string]string{Annotation: "test"},
+			expected:    jProdNsProd,
+			cap:         "forced instance has precedence over the namespace annotation",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.cap, func(t *testing.T) {
+			d := dep(test.annotations, map[string]string{})
+			d.Namespace = "nsprod"
+			jaeger, err := Select(d, ns(test.nsAnnotated), jaegers)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, jaeger)
+		})
+	}
+}
+
+func TestSelectForcedInstanceNotFound(t *testing.T) {
+	jProd := v1.NewJaeger(types.NamespacedName{Name: "prod", Namespace: "nsprod"})
+	for _, instance := range []string{"doesNotExist", "nstest/prod"} {
+		d := dep(map[string]string{AnnotationInstance: instance}, map[string]string{})
+		d.Namespace = "nsprod"
+
+		jaeger, err := Select(d, ns(map[string]string{}), &v1.JaegerList{Items: []v1.Jaeger{*jProd}})
+		assert.Nil(t, jaeger)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), AnnotationInstance)
+	}
+}
+
+func TestNeededForcedInstance(t *testing.T) {
+	d := dep(map[string]string{AnnotationInstance: "prod"}, map[string]string{})
+	assert.True(t, Needed(d, ns(map[string]string{})))
+}
+
+func TestSidecarForcedInstanceReporterHostPort(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "prod", Namespace: "observability"})
+	d := dep(map[string]string{AnnotationInstance: "observability/prod"}, map[string]string{})
+	d.Namespace = "app"
+
+	selected, err := Select(d, ns(map[string]string{}), &v1.JaegerList{Items: []v1.Jaeger{*jaeger}})
+	require.NoError(t, err)
+
+	d = Sidecar(selected, d)
+	require.Len(t, d.Spec.Template.Spec.Containers, 2)
+	assert.Contains(t, d.Spec.Template.Spec.Containers[1].Args, "--reporter.grpc.host-port=dns:///prod-collector-headless.observability.svc:14250")
+}
+
 func TestSidecarOrderOfArguments(t *testing.T) {
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
 	jaeger.Spec.Agent.Options = v1.NewOptions(map[string]interface{}{
@@ -534,6 +676,25 @@ func TestSidecarExplicitTags(t *testing.T) {
 	assert.Equal(t, agentTags, map[string]string{"key": "val"})
 }
 
+func TestSidecarAgentTags(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Agent.AgentTags = map[string]string{"team": "tracing", "zone": "a", "cluster": "prod"}
+	dep := dep(map[string]string{}, map[string]string{})
+
+	// test
+	dep = Sidecar(jaeger, dep)
+
+	// verify
+	assert.Len(t, dep.Spec.Template.Spec.Containers, 2)
+	assert.Contains(t, util.FindItem("--jaeger.tags=", dep.Spec.Template.Spec.Containers[1].Args), "zone=a")
+	agentTags := parseAgentTags(dep.Spec.Template.Spec.Containers[1].Args)
+	assert.Equal(t, "tracing", agentTags["team"])
+	assert.Equal(t, "a", agentTags["zone"])
+	assert.Equal(t, "prod", agentTags["cluster"])
+	assert.Equal(t, "only_container", agentTags["container.name"])
+}
+
 func TestSidecarCustomReporterPort(t *testing.T) {
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
 	jaeger.Spec.Agent.Options = v1.NewOptions(map[string]interface{}{
@@ -548,6 +709,28 @@ func TestSidecarCustomReporterPort(t *testing.T) {
 	assert.Contains(t, dep.Spec.Template.Spec.Containers[1].Args, "--reporter.grpc.host-port=collector:5000")
 }
 
+func TestSidecarProcessorTuning(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	queueSize := int32(5000)
+	workers := int32(20)
+	jaeger.Spec.Agent.ProcessorQueueSize = &queueSize
+	jaeger.Spec.Agent.ProcessorWorkers = &workers
+	jaeger.Spec.Agent.Options = v1.NewOptions(map[string]interface{}{
+		"processor.jaeger-compact.workers": "50",
+	})
+
+	dep := dep(map[string]string{}, map[string]string{})
+	dep = Sidecar(jaeger, dep)
+
+	assert.Len(t, dep.Spec.Template.Spec.Containers, 2)
+	args := dep.Spec.Template.Spec.Containers[1].Args
+	assert.Contains(t, args, "--processor.jaeger-compact.server-queue-size=5000")
+	assert.Contains(t, args, "--processor.jaeger-compact.workers=50")
+	assert.NotContains(t, args, "--processor.jaeger-compact.workers=20")
+	assert.Contains(t, args, "--processor.jaeger-binary.workers=20")
+	assert.Contains(t, args, "--processor.zipkin-compact.server-queue-size=5000")
+}
+
 func TestSidecarAgentResources(t *testing.T) {
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
 	jaeger.Spec.Resources = corev1.ResourceRequirements{
@@ -584,6 +767,45 @@ func TestSidecarAgentResources(t *testing.T) {
 	assert.Equal(t, *resource.NewQuantity(512, resource.DecimalSI), dep.Spec.Template.Spec.Containers[1].Resources.Requests[corev1.ResourceRequestsEphemeralStorage])
 }
 
+func TestSidecarAgentSidecarResources(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.SidecarResources = &v1.JaegerSidecarResourcesSpec{
+		Default: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+		},
+		Agent: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+		},
+	}
+
+	dep := Sidecar(jaeger, dep(map[string]string{}, map[string]string{}))
+
+	assert.Equal(t, "jaeger-agent", dep.Spec.Template.Spec.Containers[1].Name)
+	assert.Equal(t, corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("50m"),
+		corev1.ResourceMemory: resource.MustParse("64Mi"),
+	}, dep.Spec.Template.Spec.Containers[1].Resources.Requests)
+}
+
+func TestSidecarAgentResourcesIndependentOfDaemonSet(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Agent.Resources = corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("512Mi")},
+	}
+	jaeger.Spec.SidecarResources = &v1.JaegerSidecarResourcesSpec{
+		Agent: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("5m"), corev1.ResourceMemory: resource.MustParse("16Mi")},
+		},
+	}
+
+	dep := Sidecar(jaeger, dep(map[string]string{}, map[string]string{}))
+
+	assert.Equal(t, corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("5m"),
+		corev1.ResourceMemory: resource.MustParse("16Mi"),
+	}, dep.Spec.Template.Spec.Containers[1].Resources.Requests)
+}
+
 func TestCleanSidecars(t *testing.T) {
 	instanceName := "my-instance"
 	nsn := types.NamespacedName{
@@ -0,0 +1,68 @@
+package inventory
+
+import (
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+// NetworkPolicy represents the NetworkPolicy inventory based on the current and desired states
+type NetworkPolicy struct {
+	Create []networkingv1.NetworkPolicy
+	Update []networkingv1.NetworkPolicy
+	Delete []networkingv1.NetworkPolicy
+}
+
+// ForNetworkPolicies builds a new NetworkPolicy inventory based on the existing and desired states
+func ForNetworkPolicies(existing []networkingv1.NetworkPolicy, desired []networkingv1.NetworkPolicy) NetworkPolicy {
+	update := []networkingv1.NetworkPolicy{}
+	mcreate := networkPolicyMap(desired)
+	mdelete := networkPolicyMap(existing)
+
+	for k, v := range mcreate {
+		if t, ok := mdelete[k]; ok {
+			tp := t.DeepCopy()
+			util.InitObjectMeta(tp)
+
+			// we can't blindly DeepCopyInto, so, we select what we bring from the new to the old object
+			tp.Spec = v.Spec
+			tp.ObjectMeta.OwnerReferences = v.ObjectMeta.OwnerReferences
+
+			for k, v := range v.ObjectMeta.Annotations {
+				tp.ObjectMeta.Annotations[k] = v
+			}
+
+			for k, v := range v.ObjectMeta.Labels {
+				tp.ObjectMeta.Labels[k] = v
+			}
+
+			update = append(update, *tp)
+			delete(mcreate, k)
+			delete(mdelete, k)
+		}
+	}
+
+	return NetworkPolicy{
+		Create: networkPolicyList(mcreate),
+		Update: update,
+		Delete: networkPolicyList(mdelete),
+	}
+}
+
+func networkPolicyMap(policies []networkingv1.NetworkPolicy) map[string]networkingv1.NetworkPolicy {
+	m := map[string]networkingv1.NetworkPolicy{}
+	for _, d := range policies {
+		m[fmt.Sprintf("%s.%s", d.Namespace, d.Name)] = d
+	}
+	return m
+}
+
+func networkPolicyList(m map[string]networkingv1.NetworkPolicy) []networkingv1.NetworkPolicy {
+	l := []networkingv1.NetworkPolicy{}
+	for _, v := range m {
+		l = append(l, v)
+	}
+	return l
+}
@@ -0,0 +1,57 @@
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNetworkPolicyInventory(t *testing.T) {
+	toCreate := networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "to-create",
+			Namespace: "tenant1",
+		},
+	}
+	toUpdate := networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "to-update",
+			Namespace: "tenant1",
+		},
+	}
+	updated := networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "to-update",
+			Namespace:   "tenant1",
+			Annotations: map[string]string{"gopher": "jaeger"},
+			Labels:      map[string]string{"gopher": "jaeger"},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+	toDelete := networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "to-delete",
+			Namespace: "tenant1",
+		},
+	}
+
+	existing := []networkingv1.NetworkPolicy{toUpdate, toDelete}
+	desired := []networkingv1.NetworkPolicy{updated, toCreate}
+
+	inv := ForNetworkPolicies(existing, desired)
+	assert.Len(t, inv.Create, 1)
+	assert.Equal(t, "to-create", inv.Create[0].Name)
+
+	assert.Len(t, inv.Update, 1)
+	assert.Equal(t, "to-update", inv.Update[0].Name)
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, inv.Update[0].Spec.PolicyTypes)
+	assert.Equal(t, "jaeger", inv.Update[0].Annotations["gopher"])
+	assert.Equal(t, "jaeger", inv.Update[0].Labels["gopher"])
+
+	assert.Len(t, inv.Delete, 1)
+	assert.Equal(t, "to-delete", inv.Delete[0].Name)
+}
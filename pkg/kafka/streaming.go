@@ -28,21 +28,12 @@ func Persistent(jaeger *v1.Jaeger) v1beta1.Kafka {
 		storage = 100
 	}
 
-	trueVar := true
 	return v1beta1.Kafka{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      jaeger.Name,
-			Namespace: jaeger.Namespace,
-			Labels:    util.Labels(jaeger.Name, "kafka", *jaeger),
-			OwnerReferences: []metav1.OwnerReference{
-				metav1.OwnerReference{
-					APIVersion: jaeger.APIVersion,
-					Kind:       jaeger.Kind,
-					Name:       jaeger.Name,
-					UID:        jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
+			Name:            jaeger.Name,
+			Namespace:       jaeger.Namespace,
+			Labels:          util.Labels(jaeger.Name, "kafka", *jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 		Spec: v1beta1.KafkaSpec{
 			v1.NewFreeForm(map[string]interface{}{
@@ -88,8 +79,6 @@ func Persistent(jaeger *v1.Jaeger) v1beta1.Kafka {
 // User returns a custom resource for a Kafka user. The Kafka Operator will then create a secret with the
 // credentials for this user
 func User(jaeger *v1.Jaeger) v1beta1.KafkaUser {
-	trueVar := true
-
 	labels := util.Labels(jaeger.Name, "kafkauser", *jaeger)
 
 	// based on this label, the Strimzi operator will create the TLS secrets for
@@ -98,18 +87,10 @@ func User(jaeger *v1.Jaeger) v1beta1.KafkaUser {
 
 	return v1beta1.KafkaUser{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      jaeger.Name,
-			Namespace: jaeger.Namespace,
-			Labels:    labels,
-			OwnerReferences: []metav1.OwnerReference{
-				metav1.OwnerReference{
-					APIVersion: jaeger.APIVersion,
-					Kind:       jaeger.Kind,
-					Name:       jaeger.Name,
-					UID:        jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
+			Name:            jaeger.Name,
+			Namespace:       jaeger.Namespace,
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 		Spec: v1beta1.KafkaUserSpec{
 			v1.NewFreeForm(map[string]interface{}{
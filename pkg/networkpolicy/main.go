@@ -0,0 +1,329 @@
+package networkpolicy
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	esv1 "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+const (
+	elasticsearchPort          = 9200
+	elasticsearchTransportPort = 9300
+	cassandraPort              = 9042
+	kafkaPort                  = 9092
+	dnsPort                    = 53
+)
+
+// storageAddressFlags are the flags pointing the components to their backends, with the parser returning the ports
+// of the given flag value
+var storageAddressFlags = map[string]func(string) []int32{
+	"--es.server-urls=":          urlPorts,
+	"--es-archive.server-urls=":  urlPorts,
+	"--cassandra.port=":          singlePort,
+	"--cassandra-archive.port=":  singlePort,
+	"--kafka.producer.brokers=":  hostPorts,
+	"--kafka.consumer.brokers=":  hostPorts,
+	"--reporter.grpc.host-port=": hostPorts,
+}
+
+// apiServerPorts are the ports the OAuth Proxy uses to reach the Kubernetes API and the OpenShift OAuth server
+var apiServerPorts = []int32{443, 6443}
+
+// queryPortNames are the names of the container ports serving the query UI and API, which are restricted
+// to the pods in the same namespace and to the configured CIDRs. All the other ports are open to any source,
+// as they receive spans from applications that might be running in other namespaces or outside of the cluster.
+var queryPortNames = map[string]bool{
+	"query":      true,
+	"grpc-query": true,
+	"public":     true, // the OAuth Proxy
+}
+
+// Validate makes sure that the network policy settings can be turned into valid network policies
+func Validate(jaeger *v1.Jaeger) error {
+	for _, cidr := range jaeger.Spec.NetworkPolicy.QueryIngressCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errors.Wrap(err, "networkPolicy.queryIngressCIDRs contains an invalid CIDR")
+		}
+	}
+	return nil
+}
+
+// Get returns the network policies allowing the traffic to the given workloads and elasticsearch clusters
+func Get(jaeger *v1.Jaeger, deployments []appsv1.Deployment, daemonSets []appsv1.DaemonSet, elasticsearches []esv1.Elasticsearch) []networkingv1.NetworkPolicy {
+	if jaeger.Spec.NetworkPolicy.Enabled == nil || !*jaeger.Spec.NetworkPolicy.Enabled {
+		return []networkingv1.NetworkPolicy{}
+	}
+
+	policies := []networkingv1.NetworkPolicy{}
+	for _, d := range deployments {
+		if p := forWorkload(jaeger, d.Name, d.Spec.Selector, d.Spec.Template.Spec); p != nil {
+			policies = append(policies, *p)
+		}
+	}
+
+	for _, d := range daemonSets {
+		if p := forWorkload(jaeger, d.Name, d.Spec.Selector, d.Spec.Template.Spec); p != nil {
+			policies = append(policies, *p)
+		}
+	}
+
+	for _, es := range elasticsearches {
+		policies = append(policies, forElasticsearch(jaeger, es))
+	}
+
+	return policies
+}
+
+func forWorkload(jaeger *v1.Jaeger, name string, selector *metav1.LabelSelector, spec corev1.PodSpec) *networkingv1.NetworkPolicy {
+	if selector == nil {
+		return nil
+	}
+
+	var open, restricted []networkingv1.NetworkPolicyPort
+	for _, c := range spec.Containers {
+		for _, p := range c.Ports {
+			port := policyPort(p.Protocol, p.ContainerPort)
+			if queryPortNames[p.Name] {
+				restricted = append(restricted, port)
+			} else {
+				open = append(open, port)
+			}
+		}
+	}
+
+	var rules []networkingv1.NetworkPolicyIngressRule
+	if len(open) > 0 {
+		rules = append(rules, networkingv1.NetworkPolicyIngressRule{Ports: open})
+	}
+	if len(restricted) > 0 {
+		rules = append(rules, networkingv1.NetworkPolicyIngressRule{Ports: restricted, From: queryPeers(jaeger)})
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	return &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NetworkPolicy",
+			APIVersion: "networking.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       jaeger.Namespace,
+			Labels:          util.Labels(name, "network-policy", *jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: *selector.DeepCopy(),
+			Ingress:     rules,
+			Egress:      egressRules(jaeger, spec),
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		},
+	}
+}
+
+// queryPeers returns the sources allowed to reach the query UI: the pods in the same namespace, the configured
+// CIDRs and namespaces, and on OpenShift, the router
+func queryPeers(jaeger *v1.Jaeger) []networkingv1.NetworkPolicyPeer {
+	from := []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}}
+	for _, cidr := range jaeger.Spec.NetworkPolicy.QueryIngressCIDRs {
+		from = append(from, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+	}
+	if selector := jaeger.Spec.NetworkPolicy.QueryIngressNamespaceSelector; selector != nil {
+		from = append(from, networkingv1.NetworkPolicyPeer{NamespaceSelector: selector.DeepCopy()})
+	}
+	if viper.GetString("platform") == v1.FlagPlatformOpenShift {
+		from = append(from, networkingv1.NetworkPolicyPeer{NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"network.openshift.io/policy-group": "ingress"},
+		}})
+	}
+	return from
+}
+
+// egressRules returns the destinations the pods with the given spec are allowed to reach: the DNS, the other pods of
+// the instance, the storage backends and, for the OAuth Proxy, the API server
+func egressRules(jaeger *v1.Jaeger, spec corev1.PodSpec) []networkingv1.NetworkPolicyEgressRule {
+	rules := []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				policyPort(corev1.ProtocolUDP, dnsPort),
+				policyPort(corev1.ProtocolTCP, dnsPort),
+			},
+		},
+		{
+			To: []networkingv1.NetworkPolicyPeer{{PodSelector: instanceSelector(jaeger)}},
+		},
+	}
+
+	var ports []networkingv1.NetworkPolicyPort
+	for _, port := range backendPorts(jaeger, spec) {
+		ports = append(ports, policyPort(corev1.ProtocolTCP, port))
+	}
+	if len(ports) > 0 {
+		rules = append(rules, networkingv1.NetworkPolicyEgressRule{Ports: ports})
+	}
+	return rules
+}
+
+// backendPorts returns the ports of the backends the containers of the given spec connect to, as per their
+// arguments, falling back to the default ports of the instance's storage
+func backendPorts(jaeger *v1.Jaeger, spec corev1.PodSpec) []int32 {
+	seen := map[int32]bool{}
+	var ports []int32
+	add := func(port int32) {
+		if !seen[port] {
+			seen[port] = true
+			ports = append(ports, port)
+		}
+	}
+
+	found := map[string]bool{}
+	for _, c := range spec.Containers {
+		for _, arg := range c.Args {
+			for flag, parse := range storageAddressFlags {
+				if strings.HasPrefix(arg, flag) {
+					found[flag] = true
+					for _, port := range parse(strings.TrimPrefix(arg, flag)) {
+						add(port)
+					}
+				}
+			}
+		}
+		if c.Name == util.SidecarOAuthProxy {
+			for _, port := range apiServerPorts {
+				add(port)
+			}
+		}
+	}
+
+	switch jaeger.Spec.Storage.Type {
+	case v1.JaegerESStorage:
+		if !found["--es.server-urls="] {
+			add(elasticsearchPort)
+		}
+	case v1.JaegerCassandraStorage:
+		if !found["--cassandra.port="] {
+			add(cassandraPort)
+		}
+	}
+	if jaeger.Spec.Strategy == v1.DeploymentStrategyStreaming && !found["--kafka.producer.brokers="] && !found["--kafka.consumer.brokers="] {
+		add(kafkaPort)
+	}
+	return ports
+}
+
+func urlPorts(value string) []int32 {
+	var ports []int32
+	for _, entry := range strings.Split(value, ",") {
+		u, err := url.Parse(strings.TrimSpace(entry))
+		if err != nil {
+			continue
+		}
+		switch {
+		case u.Port() != "":
+			ports = append(ports, singlePort(u.Port())...)
+		case u.Scheme == "https":
+			ports = append(ports, 443)
+		case u.Scheme == "http":
+			ports = append(ports, 80)
+		}
+	}
+	return ports
+}
+
+func hostPorts(value string) []int32 {
+	var ports []int32
+	for _, entry := range strings.Split(value, ",") {
+		if _, port, err := net.SplitHostPort(strings.TrimSpace(entry)); err == nil {
+			ports = append(ports, singlePort(port)...)
+		}
+	}
+	return ports
+}
+
+func singlePort(value string) []int32 {
+	port, err := strconv.ParseInt(strings.TrimSpace(value), 10, 32)
+	if err != nil || port <= 0 || port > 65535 {
+		return nil
+	}
+	return []int32{int32(port)}
+}
+
+// forElasticsearch allows the pods of this instance, including the jobs, and the elasticsearch operator to reach the
+// self-provisioned elasticsearch nodes, which also talk to each other over the transport port
+func forElasticsearch(jaeger *v1.Jaeger, es esv1.Elasticsearch) networkingv1.NetworkPolicy {
+	name := util.DNSName(util.Truncate("%s-%s", 63, jaeger.Name, es.Name))
+	// these are the labels the elasticsearch operator sets on the nodes it creates
+	nodes := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"cluster-name": es.Name,
+			"component":    "elasticsearch",
+		},
+	}
+	return networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NetworkPolicy",
+			APIVersion: "networking.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       jaeger.Namespace,
+			Labels:          util.Labels(name, "network-policy", *jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: nodes,
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					Ports: []networkingv1.NetworkPolicyPort{policyPort(corev1.ProtocolTCP, elasticsearchPort)},
+					From: []networkingv1.NetworkPolicyPeer{
+						{PodSelector: instanceSelector(jaeger)},
+						{
+							// the elasticsearch operator runs in its own namespace
+							NamespaceSelector: &metav1.LabelSelector{},
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"name": "elasticsearch-operator"},
+							},
+						},
+					},
+				},
+				{
+					Ports: []networkingv1.NetworkPolicyPort{policyPort(corev1.ProtocolTCP, elasticsearchTransportPort)},
+					From:  []networkingv1.NetworkPolicyPeer{{PodSelector: nodes.DeepCopy()}},
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+}
+
+// instanceSelector selects the pods of the given instance, including the jobs
+func instanceSelector(jaeger *v1.Jaeger) *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"app.kubernetes.io/instance": util.Truncate(jaeger.Name, 63),
+			"app.kubernetes.io/part-of":  "jaeger",
+		},
+	}
+}
+
+func policyPort(protocol corev1.Protocol, port int32) networkingv1.NetworkPolicyPort {
+	if protocol == "" {
+		protocol = corev1.ProtocolTCP
+	}
+	p := intstr.FromInt(int(port))
+	return networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &p}
+}
@@ -0,0 +1,211 @@
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/deployment"
+	esv1 "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1"
+)
+
+func TestNoNetworkPoliciesByDefault(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestNoNetworkPoliciesByDefault"})
+	deps := []appsv1.Deployment{*deployment.NewCollector(jaeger).Get()}
+
+	assert.Len(t, Get(jaeger, deps, nil, nil), 0)
+}
+
+func TestNetworkPolicyForCollector(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestNetworkPolicyForCollector", Namespace: "observability"})
+	jaeger.Spec.NetworkPolicy.Enabled = &enabled
+	collector := deployment.NewCollector(jaeger).Get()
+
+	policies := Get(jaeger, []appsv1.Deployment{*collector}, nil, nil)
+	require.Len(t, policies, 1)
+
+	p := policies[0]
+	assert.Equal(t, collector.Name, p.Name)
+	assert.Equal(t, "observability", p.Namespace)
+	assert.Len(t, p.OwnerReferences, 1)
+	assert.Equal(t, *collector.Spec.Selector, p.Spec.PodSelector)
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, p.Spec.PolicyTypes)
+
+	// the spans can come from anywhere
+	require.Len(t, p.Spec.Ingress, 1)
+	assert.Empty(t, p.Spec.Ingress[0].From)
+	assert.Contains(t, ports(p.Spec.Ingress[0]), 14250)
+	assert.Contains(t, ports(p.Spec.Ingress[0]), 14268)
+}
+
+func TestNetworkPolicyForQuery(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestNetworkPolicyForQuery"})
+	jaeger.Spec.NetworkPolicy.Enabled = &enabled
+	jaeger.Spec.NetworkPolicy.QueryIngressCIDRs = []string{"10.0.0.0/8"}
+	query := deployment.NewQuery(jaeger).Get()
+
+	policies := Get(jaeger, []appsv1.Deployment{*query}, nil, nil)
+	require.Len(t, policies, 1)
+
+	p := policies[0]
+	require.Len(t, p.Spec.Ingress, 2)
+
+	// the admin port remains open, so that metrics can be scraped
+	assert.Empty(t, p.Spec.Ingress[0].From)
+	assert.NotContains(t, ports(p.Spec.Ingress[0]), 16686)
+
+	// the UI is reachable from the same namespace and from the given CIDRs
+	assert.Equal(t, []int{16686}, ports(p.Spec.Ingress[1]))
+	require.Len(t, p.Spec.Ingress[1].From, 2)
+	assert.Equal(t, &metav1.LabelSelector{}, p.Spec.Ingress[1].From[0].PodSelector)
+	assert.Equal(t, "10.0.0.0/8", p.Spec.Ingress[1].From[1].IPBlock.CIDR)
+}
+
+func TestNetworkPolicyForAgentDaemonSet(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestNetworkPolicyForAgentDaemonSet"})
+	jaeger.Spec.NetworkPolicy.Enabled = &enabled
+	jaeger.Spec.Agent.Strategy = "DaemonSet"
+	agent := deployment.NewAgent(jaeger).Get()
+	require.NotNil(t, agent)
+
+	policies := Get(jaeger, nil, []appsv1.DaemonSet{*agent}, nil)
+	require.Len(t, policies, 1)
+	assert.Equal(t, agent.Name, policies[0].Name)
+	assert.Contains(t, ports(policies[0].Spec.Ingress[0]), 6831)
+}
+
+func TestNetworkPolicyForElasticsearch(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestNetworkPolicyForElasticsearch"})
+	jaeger.Spec.NetworkPolicy.Enabled = &enabled
+	es := esv1.Elasticsearch{ObjectMeta: metav1.ObjectMeta{Name: "elasticsearch"}}
+
+	policies := Get(jaeger, nil, nil, []esv1.Elasticsearch{es})
+	require.Len(t, policies, 1)
+
+	p := policies[0]
+	assert.Equal(t, "testnetworkpolicyforelasticsearch-elasticsearch", p.Name)
+	assert.Equal(t, "elasticsearch", p.Spec.PodSelector.MatchLabels["cluster-name"])
+	require.Len(t, p.Spec.Ingress, 2)
+
+	// the jaeger pods and the elasticsearch operator use the HTTP port
+	assert.Equal(t, []int{9200}, ports(p.Spec.Ingress[0]))
+	require.Len(t, p.Spec.Ingress[0].From, 2)
+	assert.Equal(t, jaeger.Name, p.Spec.Ingress[0].From[0].PodSelector.MatchLabels["app.kubernetes.io/instance"])
+	assert.Equal(t, &metav1.LabelSelector{}, p.Spec.Ingress[0].From[1].NamespaceSelector)
+	assert.Equal(t, "elasticsearch-operator", p.Spec.Ingress[0].From[1].PodSelector.MatchLabels["name"])
+
+	// the nodes talk to each other over the transport port
+	assert.Equal(t, []int{9300}, ports(p.Spec.Ingress[1]))
+	require.Len(t, p.Spec.Ingress[1].From, 1)
+	assert.Equal(t, p.Spec.PodSelector, *p.Spec.Ingress[1].From[0].PodSelector)
+}
+
+func TestNetworkPolicyQueryIngressNamespaces(t *testing.T) {
+	viper.Set("platform", v1.FlagPlatformOpenShift)
+	defer viper.Reset()
+
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestNetworkPolicyQueryIngressNamespaces"})
+	jaeger.Spec.NetworkPolicy.Enabled = &enabled
+	jaeger.Spec.NetworkPolicy.QueryIngressNamespaceSelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"name": "ingress-nginx"},
+	}
+	query := deployment.NewQuery(jaeger).Get()
+
+	policies := Get(jaeger, []appsv1.Deployment{*query}, nil, nil)
+	require.Len(t, policies, 1)
+
+	from := policies[0].Spec.Ingress[1].From
+	require.Len(t, from, 3)
+	assert.Equal(t, jaeger.Spec.NetworkPolicy.QueryIngressNamespaceSelector, from[1].NamespaceSelector)
+	assert.Equal(t, "ingress", from[2].NamespaceSelector.MatchLabels["network.openshift.io/policy-group"])
+}
+
+func TestNetworkPolicyEgress(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestNetworkPolicyEgress"})
+	jaeger.Spec.NetworkPolicy.Enabled = &enabled
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	collector := deployment.NewCollector(jaeger).Get()
+
+	policies := Get(jaeger, []appsv1.Deployment{*collector}, nil, nil)
+	require.Len(t, policies, 1)
+
+	egress := policies[0].Spec.Egress
+	require.Len(t, egress, 3)
+	assert.Equal(t, []int{53, 53}, egressPorts(egress[0]))
+	assert.Empty(t, egress[0].To)
+	assert.Empty(t, egress[1].Ports)
+	assert.Equal(t, jaeger.Name, egress[1].To[0].PodSelector.MatchLabels["app.kubernetes.io/instance"])
+	assert.Equal(t, []int{9200}, egressPorts(egress[2]))
+}
+
+func TestNetworkPolicyEgressFromArgs(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestNetworkPolicyEgressFromArgs"})
+	jaeger.Spec.NetworkPolicy.Enabled = &enabled
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{
+		"es.server-urls": "https://es-0.example.com,http://es-1.example.com:9201",
+	})
+	query := deployment.NewQuery(jaeger).Get()
+
+	policies := Get(jaeger, []appsv1.Deployment{*query}, nil, nil)
+	require.Len(t, policies, 1)
+
+	egress := policies[0].Spec.Egress
+	require.Len(t, egress, 3)
+	assert.ElementsMatch(t, []int{443, 9201}, egressPorts(egress[2]))
+}
+
+func ports(rule networkingv1.NetworkPolicyIngressRule) []int {
+	var res []int
+	for _, p := range rule.Ports {
+		res = append(res, p.Port.IntValue())
+	}
+	return res
+}
+
+func egressPorts(rule networkingv1.NetworkPolicyEgressRule) []int {
+	var res []int
+	for _, p := range rule.Ports {
+		res = append(res, p.Port.IntValue())
+	}
+	return res
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		cidrs []string
+		valid bool
+	}{
+		{name: "defaults", valid: true},
+		{name: "ipv4 and ipv6", cidrs: []string{"10.0.0.0/8", "2001:db8::/32"}, valid: true},
+		{name: "address without prefix length", cidrs: []string{"10.0.0.0/8", "10.0.0.1"}, valid: false},
+		{name: "empty", cidrs: []string{""}, valid: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestValidate"})
+			jaeger.Spec.NetworkPolicy.QueryIngressCIDRs = tt.cidrs
+
+			err := Validate(jaeger)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
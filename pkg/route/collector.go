@@ -0,0 +1,84 @@
+package route
+
+import (
+	"fmt"
+
+	corev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/service"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+// CollectorRoute builds a route for the collector endpoint of an all-in-one instance
+type CollectorRoute struct {
+	jaeger *v1.Jaeger
+}
+
+// NewCollectorRoute builds a new CollectorRoute struct based on the given spec
+func NewCollectorRoute(jaeger *v1.Jaeger) *CollectorRoute {
+	return &CollectorRoute{jaeger: jaeger}
+}
+
+// Get returns a route specification for the current instance, or nil when the collector isn't meant to be exposed
+func (r *CollectorRoute) Get() *corev1.Route {
+	if r.jaeger.Spec.Ingress.Enabled != nil && *r.jaeger.Spec.Ingress.Enabled == false {
+		return nil
+	}
+
+	if skipRoute(r.jaeger) {
+		return nil
+	}
+
+	if r.jaeger.Spec.Strategy != v1.DeploymentStrategyAllInOne {
+		return nil
+	}
+
+	if r.jaeger.Spec.Ingress.Collector.Enabled == nil || *r.jaeger.Spec.Ingress.Collector.Enabled == false {
+		return nil
+	}
+
+	var name string
+	if len(r.jaeger.Namespace) >= 63 {
+		// the route is doomed already, nothing we can do...
+		name = fmt.Sprintf("%s-collector", r.jaeger.Name)
+		r.jaeger.Logger().WithField("name", name).Warn("the route's hostname will have more than 63 chars and will not be valid")
+	} else {
+		// -namespace is added to the host by OpenShift
+		name = util.Truncate("%s-collector", 62-len(r.jaeger.Namespace), r.jaeger.Name)
+	}
+	name = util.DNSName(name)
+
+	var host string
+	if len(r.jaeger.Spec.Ingress.Collector.Hosts) > 0 {
+		host = r.jaeger.Spec.Ingress.Collector.Hosts[0]
+	}
+
+	return &corev1.Route{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Route",
+			APIVersion: "route.openshift.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       r.jaeger.Namespace,
+			Labels:          util.Labels(r.jaeger.Name, "collector-route", *r.jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(r.jaeger)},
+		},
+		Spec: corev1.RouteSpec{
+			Host: host,
+			To: corev1.RouteTargetReference{
+				Kind: "Service",
+				Name: service.GetNameForCollectorService(r.jaeger),
+			},
+			Port: &corev1.RoutePort{
+				TargetPort: intstr.FromInt(int(service.GetPortForCollectorIngress(r.jaeger))),
+			},
+			TLS: &corev1.TLSConfig{
+				Termination: corev1.TLSTerminationEdge,
+			},
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package route
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestCollectorRouteDefaultsToQueryOnly(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorRouteDefaultsToQueryOnly"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
+
+	assert.Nil(t, NewCollectorRoute(jaeger).Get())
+}
+
+func TestCollectorRouteEnabled(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorRouteEnabled"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
+	jaeger.Spec.Ingress.Collector.Enabled = &enabled
+	jaeger.Spec.Ingress.Collector.Hosts = []string{"collector.example.com"}
+
+	dep := NewCollectorRoute(jaeger).Get()
+
+	assert.NotNil(t, dep)
+	assert.Equal(t, "testcollectorrouteenabled-collector", dep.Name)
+	assert.Equal(t, "testcollectorrouteenabled-collector", dep.Spec.To.Name)
+	assert.Equal(t, intstr.FromInt(14268), dep.Spec.Port.TargetPort)
+	assert.Equal(t, "collector.example.com", dep.Spec.Host)
+}
+
+func TestCollectorRouteSkipped(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorRouteSkipped"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
+	jaeger.Spec.Ingress.Collector.Enabled = &enabled
+	jaeger.Spec.Ingress.Openshift.SkipRoute = &enabled
+
+	assert.Nil(t, NewCollectorRoute(jaeger).Get())
+}
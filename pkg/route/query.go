@@ -25,7 +25,9 @@ func (r *QueryRoute) Get() *corev1.Route {
 		return nil
 	}
 
-	trueVar := true
+	if skipRoute(r.jaeger) {
+		return nil
+	}
 
 	var termination corev1.TLSTerminationType
 	if r.jaeger.Spec.Ingress.Security == v1.IngressSecurityOAuthProxy {
@@ -50,18 +52,10 @@ func (r *QueryRoute) Get() *corev1.Route {
 			APIVersion: "route.openshift.io/v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: r.jaeger.Namespace,
-			Labels:    util.Labels(r.jaeger.Name, "query-route", *r.jaeger),
-			OwnerReferences: []metav1.OwnerReference{
-				metav1.OwnerReference{
-					APIVersion: r.jaeger.APIVersion,
-					Kind:       r.jaeger.Kind,
-					Name:       r.jaeger.Name,
-					UID:        r.jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
+			Name:            name,
+			Namespace:       r.jaeger.Namespace,
+			Labels:          util.Labels(r.jaeger.Name, "query-route", *r.jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(r.jaeger)},
 		},
 		Spec: corev1.RouteSpec{
 			To: corev1.RouteTargetReference{
@@ -74,3 +68,8 @@ func (r *QueryRoute) Get() *corev1.Route {
 		},
 	}
 }
+
+// skipRoute determines whether the routes are managed outside of the operator
+func skipRoute(jaeger *v1.Jaeger) bool {
+	return jaeger.Spec.Ingress.Openshift.SkipRoute != nil && *jaeger.Spec.Ingress.Openshift.SkipRoute
+}
@@ -44,6 +44,14 @@ func TestQueryRouteEnabled(t *testing.T) {
 	assert.NotNil(t, dep)
 }
 
+func TestQueryRouteSkipped(t *testing.T) {
+	skip := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryRouteSkipped"})
+	jaeger.Spec.Ingress.Openshift.SkipRoute = &skip
+
+	assert.Nil(t, NewQueryRoute(jaeger).Get())
+}
+
 func TestQueryRouteTerminationTypeWithOAuthProxy(t *testing.T) {
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryRouteTerminationTypeWithOAuthProxy"})
 	jaeger.Spec.Ingress.Security = v1.IngressSecurityOAuthProxy
@@ -10,7 +10,6 @@ import (
 
 // NewAgentService returns a new Kubernetes service for Jaeger Agent backed by the pods matching the selector
 func NewAgentService(jaeger *v1.Jaeger, selector map[string]string) *corev1.Service {
-	trueVar := true
 	name := util.DNSName(util.Truncate("%s-agent", 63, jaeger.Name))
 
 	return &corev1.Service{
@@ -19,18 +18,10 @@ func NewAgentService(jaeger *v1.Jaeger, selector map[string]string) *corev1.Serv
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: jaeger.Namespace,
-			Labels:    util.Labels(name, "service-agent", *jaeger),
-			OwnerReferences: []metav1.OwnerReference{
-				metav1.OwnerReference{
-					APIVersion: jaeger.APIVersion,
-					Kind:       jaeger.Kind,
-					Name:       jaeger.Name,
-					UID:        jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
+			Name:            name,
+			Namespace:       jaeger.Namespace,
+			Labels:          util.Labels(name, "service-agent", *jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 		Spec: corev1.ServiceSpec{
 			Selector:  selector,
@@ -12,6 +12,17 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
+const (
+	// ZipkinPort is the port of the collector's Zipkin endpoint
+	ZipkinPort = 9411
+
+	// OTLPGRPCPort is the port of the OTLP gRPC receiver
+	OTLPGRPCPort = 4317
+
+	// OTLPHTTPPort is the port of the OTLP HTTP receiver
+	OTLPHTTPPort = 4318
+)
+
 // NewCollectorServices returns a new Kubernetes service for Jaeger Collector backed by the pods matching the selector
 func NewCollectorServices(jaeger *v1.Jaeger, selector map[string]string) []*corev1.Service {
 	return []*corev1.Service{
@@ -38,23 +49,16 @@ func clusteripCollectorService(jaeger *v1.Jaeger, selector map[string]string) *c
 }
 
 func collectorService(jaeger *v1.Jaeger, selector map[string]string) *corev1.Service {
-	trueVar := true
-	return &corev1.Service{
+	svc := &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Service",
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      GetNameForCollectorService(jaeger),
-			Namespace: jaeger.Namespace,
-			Labels:    util.Labels(GetNameForCollectorService(jaeger), "service-collector", *jaeger),
-			OwnerReferences: []metav1.OwnerReference{{
-				APIVersion: jaeger.APIVersion,
-				Kind:       jaeger.Kind,
-				Name:       jaeger.Name,
-				UID:        jaeger.UID,
-				Controller: &trueVar,
-			}},
+			Name:            GetNameForCollectorService(jaeger),
+			Namespace:       jaeger.Namespace,
+			Labels:          util.Labels(GetNameForCollectorService(jaeger), "service-collector", *jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 		Spec: corev1.ServiceSpec{
 			Selector:  selector,
@@ -62,7 +66,7 @@ func collectorService(jaeger *v1.Jaeger, selector map[string]string) *corev1.Ser
 			Ports: []corev1.ServicePort{
 				{
 					Name: "http-zipkin",
-					Port: 9411,
+					Port: ZipkinPort,
 				},
 				{
 					Name: GetPortNameForGRPC(jaeger),
@@ -74,12 +78,20 @@ func collectorService(jaeger *v1.Jaeger, selector map[string]string) *corev1.Ser
 				},
 				{
 					Name: "http-c-binary-trft",
-					Port: 14268,
+					Port: GetThriftHTTPPortForCollector(jaeger),
 				},
 			},
 		},
 	}
 
+	// the metrics are scraped through the service when they are served on a dedicated port
+	if port := jaeger.Spec.Collector.MetricsPort; port != nil {
+		svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+			Name: "http-admin",
+			Port: util.GetAdminPort(jaeger.Spec.Collector.Options.ToArgs(), *port),
+		})
+	}
+	return svc
 }
 
 // GetNameForCollectorService returns the service name for the collector in this Jaeger instance
@@ -130,3 +142,30 @@ func getTypeForCollectorService(jaeger *v1.Jaeger) corev1.ServiceType {
 	}
 	return corev1.ServiceTypeClusterIP
 }
+
+// GetThriftHTTPPortForCollector returns the port of the collector's HTTP endpoint accepting spans in the Thrift format.
+// The all-in-one always listens on the default port, as the collector settings don't apply to it
+func GetThriftHTTPPortForCollector(jaeger *v1.Jaeger) int32 {
+	if jaeger.Spec.Collector.ThriftHTTPPort == nil || jaeger.Spec.Strategy == v1.DeploymentStrategyAllInOne {
+		return 14268
+	}
+	return util.GetPort("--collector.http-server.host-port=", jaeger.Spec.Collector.Options.ToArgs(), *jaeger.Spec.Collector.ThriftHTTPPort)
+}
+
+// GetAdminPortForCollector returns the port of the collector's admin endpoint, serving the metrics and the health check
+func GetAdminPortForCollector(jaeger *v1.Jaeger) int32 {
+	port := int32(14269)
+	if jaeger.Spec.Collector.MetricsPort != nil {
+		port = *jaeger.Spec.Collector.MetricsPort
+	}
+	return util.GetAdminPort(jaeger.Spec.Collector.Options.ToArgs(), port)
+}
+
+// GetPortForCollectorIngress returns the collector service port to be exposed by the ingress or route, defaulting
+// to the HTTP endpoint accepting spans in the Thrift format
+func GetPortForCollectorIngress(jaeger *v1.Jaeger) int32 {
+	if jaeger.Spec.Ingress.Collector.Port > 0 {
+		return jaeger.Spec.Ingress.Collector.Port
+	}
+	return GetThriftHTTPPortForCollector(jaeger)
+}
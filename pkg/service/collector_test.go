@@ -41,6 +41,43 @@ func TestCollectorServiceNameAndPorts(t *testing.T) {
 	assert.Equal(t, svcs[0].Spec.Ports, svcs[1].Spec.Ports)
 }
 
+func TestCollectorServiceMetricsPort(t *testing.T) {
+	name := "TestCollectorServiceMetricsPort"
+	selector := map[string]string{"app": "myapp", "jaeger": name, "jaeger-component": "collector"}
+
+	port := int32(9090)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: name})
+	jaeger.Spec.Collector.MetricsPort = &port
+	svcs := NewCollectorServices(jaeger, selector)
+
+	for _, svc := range svcs {
+		assert.Contains(t, svc.Spec.Ports, corev1.ServicePort{Name: "http-admin", Port: 9090})
+	}
+}
+
+func TestCollectorServiceThriftHTTPPort(t *testing.T) {
+	name := "TestCollectorServiceThriftHTTPPort"
+	selector := map[string]string{"app": "myapp", "jaeger": name, "jaeger-component": "collector"}
+
+	port := int32(8080)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: name})
+	jaeger.Spec.Collector.ThriftHTTPPort = &port
+	svcs := NewCollectorServices(jaeger, selector)
+
+	for _, svc := range svcs {
+		assert.Contains(t, svc.Spec.Ports, corev1.ServicePort{Name: "http-c-binary-trft", Port: 8080})
+	}
+	assert.Equal(t, int32(8080), GetPortForCollectorIngress(jaeger))
+
+	// an explicit option takes precedence
+	jaeger.Spec.Collector.Options = v1.NewOptions(map[string]interface{}{"collector.http-server.host-port": ":8081"})
+	assert.Equal(t, int32(8081), GetThriftHTTPPortForCollector(jaeger))
+
+	// the all-in-one always listens on the default port
+	jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
+	assert.Equal(t, int32(14268), GetThriftHTTPPortForCollector(jaeger))
+}
+
 func TestCollectorServiceWithClusterIPEmptyAndNone(t *testing.T) {
 	name := "TestCollectorServiceWithClusterIP"
 	selector := map[string]string{"app": "myapp", "jaeger": name, "jaeger-component": "collector"}
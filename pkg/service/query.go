@@ -11,32 +11,22 @@ import (
 
 // NewQueryService returns a new Kubernetes service for Jaeger Query backed by the pods matching the selector
 func NewQueryService(jaeger *v1.Jaeger, selector map[string]string) *corev1.Service {
-	trueVar := true
-
 	annotations := map[string]string{}
 	if jaeger.Spec.Ingress.Security == v1.IngressSecurityOAuthProxy {
 		annotations["service.alpha.openshift.io/serving-cert-secret-name"] = GetTLSSecretNameForQueryService(jaeger)
 	}
 
-	return &corev1.Service{
+	svc := &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Service",
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        GetNameForQueryService(jaeger),
-			Namespace:   jaeger.Namespace,
-			Labels:      util.Labels(GetNameForQueryService(jaeger), "service-query", *jaeger),
-			Annotations: annotations,
-			OwnerReferences: []metav1.OwnerReference{
-				metav1.OwnerReference{
-					APIVersion: jaeger.APIVersion,
-					Kind:       jaeger.Kind,
-					Name:       jaeger.Name,
-					UID:        jaeger.UID,
-					Controller: &trueVar,
-				},
-			},
+			Name:            GetNameForQueryService(jaeger),
+			Namespace:       jaeger.Namespace,
+			Labels:          util.Labels(GetNameForQueryService(jaeger), "service-query", *jaeger),
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 		Spec: corev1.ServiceSpec{
 			Selector: selector,
@@ -50,6 +40,17 @@ func NewQueryService(jaeger *v1.Jaeger, selector map[string]string) *corev1.Serv
 			},
 		},
 	}
+
+	if jaeger.Spec.Query.SessionAffinity == corev1.ServiceAffinityClientIP {
+		svc.Spec.SessionAffinity = corev1.ServiceAffinityClientIP
+		if timeout := jaeger.Spec.Query.SessionAffinityTimeoutSeconds; timeout != nil {
+			svc.Spec.SessionAffinityConfig = &corev1.SessionAffinityConfig{
+				ClientIP: &corev1.ClientIPConfig{TimeoutSeconds: timeout},
+			}
+		}
+	}
+
+	return svc
 }
 
 // GetNameForQueryService returns the query service name for this Jaeger instance
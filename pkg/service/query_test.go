@@ -84,3 +84,19 @@ func TestQueryServiceLoadBalancerWithIngress(t *testing.T) {
 	assert.Equal(t, intstr.FromInt(16686), svc.Spec.Ports[0].TargetPort)
 	assert.Equal(t, svc.Spec.Type, corev1.ServiceTypeLoadBalancer) // make sure we get a LoadBalancer service
 }
+
+func TestQueryServiceSessionAffinity(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryServiceSessionAffinity"})
+
+	svc := NewQueryService(jaeger, map[string]string{})
+	assert.Empty(t, svc.Spec.SessionAffinity)
+	assert.Nil(t, svc.Spec.SessionAffinityConfig)
+
+	timeout := int32(3600)
+	jaeger.Spec.Query.SessionAffinity = corev1.ServiceAffinityClientIP
+	jaeger.Spec.Query.SessionAffinityTimeoutSeconds = &timeout
+
+	svc = NewQueryService(jaeger, map[string]string{})
+	assert.Equal(t, corev1.ServiceAffinityClientIP, svc.Spec.SessionAffinity)
+	assert.Equal(t, &timeout, svc.Spec.SessionAffinityConfig.ClientIP.TimeoutSeconds)
+}
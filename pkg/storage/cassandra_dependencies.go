@@ -2,6 +2,7 @@ package storage
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	batchv1 "k8s.io/api/batch/v1"
@@ -36,26 +37,28 @@ func cassandraDeps(jaeger *v1.Jaeger) []batchv1.Job {
 		jaeger.Spec.Storage.CassandraCreateSchema.Mode = "prod"
 	}
 
-	host := jaeger.Spec.Storage.Options.Map()["cassandra.servers"]
+	sOpts := jaeger.Spec.Storage.EffectiveOptions()
+	sFlagsMap := sOpts.Map()
+	host := sFlagsMap["cassandra.servers"]
 	if host == "" {
 		jaeger.Logger().Info("Cassandra hostname not specified. Using 'cassandra' for the cassandra-create-schema job.")
 		host = "cassandra" // this is the default in the image
 	}
 
-	port := jaeger.Spec.Storage.Options.Map()["cassandra.port"]
+	port := sFlagsMap["cassandra.port"]
 	if port == "" {
 		jaeger.Logger().Info("Cassandra port not specified. Using '9042' for the cassandra-create-schema job.")
 		port = "9042" // this is the default in the image
 	}
 
-	keyspace := jaeger.Spec.Storage.Options.Map()["cassandra.keyspace"]
+	keyspace := sFlagsMap["cassandra.keyspace"]
 	if keyspace == "" {
 		jaeger.Logger().Info("Cassandra keyspace not specified. Using 'jaeger_v1_test' for the cassandra-create-schema job.")
 		keyspace = "jaeger_v1_test" // this is default in the image
 	}
 
-	username := jaeger.Spec.Storage.Options.Map()["cassandra.username"]
-	password := jaeger.Spec.Storage.Options.Map()["cassandra.password"]
+	username := sFlagsMap["cassandra.username"]
+	password := sFlagsMap["cassandra.password"]
 
 	annotations := map[string]string{
 		"prometheus.io/scrape":    "false",
@@ -83,14 +86,53 @@ func cassandraDeps(jaeger *v1.Jaeger) []batchv1.Job {
 	// see: https://github.com/jaegertracing/jaeger/blob/master/plugin/storage/cassandra/schema/create.sh
 	traceTTLSeconds := "172800"
 	if jaeger.Spec.Storage.CassandraCreateSchema.TraceTTL != "" {
-		dur, err := time.ParseDuration(jaeger.Spec.Storage.CassandraCreateSchema.TraceTTL)
+		seconds, err := CassandraTTLSeconds(jaeger.Spec.Storage.CassandraCreateSchema.TraceTTL)
 		if err != nil {
 			jaeger.Logger().
 				WithError(err).
 				WithField("timeout", jaeger.Spec.Storage.CassandraCreateSchema.TraceTTL).
 				Error("Failed to parse cassandraCreateSchema.traceTTL to time.duration. Using the default.")
 		} else {
-			traceTTLSeconds = fmt.Sprintf("%.0f", dur.Seconds())
+			traceTTLSeconds = seconds
+		}
+	}
+
+	env := []corev1.EnvVar{{
+		Name:  "CQLSH_HOST",
+		Value: host,
+	}, {
+		Name:  "CQLSH_PORT",
+		Value: port,
+	}, {
+		Name:  "MODE",
+		Value: jaeger.Spec.Storage.CassandraCreateSchema.Mode,
+	}, {
+		Name:  "DATACENTER",
+		Value: jaeger.Spec.Storage.CassandraCreateSchema.Datacenter,
+	}, {
+		Name:  "TRACE_TTL",
+		Value: traceTTLSeconds,
+	}, {
+		Name:  "KEYSPACE",
+		Value: keyspace,
+	}, {
+		Name:  "CASSANDRA_USERNAME",
+		Value: username,
+	}, {
+		Name:  "CASSANDRA_PASSWORD",
+		Value: password,
+	}}
+
+	// TTL for the dependencies data, in seconds (the image defaults to 0, meaning no TTL)
+	if jaeger.Spec.Storage.CassandraCreateSchema.DependenciesTTL != "" {
+		seconds, err := CassandraTTLSeconds(jaeger.Spec.Storage.CassandraCreateSchema.DependenciesTTL)
+		if err != nil {
+			jaeger.Logger().
+				WithError(err).
+				WithField("ttl", jaeger.Spec.Storage.CassandraCreateSchema.DependenciesTTL).
+				Error("Failed to parse cassandraCreateSchema.dependenciesTTL. Using the default.")
+		} else {
+			env = append(env, corev1.EnvVar{Name: "DEPENDENCIES_TTL", Value: seconds})
 		}
 	}
 
@@ -119,18 +161,10 @@ func cassandraDeps(jaeger *v1.Jaeger) []batchv1.Job {
 			ObjectMeta: metav1.ObjectMeta{
 				// while the name itself isn't a problem, Kubernetes will create a job with a label "job-name" with this value
 				// so, this value has to be restricted to 63 chars
-				Name:      truncatedName,
-				Namespace: jaeger.Namespace,
-				Labels:    util.Labels(truncatedName, "cronjob-cassandra-schema", *jaeger),
-				OwnerReferences: []metav1.OwnerReference{
-					{
-						APIVersion: jaeger.APIVersion,
-						Kind:       jaeger.Kind,
-						Name:       jaeger.Name,
-						UID:        jaeger.UID,
-						Controller: &trueVar,
-					},
-				},
+				Name:            truncatedName,
+				Namespace:       jaeger.Namespace,
+				Labels:          util.Labels(truncatedName, "cronjob-cassandra-schema", *jaeger),
+				OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 			},
 			Spec: batchv1.JobSpec{
 				ActiveDeadlineSeconds: jobTimeout,
@@ -144,31 +178,7 @@ func cassandraDeps(jaeger *v1.Jaeger) []batchv1.Job {
 						Containers: []corev1.Container{{
 							Image: util.ImageName(jaeger.Spec.Storage.CassandraCreateSchema.Image, "jaeger-cassandra-schema-image"),
 							Name:  truncatedName,
-							Env: []corev1.EnvVar{{
-								Name:  "CQLSH_HOST",
-								Value: host,
-							}, {
-								Name:  "CQLSH_PORT",
-								Value: port,
-							}, {
-								Name:  "MODE",
-								Value: jaeger.Spec.Storage.CassandraCreateSchema.Mode,
-							}, {
-								Name:  "DATACENTER",
-								Value: jaeger.Spec.Storage.CassandraCreateSchema.Datacenter,
-							}, {
-								Name:  "TRACE_TTL",
-								Value: traceTTLSeconds,
-							}, {
-								Name:  "KEYSPACE",
-								Value: keyspace,
-							}, {
-								Name:  "CASSANDRA_USERNAME",
-								Value: username,
-							}, {
-								Name:  "CASSANDRA_PASSWORD",
-								Value: password,
-							}},
+							Env:   env,
 						}},
 						RestartPolicy: corev1.RestartPolicyOnFailure,
 					},
@@ -177,3 +187,23 @@ func cassandraDeps(jaeger *v1.Jaeger) []batchv1.Job {
 		},
 	}
 }
+
+// CassandraTTLSeconds converts the given TTL into the number of seconds expected by the create-schema job.
+// The TTL can be either a duration, like 168h, or a plain number of seconds.
+func CassandraTTLSeconds(ttl string) (string, error) {
+	if seconds, err := strconv.ParseInt(ttl, 10, 64); err == nil {
+		if seconds < 0 {
+			return "", fmt.Errorf("the TTL must not be negative, got %d", seconds)
+		}
+		return strconv.FormatInt(seconds, 10), nil
+	}
+
+	dur, err := time.ParseDuration(ttl)
+	if err != nil {
+		return "", err
+	}
+	if dur < 0 {
+		return "", fmt.Errorf("the TTL must not be negative, got %s", ttl)
+	}
+	return fmt.Sprintf("%.0f", dur.Seconds()), nil
+}
@@ -57,6 +57,57 @@ func TestCassandraCustomTraceTTLParseError(t *testing.T) {
 	assert.Equal(t, "172800", foundValue, "unexpected TRACE_TTL environment var value")
 }
 
+func TestCassandraCustomDependenciesTTL(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Storage.CassandraCreateSchema.TraceTTL = "86400"
+	jaeger.Spec.Storage.CassandraCreateSchema.DependenciesTTL = "168h"
+
+	b := cassandraDeps(jaeger)
+	assert.Len(t, b, 1)
+	env := map[string]string{}
+	for _, e := range b[0].Spec.Template.Spec.Containers[0].Env {
+		env[e.Name] = e.Value
+	}
+	assert.Equal(t, "86400", env["TRACE_TTL"])
+	assert.Equal(t, "604800", env["DEPENDENCIES_TTL"])
+}
+
+func TestCassandraDefaultDependenciesTTL(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	b := cassandraDeps(jaeger)
+	assert.Len(t, b, 1)
+	for _, e := range b[0].Spec.Template.Spec.Containers[0].Env {
+		assert.NotEqual(t, "DEPENDENCIES_TTL", e.Name)
+	}
+}
+
+func TestCassandraTTLSeconds(t *testing.T) {
+	for _, tt := range []struct {
+		ttl      string
+		expected string
+		err      bool
+	}{
+		{ttl: "172800", expected: "172800"},
+		{ttl: "48h", expected: "172800"},
+		{ttl: "90m", expected: "5400"},
+		{ttl: "0", expected: "0"},
+		{ttl: "7d", err: true},
+		{ttl: "-1", err: true},
+		{ttl: "-1h", err: true},
+	} {
+		t.Run(tt.ttl, func(t *testing.T) {
+			seconds, err := CassandraTTLSeconds(tt.ttl)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, seconds)
+		})
+	}
+}
+
 func TestCassandraDefaultPort(t *testing.T) {
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
 
@@ -72,6 +123,23 @@ func TestCassandraDefaultPort(t *testing.T) {
 	assert.Fail(t, "value for CQLSH_PORT environment var not found")
 }
 
+func TestCassandraStructuredServersAndPort(t *testing.T) {
+	port := 9043
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+	jaeger.Spec.Storage.Cassandra = v1.JaegerCassandraSpec{Servers: []string{"cassandra-0", "cassandra-1"}, Port: &port}
+
+	b := cassandraDeps(jaeger)
+	assert.Len(t, b, 1)
+	assert.Len(t, b[0].Spec.Template.Spec.Containers, 1)
+	env := map[string]string{}
+	for _, e := range b[0].Spec.Template.Spec.Containers[0].Env {
+		env[e.Name] = e.Value
+	}
+	assert.Equal(t, "cassandra-0,cassandra-1", env["CQLSH_HOST"])
+	assert.Equal(t, "9043", env["CQLSH_PORT"])
+}
+
 func TestDefaultImage(t *testing.T) {
 	viper.Set("jaeger-cassandra-schema-image", "jaegertracing/theimage")
 	defer viper.Reset()
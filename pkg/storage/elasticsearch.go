@@ -7,6 +7,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
 	esv1 "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1"
@@ -31,6 +32,20 @@ func ShouldDeployElasticsearch(s v1.JaegerStorageSpec) bool {
 	return !ok
 }
 
+// ExistingElasticsearch returns the Elasticsearch CR referenced by the Jaeger instance, which isn't managed by
+// the operator, and whether there's such a reference
+func ExistingElasticsearch(jaeger *v1.Jaeger) (types.NamespacedName, bool) {
+	ref := jaeger.Spec.Storage.Elasticsearch.Existing
+	if ref == nil {
+		return types.NamespacedName{}, false
+	}
+	namespace := ref.Namespace
+	if len(namespace) == 0 {
+		namespace = jaeger.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: ref.Name}, true
+}
+
 // ElasticsearchDeployment represents an ES deployment for Jaeger
 type ElasticsearchDeployment struct {
 	Jaeger     *v1.Jaeger
@@ -38,8 +53,15 @@ type ElasticsearchDeployment struct {
 	Secrets    []corev1.Secret
 }
 
+func (ed *ElasticsearchDeployment) url() string {
+	if ref, ok := ExistingElasticsearch(ed.Jaeger); ok {
+		return fmt.Sprintf("https://%s.%s.svc:9200", ref.Name, ref.Namespace)
+	}
+	return elasticsearchURL
+}
+
 func (ed *ElasticsearchDeployment) injectArguments(container *corev1.Container) {
-	container.Args = append(container.Args, "--es.server-urls="+elasticsearchURL)
+	container.Args = append(container.Args, "--es.server-urls="+ed.url())
 	if util.FindItem("--es.tls=", container.Args) == "" && util.FindItem("--es.tls.enabled=", container.Args) == "" {
 		container.Args = append(container.Args, "--es.tls.enabled=true")
 	}
@@ -62,7 +84,7 @@ func (ed *ElasticsearchDeployment) injectArguments(container *corev1.Container)
 	}
 	if strings.EqualFold(util.FindItem("--es-archive.enabled", container.Args), "--es-archive.enabled=true") {
 		container.Args = append(container.Args,
-			"--es-archive.server-urls="+elasticsearchURL,
+			"--es-archive.server-urls="+ed.url(),
 		)
 		if util.FindItem("--es-archive.tls=", container.Args) == "" && util.FindItem("--es-archive.tls.enabled=", container.Args) == "" {
 			container.Args = append(container.Args, "--es-archive.tls.enabled=true")
@@ -121,15 +143,22 @@ func (ed *ElasticsearchDeployment) InjectSecretsConfiguration(p *corev1.PodSpec)
 	// we assume jaeger containers are first
 	if len(p.Containers) > 0 {
 		// the size of arguments array should be always 2
-		p.Containers[0].Args[1] = elasticsearchURL
+		p.Containers[0].Args[1] = ed.url()
 		p.Containers[0].Env = append(p.Containers[0].Env,
 			corev1.EnvVar{Name: "ES_TLS", Value: "true"},
 			corev1.EnvVar{Name: "ES_TLS_CA", Value: caPath},
 			corev1.EnvVar{Name: "ES_TLS_KEY", Value: keyPath},
 			corev1.EnvVar{Name: "ES_TLS_CERT", Value: certPath},
-			corev1.EnvVar{Name: "SHARDS", Value: strconv.Itoa(int(ed.Jaeger.Spec.Storage.Elasticsearch.NodeCount))},
-			corev1.EnvVar{Name: "REPLICAS", Value: strconv.Itoa(calculateReplicaShards(ed.Jaeger.Spec.Storage.Elasticsearch.RedundancyPolicy, int(ed.Jaeger.Spec.Storage.Elasticsearch.NodeCount)))},
 		)
+		// the shards and replicas might have been set explicitly via the storage options
+		if !hasEnvVar(p.Containers[0].Env, "SHARDS") {
+			p.Containers[0].Env = append(p.Containers[0].Env,
+				corev1.EnvVar{Name: "SHARDS", Value: strconv.Itoa(int(ed.Jaeger.Spec.Storage.Elasticsearch.NodeCount))})
+		}
+		if !hasEnvVar(p.Containers[0].Env, "REPLICAS") {
+			p.Containers[0].Env = append(p.Containers[0].Env,
+				corev1.EnvVar{Name: "REPLICAS", Value: strconv.Itoa(calculateReplicaShards(ed.Jaeger.Spec.Storage.Elasticsearch.RedundancyPolicy, int(ed.Jaeger.Spec.Storage.Elasticsearch.NodeCount)))})
+		}
 		p.Containers[0].VolumeMounts = append(p.Containers[0].VolumeMounts, corev1.VolumeMount{
 			Name:      volumeName,
 			ReadOnly:  true,
@@ -138,6 +167,15 @@ func (ed *ElasticsearchDeployment) InjectSecretsConfiguration(p *corev1.PodSpec)
 	}
 }
 
+func hasEnvVar(envs []corev1.EnvVar, name string) bool {
+	for _, env := range envs {
+		if env.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // Elasticsearch returns an ES CR for the deployment
 func (ed *ElasticsearchDeployment) Elasticsearch() *esv1.Elasticsearch {
 	// this might yield names like:
@@ -148,6 +186,10 @@ func (ed *ElasticsearchDeployment) Elasticsearch() *esv1.Elasticsearch {
 	if ed.Jaeger.Spec.Storage.Elasticsearch.Resources != nil {
 		res = *ed.Jaeger.Spec.Storage.Elasticsearch.Resources
 	}
+	var proxyRes corev1.ResourceRequirements
+	if ed.Jaeger.Spec.Storage.Elasticsearch.ProxyResources != nil {
+		proxyRes = *ed.Jaeger.Spec.Storage.Elasticsearch.ProxyResources
+	}
 	return &esv1.Elasticsearch{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: ed.Jaeger.Namespace,
@@ -168,9 +210,10 @@ func (ed *ElasticsearchDeployment) Elasticsearch() *esv1.Elasticsearch {
 			ManagementState:  esv1.ManagementStateManaged,
 			RedundancyPolicy: ed.Jaeger.Spec.Storage.Elasticsearch.RedundancyPolicy,
 			Spec: esv1.ElasticsearchNodeSpec{
-				Image:       ed.Jaeger.Spec.Storage.Elasticsearch.Image,
-				Resources:   res,
-				Tolerations: ed.Jaeger.Spec.Storage.Elasticsearch.Tolerations,
+				Image:          ed.Jaeger.Spec.Storage.Elasticsearch.Image,
+				Resources:      res,
+				ProxyResources: proxyRes,
+				Tolerations:    ed.Jaeger.Spec.Storage.Elasticsearch.Tolerations,
 			},
 			Nodes: getNodes(uuid, ed.Jaeger.Spec.Storage.Elasticsearch),
 		},
@@ -178,6 +221,9 @@ func (ed *ElasticsearchDeployment) Elasticsearch() *esv1.Elasticsearch {
 }
 
 func getNodes(uuid string, es v1.ElasticsearchSpec) []esv1.ElasticsearchNode {
+	if es.MasterNodes != nil {
+		return getDedicatedMasterNodes(uuid, es)
+	}
 	if es.NodeCount <= 3 {
 		return []esv1.ElasticsearchNode{
 			{
@@ -208,6 +254,40 @@ func getNodes(uuid string, es v1.ElasticsearchSpec) []esv1.ElasticsearchNode {
 	}
 }
 
+// getDedicatedMasterNodes returns a group of master-only nodes, next to a group of data nodes
+func getDedicatedMasterNodes(uuid string, es v1.ElasticsearchSpec) []esv1.ElasticsearchNode {
+	masters := *es.MasterNodes
+	genuuidmaster := uuid + "master"
+
+	master := esv1.ElasticsearchNode{
+		NodeCount:    masters.NodeCount,
+		Storage:      es.Storage,
+		NodeSelector: es.NodeSelector,
+		Roles:        []esv1.ElasticsearchNodeRole{esv1.ElasticsearchRoleMaster},
+		GenUUID:      &genuuidmaster,
+	}
+	if masters.Resources != nil {
+		master.Resources = *masters.Resources
+	}
+	if masters.NodeSelector != nil {
+		master.NodeSelector = masters.NodeSelector
+	}
+	if masters.Storage != nil {
+		master.Storage = *masters.Storage
+	}
+
+	return []esv1.ElasticsearchNode{
+		master,
+		{
+			NodeCount:    es.NodeCount,
+			Storage:      es.Storage,
+			NodeSelector: es.NodeSelector,
+			Roles:        []esv1.ElasticsearchNodeRole{esv1.ElasticsearchRoleClient, esv1.ElasticsearchRoleData},
+			GenUUID:      &uuid,
+		},
+	}
+}
+
 // taken from https://github.com/openshift/cluster-logging-operator/blob/1ead6701c7c7af9c0578aa66597261079b2781d5/vendor/github.com/openshift/elasticsearch-operator/pkg/k8shandler/defaults.go#L33
 func calculateReplicaShards(policyType esv1.RedundancyPolicyType, dataNodes int) int {
 	switch policyType {
@@ -102,10 +102,11 @@ const (
 
 // ElasticsearchNodeSpec represents configuration of an individual Elasticsearch node
 type ElasticsearchNodeSpec struct {
-	Image        string                  `json:"image,omitempty"`
-	Resources    v1.ResourceRequirements `json:"resources"`
-	NodeSelector map[string]string       `json:"nodeSelector,omitempty"`
-	Tolerations  []v1.Toleration         `json:"tolerations,omitempty"`
+	Image          string                  `json:"image,omitempty"`
+	Resources      v1.ResourceRequirements `json:"resources"`
+	ProxyResources v1.ResourceRequirements `json:"proxyResources,omitempty"`
+	NodeSelector   map[string]string       `json:"nodeSelector,omitempty"`
+	Tolerations    []v1.Toleration         `json:"tolerations,omitempty"`
 }
 
 type ElasticsearchRequiredAction string
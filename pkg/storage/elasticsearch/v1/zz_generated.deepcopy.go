@@ -128,6 +128,7 @@ func (in *ElasticsearchNode) DeepCopy() *ElasticsearchNode {
 func (in *ElasticsearchNodeSpec) DeepCopyInto(out *ElasticsearchNodeSpec) {
 	*out = *in
 	in.Resources.DeepCopyInto(&out.Resources)
+	in.ProxyResources.DeepCopyInto(&out.ProxyResources)
 	if in.NodeSelector != nil {
 		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make(map[string]string, len(*in))
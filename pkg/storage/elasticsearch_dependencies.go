@@ -9,13 +9,15 @@ import (
 
 	"github.com/jaegertracing/jaeger-operator/pkg/account"
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/aws"
 	"github.com/jaegertracing/jaeger-operator/pkg/cronjob"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 // EnableRollover returns true if rollover should be enabled
 func EnableRollover(spec v1.JaegerStorageSpec) bool {
-	useAliases := spec.Options.Map()["es.use-aliases"]
+	sOpts := spec.EffectiveOptions()
+	useAliases := sOpts.Map()["es.use-aliases"]
 	return (spec.Type == v1.JaegerESStorage) && strings.EqualFold(useAliases, "true")
 }
 
@@ -31,6 +33,10 @@ func elasticsearchDependencies(jaeger *v1.Jaeger) []batchv1.Job {
 		Labels: util.Labels(name, "job-es-rollover-create-mapping", *jaeger),
 	}
 	commonSpec = util.Merge([]v1.JaegerCommonSpec{jaeger.Spec.Storage.EsRollover.JaegerCommonSpec, jaeger.Spec.JaegerCommonSpec, *commonSpec})
+	aws.Update(jaeger, commonSpec)
+	storageOpts := jaeger.Spec.Storage.EffectiveOptions()
+	env := util.RemoveEmptyVars(append(envVars(storageOpts), aws.EnvVars(jaeger)...))
+	env = append(env, util.CommonEnvVars(*commonSpec, env)...)
 	job := batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            name,
@@ -48,18 +54,20 @@ func elasticsearchDependencies(jaeger *v1.Jaeger) []batchv1.Job {
 					RestartPolicy:      corev1.RestartPolicyOnFailure,
 					Affinity:           commonSpec.Affinity,
 					Tolerations:        commonSpec.Tolerations,
+					NodeSelector:       commonSpec.NodeSelector,
 					SecurityContext:    commonSpec.SecurityContext,
 					ServiceAccountName: account.JaegerServiceAccountFor(jaeger, account.EsRolloverComponent),
 					Volumes:            commonSpec.Volumes,
 					Containers: []corev1.Container{
 						{
-							Name:         name,
-							Image:        util.ImageName(jaeger.Spec.Storage.EsRollover.Image, "jaeger-es-rollover-image"),
-							Args:         []string{"init", util.GetEsHostname(jaeger.Spec.Storage.Options.Map())},
-							Env:          util.RemoveEmptyVars(envVars(jaeger.Spec.Storage.Options)),
-							EnvFrom:      envFromSource,
-							Resources:    commonSpec.Resources,
-							VolumeMounts: commonSpec.VolumeMounts,
+							Name:            name,
+							Image:           util.ImageName(jaeger.Spec.Storage.EsRollover.Image, "jaeger-es-rollover-image"),
+							ImagePullPolicy: commonSpec.ImagePullPolicy,
+							Args:            []string{"init", util.GetEsHostname(storageOpts.Map())},
+							Env:             env,
+							EnvFrom:         envFromSource,
+							Resources:       cronjob.EsRolloverResources(jaeger.Spec.Storage.EsRollover.StepResources.Init, *commonSpec),
+							VolumeMounts:    commonSpec.VolumeMounts,
 						},
 					},
 				},
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -67,6 +68,20 @@ func TestElasticsearchDependencies(t *testing.T) {
 	assert.Equal(t, []corev1.EnvVar{{Name: "INDEX_PREFIX", Value: "shortone"}}, job.Spec.Template.Spec.Containers[0].Env)
 }
 
+func TestElasticsearchDependenciesInitResources(t *testing.T) {
+	j := v1.NewJaeger(types.NamespacedName{Name: "TestElasticsearchDependenciesInitResources"})
+	j.Spec.Storage.EsRollover.Resources = corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+	}
+	j.Spec.Storage.EsRollover.StepResources.Init = corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+	}
+
+	job := elasticsearchDependencies(j)[0]
+
+	assert.Equal(t, resource.MustParse("256Mi"), job.Spec.Template.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory])
+}
+
 func TestEnvVars(t *testing.T) {
 	tests := []struct {
 		opts     v1.Options
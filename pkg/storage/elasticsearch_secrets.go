@@ -78,13 +78,18 @@ var curatorSecret = secret{
 }
 
 // ExtractSecrets assembles a set of secrets related to Elasticsearch
+// The secret for the Elasticsearch nodes is skipped when the cluster isn't managed by the operator.
 func (ed *ElasticsearchDeployment) ExtractSecrets() []corev1.Secret {
-	return []corev1.Secret{
+	secrets := []corev1.Secret{
 		createSecret(ed.Jaeger, masterSecret.instanceName(ed.Jaeger), getWorkingDirContents(getWorkingDir(ed.Jaeger), masterSecret.keyFileNameMap)),
-		createSecret(ed.Jaeger, esSecret.instanceName(ed.Jaeger), getWorkingDirContents(getWorkingDir(ed.Jaeger), esSecret.keyFileNameMap)),
+	}
+	if _, ok := ExistingElasticsearch(ed.Jaeger); !ok {
+		secrets = append(secrets, createSecret(ed.Jaeger, esSecret.instanceName(ed.Jaeger), getWorkingDirContents(getWorkingDir(ed.Jaeger), esSecret.keyFileNameMap)))
+	}
+	return append(secrets,
 		createSecret(ed.Jaeger, jaegerSecret.instanceName(ed.Jaeger), getWorkingDirContents(getWorkingDir(ed.Jaeger), jaegerSecret.keyFileNameMap)),
 		createSecret(ed.Jaeger, curatorSecret.instanceName(ed.Jaeger), getWorkingDirContents(getWorkingDir(ed.Jaeger), curatorSecret.keyFileNameMap)),
-	}
+	)
 }
 
 // CreateCerts creates certificates for elasticsearch, jaeger and curator
@@ -5,10 +5,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/cronjob"
 	esv1 "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1"
 )
 
@@ -27,6 +29,24 @@ func TestShouldDeployElasticsearch(t *testing.T) {
 	}
 }
 
+func TestExistingElasticsearch(t *testing.T) {
+	j := v1.NewJaeger(types.NamespacedName{Name: "TestExistingElasticsearch", Namespace: "observability"})
+	_, ok := ExistingElasticsearch(j)
+	assert.False(t, ok)
+	assert.Equal(t, "https://elasticsearch:9200", (&ElasticsearchDeployment{Jaeger: j}).url())
+
+	j.Spec.Storage.Elasticsearch.Existing = &v1.ElasticsearchReference{Name: "logging"}
+	ref, ok := ExistingElasticsearch(j)
+	assert.True(t, ok)
+	assert.Equal(t, types.NamespacedName{Name: "logging", Namespace: "observability"}, ref)
+
+	j.Spec.Storage.Elasticsearch.Existing.Namespace = "openshift-logging"
+	ref, ok = ExistingElasticsearch(j)
+	assert.True(t, ok)
+	assert.Equal(t, types.NamespacedName{Name: "logging", Namespace: "openshift-logging"}, ref)
+	assert.Equal(t, "https://logging.openshift-logging.svc:9200", (&ElasticsearchDeployment{Jaeger: j}).url())
+}
+
 func TestCreateElasticsearchCR(t *testing.T) {
 	storageClassName := "floppydisk"
 	genuuid1 := "myprojectfoo"
@@ -169,11 +189,25 @@ func TestCreateElasticsearchCR(t *testing.T) {
 		assert.Equal(t, test.namespace, cr.Namespace)
 		assert.Equal(t, "elasticsearch", cr.Name)
 		trueVar := true
-		assert.Equal(t, []metav1.OwnerReference{{Name: test.name, Controller: &trueVar}}, cr.OwnerReferences)
+		assert.Equal(t, []metav1.OwnerReference{{Name: test.name, Controller: &trueVar, BlockOwnerDeletion: &trueVar}}, cr.OwnerReferences)
 		assert.Equal(t, cr.Spec, test.esSpec)
 	}
 }
 
+func TestCreateElasticsearchCRProxyResources(t *testing.T) {
+	proxyResources := corev1.ResourceRequirements{
+		Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+		Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi"), corev1.ResourceCPU: resource.MustParse("200m")},
+	}
+	j := v1.NewJaeger(types.NamespacedName{Name: "TestCreateElasticsearchCRProxyResources"})
+	j.Spec.Storage.Elasticsearch = v1.ElasticsearchSpec{NodeCount: 1, ProxyResources: &proxyResources}
+
+	cr := (&ElasticsearchDeployment{Jaeger: j}).Elasticsearch()
+
+	assert.Equal(t, proxyResources, cr.Spec.Spec.ProxyResources)
+	assert.Equal(t, corev1.ResourceRequirements{}, cr.Spec.Spec.Resources)
+}
+
 func TestInject(t *testing.T) {
 	tests := []struct {
 		pod      *corev1.PodSpec
@@ -303,6 +337,42 @@ func TestInject(t *testing.T) {
 
 }
 
+func TestInjectSecretsConfigurationKeepsExplicitShards(t *testing.T) {
+	es := &ElasticsearchDeployment{Jaeger: v1.NewJaeger(types.NamespacedName{Name: "TestInjectSecretsConfigurationKeepsExplicitShards"})}
+	es.Jaeger.Spec.Storage.Elasticsearch = v1.ElasticsearchSpec{NodeCount: 3, RedundancyPolicy: esv1.SingleRedundancy}
+
+	pod := &corev1.PodSpec{Containers: []corev1.Container{{
+		Args: []string{"init", "http://localhost:9200"},
+		Env:  []corev1.EnvVar{{Name: "SHARDS", Value: "1"}},
+	}}}
+	es.InjectSecretsConfiguration(pod)
+
+	envs := map[string][]string{}
+	for _, env := range pod.Containers[0].Env {
+		envs[env.Name] = append(envs[env.Name], env.Value)
+	}
+	assert.Equal(t, []string{"1"}, envs["SHARDS"])
+	assert.Equal(t, []string{"1"}, envs["REPLICAS"])
+}
+
+func TestInjectSecretsConfigurationKeepLatestCleaner(t *testing.T) {
+	days := 7
+	keep := 3
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestInjectSecretsConfigurationKeepLatestCleaner"})
+	jaeger.Spec.Storage.EsIndexCleaner.NumberOfDays = &days
+	jaeger.Spec.Storage.EsIndexCleaner.KeepLatest = &keep
+	es := &ElasticsearchDeployment{Jaeger: jaeger}
+
+	pod := &cronjob.CreateEsIndexCleaner(jaeger).Spec.JobTemplate.Spec.Template.Spec
+	es.InjectSecretsConfiguration(pod)
+
+	// the keep-latest wrapper needs the client certificate to list the indices of the provisioned cluster
+	assert.Equal(t, "python3", pod.Containers[0].Command[0])
+	assert.Contains(t, pod.Containers[0].Env, corev1.EnvVar{Name: "ES_TLS_CERT", Value: certPath})
+	assert.Contains(t, pod.Containers[0].Env, corev1.EnvVar{Name: "ES_TLS_KEY", Value: keyPath})
+	assert.Contains(t, pod.Containers[0].VolumeMounts, corev1.VolumeMount{Name: volumeName, ReadOnly: true, MountPath: volumeMountPath})
+}
+
 func TestCalculateReplicaShards(t *testing.T) {
 	tests := []struct {
 		dataNodes int
@@ -322,3 +392,37 @@ func TestCalculateReplicaShards(t *testing.T) {
 		assert.Equal(t, test.shards, calculateReplicaShards(test.redType, test.dataNodes))
 	}
 }
+
+func TestGetNodesDedicatedMasters(t *testing.T) {
+	storageClassName := "floppydisk"
+	masterStorageClassName := "ssd"
+	masterResources := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+	}
+	es := v1.ElasticsearchSpec{
+		NodeCount:    2,
+		NodeSelector: map[string]string{"role": "es"},
+		Storage:      esv1.ElasticsearchStorageSpec{StorageClassName: &storageClassName},
+		MasterNodes: &v1.ElasticsearchMasterNodesSpec{
+			NodeCount: 3,
+			Resources: &masterResources,
+			Storage:   &esv1.ElasticsearchStorageSpec{StorageClassName: &masterStorageClassName},
+		},
+	}
+
+	nodes := getNodes("uuid", es)
+
+	assert.Len(t, nodes, 2)
+	assert.Equal(t, int32(3), nodes[0].NodeCount)
+	assert.Equal(t, []esv1.ElasticsearchNodeRole{esv1.ElasticsearchRoleMaster}, nodes[0].Roles)
+	assert.Equal(t, masterResources, nodes[0].Resources)
+	assert.Equal(t, &masterStorageClassName, nodes[0].Storage.StorageClassName)
+	assert.Equal(t, map[string]string{"role": "es"}, nodes[0].NodeSelector)
+	assert.Equal(t, "uuidmaster", *nodes[0].GenUUID)
+
+	assert.Equal(t, int32(2), nodes[1].NodeCount)
+	assert.Equal(t, []esv1.ElasticsearchNodeRole{esv1.ElasticsearchRoleClient, esv1.ElasticsearchRoleData}, nodes[1].Roles)
+	assert.Equal(t, corev1.ResourceRequirements{}, nodes[1].Resources)
+	assert.Equal(t, &storageClassName, nodes[1].Storage.StorageClassName)
+	assert.Equal(t, "uuid", *nodes[1].GenUUID)
+}
@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	esv1 "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1"
+)
+
+// Validate makes sure that the storage settings are consistent with the storage type, before the storage
+// dependencies, the Elasticsearch cluster and the cron jobs get created
+func Validate(spec v1.JaegerStorageSpec) error {
+	if spec.EsRollover.ReadTTL != "" {
+		if _, err := time.ParseDuration(spec.EsRollover.ReadTTL); err != nil {
+			return errors.Wrap(err, "failed to parse esRollover.readTTL to time.Duration")
+		}
+	}
+
+	for step, resources := range map[string]corev1.ResourceRequirements{
+		"init":     spec.EsRollover.StepResources.Init,
+		"rollover": spec.EsRollover.StepResources.Rollover,
+		"lookback": spec.EsRollover.StepResources.Lookback,
+	} {
+		if err := validateResources(resources); err != nil {
+			return errors.Wrapf(err, "invalid esRollover.stepResources.%s", step)
+		}
+	}
+
+	switch policy := spec.Elasticsearch.RedundancyPolicy; policy {
+	case "", esv1.FullRedundancy, esv1.MultipleRedundancy, esv1.SingleRedundancy, esv1.ZeroRedundancy:
+	default:
+		return errors.Errorf("unknown Elasticsearch redundancy policy %q, possible values: %s, %s, %s, %s", policy,
+			esv1.FullRedundancy, esv1.MultipleRedundancy, esv1.SingleRedundancy, esv1.ZeroRedundancy)
+	}
+
+	if existing := spec.Elasticsearch.Existing; existing != nil && strings.TrimSpace(existing.Name) == "" {
+		return errors.New("the name of the existing Elasticsearch cluster must not be empty")
+	}
+
+	if shards := spec.EsNumShards; shards != nil && *shards < 0 {
+		return errors.Errorf("the number of Elasticsearch shards must not be negative, got %d", *shards)
+	}
+
+	if replicas := spec.EsNumReplicas; replicas != nil && *replicas < 0 {
+		return errors.Errorf("the number of Elasticsearch replicas must not be negative, got %d", *replicas)
+	}
+
+	if bulk := spec.EsBulk; bulk != nil {
+		if spec.Type != v1.JaegerESStorage {
+			return errors.Errorf("storage.esBulk can't be used with the %q storage", spec.Type)
+		}
+		for name, value := range map[string]*int32{"size": bulk.Size, "workers": bulk.Workers, "actions": bulk.Actions} {
+			if value != nil && *value <= 0 {
+				return errors.Errorf("storage.esBulk.%s has to be a positive number, got %d", name, *value)
+			}
+		}
+		if bulk.FlushInterval != "" {
+			if _, err := time.ParseDuration(bulk.FlushInterval); err != nil {
+				return errors.Wrap(err, "failed to parse storage.esBulk.flushInterval to time.Duration")
+			}
+		}
+	}
+
+	for name, ttl := range map[string]string{
+		"storage.cassandraCreateSchema.traceTTL":        spec.CassandraCreateSchema.TraceTTL,
+		"storage.cassandraCreateSchema.dependenciesTTL": spec.CassandraCreateSchema.DependenciesTTL,
+	} {
+		if ttl == "" {
+			continue
+		}
+		if _, err := CassandraTTLSeconds(ttl); err != nil {
+			return errors.Wrapf(err, "failed to parse %s as a duration or a number of seconds", name)
+		}
+	}
+
+	if cassandra := spec.Cassandra; len(cassandra.Servers) > 0 || cassandra.Port != nil {
+		if spec.Type != v1.JaegerCassandraStorage {
+			return errors.Errorf("storage.cassandra.servers and storage.cassandra.port can't be used with the %q storage", spec.Type)
+		}
+		for _, server := range cassandra.Servers {
+			if strings.TrimSpace(server) == "" {
+				return errors.New("storage.cassandra.servers must not contain empty hosts")
+			}
+		}
+	}
+
+	if cassandra := spec.Cassandra; cassandra.ConnectionsPerHost != nil || cassandra.MaxRetryAttempts != nil {
+		if spec.Type != v1.JaegerCassandraStorage {
+			return errors.Errorf("the storage.cassandra tuning settings can't be used with the %q storage", spec.Type)
+		}
+		if connections := cassandra.ConnectionsPerHost; connections != nil && *connections <= 0 {
+			return errors.Errorf("storage.cassandra.connectionsPerHost has to be a positive number, got %d", *connections)
+		}
+		if retries := cassandra.MaxRetryAttempts; retries != nil && *retries < 0 {
+			return errors.Errorf("storage.cassandra.maxRetryAttempts must not be negative, got %d", *retries)
+		}
+	}
+
+	if archive := spec.ArchiveStorage; archive != nil {
+		if !v1.ArchiveStorageSupported(spec.Type) {
+			return errors.Errorf("storage.archiveStorage can't be used with the %q storage, possible values: %s, %s", spec.Type,
+				v1.JaegerESStorage, v1.JaegerCassandraStorage)
+		}
+		if archive.Type != "" && archive.Type != spec.Type {
+			return errors.Errorf("the archive storage type %q doesn't match the storage type %q", archive.Type, spec.Type)
+		}
+		if err := validateArchiveStorageOptions(spec); err != nil {
+			return err
+		}
+	}
+
+	if parallelism := spec.Dependencies.Parallelism; parallelism != nil && *parallelism <= 0 {
+		return errors.Errorf("the dependencies job's parallelism has to be a positive number, got %d", *parallelism)
+	}
+
+	return nil
+}
+
+// validateArchiveStorageOptions makes sure that the archive storage knows where to find its backend, as the defaults
+// point to a local instance. The Elasticsearch cluster provisioned by the operator is also used for the archive.
+func validateArchiveStorageOptions(spec v1.JaegerStorageSpec) error {
+	required := []string{"servers", "keyspace"}
+	if spec.Type == v1.JaegerESStorage {
+		if ShouldDeployElasticsearch(spec) {
+			return nil
+		}
+		required = []string{"server-urls"}
+	}
+
+	prefix := spec.Type.OptionsPrefix() + "-archive."
+	archiveOpts := spec.ArchiveStorage.Options.Map()
+	sOpts := spec.Options.Map()
+	for _, option := range required {
+		_, inArchive := archiveOpts[option]
+		_, inStorage := sOpts[prefix+option]
+		if !inArchive && !inStorage {
+			return errors.Errorf("the archive storage option %q has to be set, either in storage.archiveStorage.options or as the %q storage option", option, prefix+option)
+		}
+	}
+	return nil
+}
+
+// validateResources makes sure that the given quantities aren't negative and that no request exceeds its limit
+func validateResources(resources corev1.ResourceRequirements) error {
+	for _, list := range []corev1.ResourceList{resources.Limits, resources.Requests} {
+		for name, quantity := range list {
+			if quantity.Sign() < 0 {
+				return errors.Errorf("the quantity for %s must not be negative, got %s", name, quantity.String())
+			}
+		}
+	}
+	for name, request := range resources.Requests {
+		if limit, ok := resources.Limits[name]; ok && request.Cmp(limit) > 0 {
+			return errors.Errorf("the request for %s (%s) exceeds its limit (%s)", name, request.String(), limit.String())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+	esv1 "github.com/jaegertracing/jaeger-operator/pkg/storage/elasticsearch/v1"
+)
+
+func TestValidate(t *testing.T) {
+	int32p := func(v int32) *int32 { return &v }
+	port := 9042
+	memoryLimit := corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")}
+
+	for _, tt := range []struct {
+		name  string
+		spec  v1.JaegerStorageSpec
+		valid bool
+	}{
+		{name: "defaults", valid: true},
+		{name: "rollover read ttl", spec: v1.JaegerStorageSpec{EsRollover: v1.JaegerEsRolloverSpec{ReadTTL: "48h"}}, valid: true},
+		{name: "rollover read ttl in days", spec: v1.JaegerStorageSpec{EsRollover: v1.JaegerEsRolloverSpec{ReadTTL: "2d"}}, valid: false},
+		{name: "rollover step resources", spec: v1.JaegerStorageSpec{EsRollover: v1.JaegerEsRolloverSpec{StepResources: v1.JaegerEsRolloverStepResourcesSpec{
+			Lookback: corev1.ResourceRequirements{Limits: memoryLimit, Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")}},
+		}}}, valid: true},
+		{name: "rollover step request exceeding its limit", spec: v1.JaegerStorageSpec{EsRollover: v1.JaegerEsRolloverSpec{StepResources: v1.JaegerEsRolloverStepResourcesSpec{
+			Lookback: corev1.ResourceRequirements{Limits: memoryLimit, Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}},
+		}}}, valid: false},
+		{name: "negative rollover step resources", spec: v1.JaegerStorageSpec{EsRollover: v1.JaegerEsRolloverSpec{StepResources: v1.JaegerEsRolloverStepResourcesSpec{
+			Init: corev1.ResourceRequirements{Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("-1")}},
+		}}}, valid: false},
+		{name: "redundancy policy", spec: v1.JaegerStorageSpec{Elasticsearch: v1.ElasticsearchSpec{RedundancyPolicy: esv1.SingleRedundancy}}, valid: true},
+		{name: "unknown redundancy policy", spec: v1.JaegerStorageSpec{Elasticsearch: v1.ElasticsearchSpec{RedundancyPolicy: "TripleRedundancy"}}, valid: false},
+		{name: "existing elasticsearch", spec: v1.JaegerStorageSpec{Elasticsearch: v1.ElasticsearchSpec{
+			Existing: &v1.ElasticsearchReference{Name: "elasticsearch", Namespace: "openshift-logging"},
+		}}, valid: true},
+		{name: "existing elasticsearch without name", spec: v1.JaegerStorageSpec{Elasticsearch: v1.ElasticsearchSpec{
+			Existing: &v1.ElasticsearchReference{Namespace: "openshift-logging"},
+		}}, valid: false},
+		{name: "index shards and replicas", spec: v1.JaegerStorageSpec{EsNumShards: int32p(0), EsNumReplicas: int32p(0)}, valid: true},
+		{name: "negative index shards", spec: v1.JaegerStorageSpec{EsNumShards: int32p(-1)}, valid: false},
+		{name: "negative index replicas", spec: v1.JaegerStorageSpec{EsNumReplicas: int32p(-1)}, valid: false},
+		{name: "es bulk", spec: v1.JaegerStorageSpec{Type: v1.JaegerESStorage, EsBulk: &v1.JaegerEsBulkSpec{Workers: int32p(4), FlushInterval: "200ms"}}, valid: true},
+		{name: "es bulk flush interval without unit", spec: v1.JaegerStorageSpec{Type: v1.JaegerESStorage, EsBulk: &v1.JaegerEsBulkSpec{FlushInterval: "200"}}, valid: false},
+		{name: "zero es bulk workers", spec: v1.JaegerStorageSpec{Type: v1.JaegerESStorage, EsBulk: &v1.JaegerEsBulkSpec{Workers: int32p(0)}}, valid: false},
+		{name: "es bulk for cassandra", spec: v1.JaegerStorageSpec{Type: v1.JaegerCassandraStorage, EsBulk: &v1.JaegerEsBulkSpec{Workers: int32p(4)}}, valid: false},
+		{name: "cassandra ttls", spec: v1.JaegerStorageSpec{CassandraCreateSchema: v1.JaegerCassandraCreateSchemaSpec{TraceTTL: "168h", DependenciesTTL: "604800"}}, valid: true},
+		{name: "cassandra ttl in days", spec: v1.JaegerStorageSpec{CassandraCreateSchema: v1.JaegerCassandraCreateSchemaSpec{DependenciesTTL: "7d"}}, valid: false},
+		{name: "cassandra servers", spec: v1.JaegerStorageSpec{Type: v1.JaegerCassandraStorage, Cassandra: v1.JaegerCassandraSpec{
+			Servers: []string{"cassandra-0", "cassandra-1"},
+			Port:    &port,
+		}}, valid: true},
+		{name: "empty cassandra server", spec: v1.JaegerStorageSpec{Type: v1.JaegerCassandraStorage, Cassandra: v1.JaegerCassandraSpec{Servers: []string{"cassandra-0", " "}}}, valid: false},
+		{name: "cassandra servers for elasticsearch", spec: v1.JaegerStorageSpec{Type: v1.JaegerESStorage, Cassandra: v1.JaegerCassandraSpec{Servers: []string{"cassandra-0"}}}, valid: false},
+		{name: "cassandra port for the memory storage", spec: v1.JaegerStorageSpec{Type: v1.JaegerMemoryStorage, Cassandra: v1.JaegerCassandraSpec{Port: &port}}, valid: false},
+		{name: "cassandra tuning", spec: v1.JaegerStorageSpec{Type: v1.JaegerCassandraStorage, Cassandra: v1.JaegerCassandraSpec{
+			ConnectionsPerHost: int32p(2),
+			MaxRetryAttempts:   int32p(0),
+		}}, valid: true},
+		{name: "negative cassandra retries", spec: v1.JaegerStorageSpec{Type: v1.JaegerCassandraStorage, Cassandra: v1.JaegerCassandraSpec{MaxRetryAttempts: int32p(-1)}}, valid: false},
+		{name: "zero cassandra connections", spec: v1.JaegerStorageSpec{Type: v1.JaegerCassandraStorage, Cassandra: v1.JaegerCassandraSpec{ConnectionsPerHost: int32p(0)}}, valid: false},
+		{name: "cassandra tuning for elasticsearch", spec: v1.JaegerStorageSpec{Type: v1.JaegerESStorage, Cassandra: v1.JaegerCassandraSpec{ConnectionsPerHost: int32p(2)}}, valid: false},
+		{name: "archive for the provisioned elasticsearch", spec: v1.JaegerStorageSpec{Type: v1.JaegerESStorage, ArchiveStorage: &v1.JaegerArchiveStorageSpec{}}, valid: true},
+		{name: "archive for cassandra", spec: v1.JaegerStorageSpec{Type: v1.JaegerCassandraStorage, ArchiveStorage: &v1.JaegerArchiveStorageSpec{
+			Type:    v1.JaegerCassandraStorage,
+			Options: v1.NewOptions(map[string]interface{}{"servers": "cassandra", "keyspace": "jaeger_archive"}),
+		}}, valid: true},
+		{name: "archive of another type", spec: v1.JaegerStorageSpec{Type: v1.JaegerESStorage, ArchiveStorage: &v1.JaegerArchiveStorageSpec{Type: v1.JaegerCassandraStorage}}, valid: false},
+		{name: "archive for the memory storage", spec: v1.JaegerStorageSpec{ArchiveStorage: &v1.JaegerArchiveStorageSpec{}}, valid: false},
+		{name: "archive for kafka", spec: v1.JaegerStorageSpec{Type: v1.JaegerKafkaStorage, ArchiveStorage: &v1.JaegerArchiveStorageSpec{}}, valid: false},
+		{name: "archive for an external elasticsearch", spec: v1.JaegerStorageSpec{
+			Type:           v1.JaegerESStorage,
+			Options:        v1.NewOptions(map[string]interface{}{"es.server-urls": "http://es:9200", "es-archive.server-urls": "http://archive:9200"}),
+			ArchiveStorage: &v1.JaegerArchiveStorageSpec{},
+		}, valid: true},
+		{name: "archive for an external elasticsearch without urls", spec: v1.JaegerStorageSpec{
+			Type:           v1.JaegerESStorage,
+			Options:        v1.NewOptions(map[string]interface{}{"es.server-urls": "http://es:9200"}),
+			ArchiveStorage: &v1.JaegerArchiveStorageSpec{},
+		}, valid: false},
+		{name: "archive for cassandra without keyspace", spec: v1.JaegerStorageSpec{Type: v1.JaegerCassandraStorage, ArchiveStorage: &v1.JaegerArchiveStorageSpec{
+			Options: v1.NewOptions(map[string]interface{}{"servers": "cassandra"}),
+		}}, valid: false},
+		{name: "dependencies parallelism", spec: v1.JaegerStorageSpec{Dependencies: v1.JaegerDependenciesSpec{Parallelism: int32p(4)}}, valid: true},
+		{name: "zero dependencies parallelism", spec: v1.JaegerStorageSpec{Dependencies: v1.JaegerDependenciesSpec{Parallelism: int32p(0)}}, valid: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.spec)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
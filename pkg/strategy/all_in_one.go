@@ -19,6 +19,7 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/deployment"
 	"github.com/jaegertracing/jaeger-operator/pkg/ingress"
 	"github.com/jaegertracing/jaeger-operator/pkg/inject"
+	"github.com/jaegertracing/jaeger-operator/pkg/networkpolicy"
 	"github.com/jaegertracing/jaeger-operator/pkg/route"
 	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 )
@@ -86,10 +87,19 @@ func newAllInOneStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 				c.consoleLinks = append(c.consoleLinks, *link)
 			}
 		}
+		if cr := route.NewCollectorRoute(jaeger).Get(); nil != cr {
+			c.routes = append(c.routes, *cr)
+		}
 	} else {
 		if q := ingress.NewQueryIngress(jaeger).Get(); nil != q {
 			c.ingresses = append(c.ingresses, *q)
 		}
+		if ci := ingress.NewCollectorIngress(jaeger).Get(); nil != ci {
+			c.ingresses = append(c.ingresses, *ci)
+		}
+		if zi := ingress.NewZipkinIngress(jaeger).Get(); nil != zi {
+			c.ingresses = append(c.ingresses, *zi)
+		}
 	}
 
 	if isBoolTrue(jaeger.Spec.Storage.Dependencies.Enabled) {
@@ -114,6 +124,9 @@ func newAllInOneStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 
 	c.dependencies = storage.Dependencies(jaeger)
 
+	// add the network policies, based on the final workloads
+	c.networkPolicies = networkpolicy.Get(jaeger, c.deployments, c.daemonSets, c.elasticsearches)
+
 	return c
 }
 
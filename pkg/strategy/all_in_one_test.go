@@ -212,3 +212,18 @@ func testEsIndexCleaner(t *testing.T, fce func(jaeger *v1.Jaeger) S) {
 		}
 	}
 }
+
+func TestAllInOneExposesCollector(t *testing.T) {
+	enabled := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestAllInOneExposesCollector"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
+	jaeger.Spec.Ingress.Collector.Enabled = &enabled
+
+	c := newAllInOneStrategy(context.Background(), jaeger)
+
+	names := []string{}
+	for _, i := range c.Ingresses() {
+		names = append(names, i.Name)
+	}
+	assert.ElementsMatch(t, []string{"TestAllInOneExposesCollector-query", "TestAllInOneExposesCollector-collector"}, names)
+}
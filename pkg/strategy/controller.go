@@ -22,8 +22,10 @@ const (
 )
 
 var (
-	defaultEsMemory     = resource.MustParse("16Gi")
-	defaultEsCPURequest = resource.MustParse("1")
+	defaultEsMemory          = resource.MustParse("16Gi")
+	defaultEsCPURequest      = resource.MustParse("1")
+	defaultEsProxyMemory     = resource.MustParse("64Mi")
+	defaultEsProxyCPURequest = resource.MustParse("100m")
 )
 
 // For returns the appropriate Strategy for the given Jaeger instance
@@ -152,6 +154,9 @@ func normalizeElasticsearch(spec *v1.ElasticsearchSpec) {
 	if spec.NodeCount == 0 {
 		spec.NodeCount = 3
 	}
+	if spec.MasterNodes != nil && spec.MasterNodes.NodeCount == 0 {
+		spec.MasterNodes.NodeCount = 3
+	}
 	if spec.RedundancyPolicy == "" {
 		if spec.NodeCount == 1 {
 			spec.RedundancyPolicy = esv1.ZeroRedundancy
@@ -170,6 +175,17 @@ func normalizeElasticsearch(spec *v1.ElasticsearchSpec) {
 			},
 		}
 	}
+	if spec.ProxyResources == nil {
+		spec.ProxyResources = &corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: defaultEsProxyMemory,
+			},
+			Requests: corev1.ResourceList{
+				corev1.ResourceMemory: defaultEsProxyMemory,
+				corev1.ResourceCPU:    defaultEsProxyCPURequest,
+			},
+		}
+	}
 }
 
 func normalizeRollover(spec *v1.JaegerEsRolloverSpec) {
@@ -185,7 +201,8 @@ func normalizeUI(spec *v1.JaegerSpec) {
 			uiOpts = m
 		}
 	}
-	enableArchiveButton(uiOpts, spec.Storage.Options.Map())
+	storageOpts := spec.Storage.EffectiveOptions()
+	enableArchiveButton(uiOpts, storageOpts.Map())
 	disableDependenciesTab(uiOpts, spec.Storage.Type, spec.Storage.Dependencies.Enabled)
 	enableDocumentationLink(uiOpts, spec)
 	enableLogOut(uiOpts, spec)
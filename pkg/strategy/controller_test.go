@@ -4,9 +4,12 @@ import (
 	"context"
 	"testing"
 
+	osconsolev1 "github.com/openshift/api/console/v1"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
@@ -36,6 +39,67 @@ func TestNewControllerForProduction(t *testing.T) {
 	assert.Equal(t, ctrl.Type(), v1.DeploymentStrategyProduction)
 }
 
+func TestControllerOwnerReferences(t *testing.T) {
+	viper.Set("platform", v1.FlagPlatformOpenShift)
+	defer viper.Reset()
+
+	trueVar := true
+	for _, tt := range []struct {
+		name    string
+		prepare func(jaeger *v1.Jaeger)
+	}{
+		{name: "all-in-one", prepare: func(jaeger *v1.Jaeger) {
+			jaeger.Spec.Agent.Strategy = "daemonset"
+		}},
+		{name: "production", prepare: func(jaeger *v1.Jaeger) {
+			jaeger.Spec.Strategy = v1.DeploymentStrategyProduction
+			jaeger.Spec.Storage.Type = v1.JaegerESStorage
+			jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{
+				"es.server-urls": "http://elasticsearch:9200",
+				"es.use-aliases": "true",
+			})
+			jaeger.Spec.Storage.Dependencies.Enabled = &trueVar
+		}},
+		{name: "streaming", prepare: func(jaeger *v1.Jaeger) {
+			jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+			jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+			jaeger.APIVersion = "jaegertracing.io/v1"
+			jaeger.Kind = "Jaeger"
+			jaeger.UID = "my-uid"
+			tt.prepare(jaeger)
+
+			s := For(context.TODO(), jaeger)
+			objs := s.All()
+			for _, dep := range s.Dependencies() {
+				objs = append(objs, dep.DeepCopy())
+			}
+			assert.NotEmpty(t, objs)
+
+			expected := []metav1.OwnerReference{{
+				APIVersion:         "jaegertracing.io/v1",
+				Kind:               "Jaeger",
+				Name:               "my-instance",
+				UID:                "my-uid",
+				Controller:         &trueVar,
+				BlockOwnerDeletion: &trueVar,
+			}}
+			for _, obj := range objs {
+				// console links are cluster-scoped and can't be owned by a namespaced object
+				if _, ok := obj.(*osconsolev1.ConsoleLink); ok {
+					continue
+				}
+				accessor, err := meta.Accessor(obj)
+				assert.NoError(t, err)
+				assert.Equal(t, expected, accessor.GetOwnerReferences(), "%T %s", obj, accessor.GetName())
+			}
+		})
+	}
+}
+
 func TestUnknownStorage(t *testing.T) {
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
 	jaeger.Spec.Storage.Type = "unknown"
@@ -267,19 +331,27 @@ func TestNormalizeElasticsearch(t *testing.T) {
 		Limits:   corev1.ResourceList{corev1.ResourceMemory: defaultEsMemory},
 		Requests: corev1.ResourceList{corev1.ResourceMemory: defaultEsMemory, corev1.ResourceCPU: defaultEsCPURequest},
 	}
+	defProxyResources := &corev1.ResourceRequirements{
+		Limits:   corev1.ResourceList{corev1.ResourceMemory: defaultEsProxyMemory},
+		Requests: corev1.ResourceList{corev1.ResourceMemory: defaultEsProxyMemory, corev1.ResourceCPU: defaultEsProxyCPURequest},
+	}
 	tests := []struct {
 		underTest v1.ElasticsearchSpec
 		expected  v1.ElasticsearchSpec
 	}{
 		{underTest: v1.ElasticsearchSpec{},
-			expected: v1.ElasticsearchSpec{NodeCount: 3, RedundancyPolicy: "SingleRedundancy", Resources: defResources},
+			expected: v1.ElasticsearchSpec{NodeCount: 3, RedundancyPolicy: "SingleRedundancy", Resources: defResources, ProxyResources: defProxyResources},
 		},
 		{underTest: v1.ElasticsearchSpec{NodeCount: 1},
-			expected: v1.ElasticsearchSpec{NodeCount: 1, RedundancyPolicy: "ZeroRedundancy", Resources: defResources}},
+			expected: v1.ElasticsearchSpec{NodeCount: 1, RedundancyPolicy: "ZeroRedundancy", Resources: defResources, ProxyResources: defProxyResources}},
 		{underTest: v1.ElasticsearchSpec{NodeCount: 3, RedundancyPolicy: "FullRedundancy"},
-			expected: v1.ElasticsearchSpec{NodeCount: 3, RedundancyPolicy: "FullRedundancy", Resources: defResources}},
+			expected: v1.ElasticsearchSpec{NodeCount: 3, RedundancyPolicy: "FullRedundancy", Resources: defResources, ProxyResources: defProxyResources}},
 		{underTest: v1.ElasticsearchSpec{Image: "bla", NodeCount: 150, RedundancyPolicy: "ZeroRedundancy", Resources: &corev1.ResourceRequirements{}},
-			expected: v1.ElasticsearchSpec{Image: "bla", NodeCount: 150, RedundancyPolicy: "ZeroRedundancy", Resources: &corev1.ResourceRequirements{}}},
+			expected: v1.ElasticsearchSpec{Image: "bla", NodeCount: 150, RedundancyPolicy: "ZeroRedundancy", Resources: &corev1.ResourceRequirements{}, ProxyResources: defProxyResources}},
+		{underTest: v1.ElasticsearchSpec{NodeCount: 1, ProxyResources: &corev1.ResourceRequirements{}},
+			expected: v1.ElasticsearchSpec{NodeCount: 1, RedundancyPolicy: "ZeroRedundancy", Resources: defResources, ProxyResources: &corev1.ResourceRequirements{}}},
+		{underTest: v1.ElasticsearchSpec{NodeCount: 2, MasterNodes: &v1.ElasticsearchMasterNodesSpec{}},
+			expected: v1.ElasticsearchSpec{NodeCount: 2, RedundancyPolicy: "SingleRedundancy", Resources: defResources, ProxyResources: defProxyResources, MasterNodes: &v1.ElasticsearchMasterNodesSpec{NodeCount: 3}}},
 	}
 	for _, test := range tests {
 		normalizeElasticsearch(&test.underTest)
@@ -287,6 +359,56 @@ func TestNormalizeElasticsearch(t *testing.T) {
 	}
 }
 
+func TestArchiveStorageForQuery(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestArchiveStorageForQuery"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyProduction
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"es.server-urls": "http://elasticsearch:9200"})
+	jaeger.Spec.Storage.ArchiveStorage = &v1.JaegerArchiveStorageSpec{
+		Options: v1.NewOptions(map[string]interface{}{"server-urls": "http://archive:9200"}),
+	}
+
+	found := false
+	for _, dep := range For(context.TODO(), jaeger).Deployments() {
+		container := dep.Spec.Template.Spec.Containers[0]
+		if container.Name == "jaeger-query" {
+			found = true
+			assert.Contains(t, container.Args, "--es-archive.enabled=true")
+			assert.Contains(t, container.Args, "--es-archive.server-urls=http://archive:9200")
+		}
+	}
+	assert.True(t, found)
+	assert.Equal(t, map[string]string{"es.server-urls": "http://elasticsearch:9200"}, jaeger.Spec.Storage.Options.Map())
+
+	uiOpts, err := jaeger.Spec.UI.Options.GetMap()
+	assert.NoError(t, err)
+	assert.Equal(t, true, uiOpts["archiveEnabled"])
+}
+
+func TestIndexTemplatesFlagForCollectorAndAllInOne(t *testing.T) {
+	falseVar := false
+	for _, strategy := range []v1.DeploymentStrategy{v1.DeploymentStrategyAllInOne, v1.DeploymentStrategyProduction} {
+		t.Run(string(strategy), func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestIndexTemplatesFlagForCollectorAndAllInOne"})
+			jaeger.Spec.Strategy = strategy
+			jaeger.Spec.Storage.Type = v1.JaegerESStorage
+			jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"es.server-urls": "http://elasticsearch:9200"})
+			jaeger.Spec.Storage.CreateIndexTemplates = &falseVar
+
+			found := false
+			for _, dep := range For(context.TODO(), jaeger).Deployments() {
+				container := dep.Spec.Template.Spec.Containers[0]
+				if container.Name == "jaeger" || container.Name == "jaeger-collector" {
+					found = true
+					assert.Contains(t, container.Args, "--es.create-index-templates=false")
+				}
+			}
+			assert.True(t, found)
+			assert.Equal(t, map[string]string{"es.server-urls": "http://elasticsearch:9200"}, jaeger.Spec.Storage.Options.Map())
+		})
+	}
+}
+
 func TestNormalizeUI(t *testing.T) {
 	tests := []struct {
 		j        *v1.JaegerSpec
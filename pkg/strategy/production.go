@@ -23,6 +23,7 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/deployment"
 	"github.com/jaegertracing/jaeger-operator/pkg/ingress"
 	"github.com/jaegertracing/jaeger-operator/pkg/inject"
+	"github.com/jaegertracing/jaeger-operator/pkg/networkpolicy"
 	"github.com/jaegertracing/jaeger-operator/pkg/route"
 	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 )
@@ -96,6 +97,9 @@ func newProductionStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 		if q := ingress.NewQueryIngress(jaeger).Get(); nil != q {
 			c.ingresses = append(c.ingresses, *q)
 		}
+		if zi := ingress.NewZipkinIngress(jaeger).Get(); nil != zi {
+			c.ingresses = append(c.ingresses, *zi)
+		}
 	}
 
 	// add autoscalers
@@ -125,6 +129,7 @@ func newProductionStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 
 	// prepare the deployments, which may get changed by the elasticsearch routine
 	cDep := collector.Get()
+	canaryDep := collector.Canary()
 	queryDep := inject.OAuthProxy(jaeger, query.Get())
 	if jaeger.Spec.Query.TracingEnabled == nil || *jaeger.Spec.Query.TracingEnabled == true {
 		queryDep = inject.Sidecar(jaeger, queryDep)
@@ -143,7 +148,11 @@ func newProductionStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 		for i := range esRollover {
 			jobs = append(jobs, &esRollover[i].Spec.JobTemplate.Spec.Template.Spec)
 		}
-		autoProvisionElasticsearch(&c, jaeger, jobs, []*appsv1.Deployment{queryDep, cDep})
+		deps := []*appsv1.Deployment{queryDep, cDep}
+		if canaryDep != nil {
+			deps = append(deps, canaryDep)
+		}
+		autoProvisionElasticsearch(&c, jaeger, jobs, deps)
 	}
 
 	// the index cleaner ES job, which may have been changed by the ES self-provisioning routine
@@ -156,6 +165,12 @@ func newProductionStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 
 	// add the deployments, which may have been changed by the ES self-provisioning routine
 	c.deployments = []appsv1.Deployment{*cDep, *queryDep}
+	if canaryDep != nil {
+		c.deployments = append(c.deployments, *canaryDep)
+	}
+
+	// add the network policies, based on the final workloads
+	c.networkPolicies = networkpolicy.Get(jaeger, c.deployments, c.daemonSets, c.elasticsearches)
 
 	return c
 }
@@ -168,5 +183,8 @@ func autoProvisionElasticsearch(manifest *S, jaeger *v1.Jaeger, curatorPods []*c
 	for _, pod := range curatorPods {
 		es.InjectSecretsConfiguration(pod)
 	}
-	manifest.elasticsearches = append(manifest.elasticsearches, *es.Elasticsearch())
+	// an existing cluster is owned by someone else, so we don't include it in our manifest
+	if _, ok := storage.ExistingElasticsearch(jaeger); !ok {
+		manifest.elasticsearches = append(manifest.elasticsearches, *es.Elasticsearch())
+	}
 }
@@ -123,6 +123,16 @@ func TestAutoscaleForProduction(t *testing.T) {
 	assert.Len(t, c.HorizontalPodAutoscalers(), 1)
 }
 
+func TestNetworkPoliciesForProduction(t *testing.T) {
+	enabled := true
+	j := v1.NewJaeger(types.NamespacedName{Name: "TestNetworkPoliciesForProduction"})
+	assert.Len(t, newProductionStrategy(context.Background(), j).NetworkPolicies(), 0)
+
+	j.Spec.NetworkPolicy.Enabled = &enabled
+	c := newProductionStrategy(context.Background(), j)
+	assert.Len(t, c.NetworkPolicies(), len(c.Deployments()))
+}
+
 func assertDeploymentsAndServicesForProduction(t *testing.T, instance *v1.Jaeger, s S, hasDaemonSet bool, hasOAuthProxy bool, hasConfigMap bool) {
 	name := instance.Name
 	expectedNumObjs := 7
@@ -226,6 +236,55 @@ func TestElasticsearchInject(t *testing.T) {
 	assertEsInjectSecrets(t, c.cronJobs[2].Spec.JobTemplate.Spec.Template.Spec)
 }
 
+func TestEsIndexCleanerWithRolloverForProduction(t *testing.T) {
+	j := v1.NewJaeger(types.NamespacedName{Name: "TestEsIndexCleanerWithRolloverForProduction"})
+	j.Spec.Storage.Type = v1.JaegerESStorage
+	verdad := true
+	days := 7
+	j.Spec.Storage.EsIndexCleaner.Enabled = &verdad
+	j.Spec.Storage.EsIndexCleaner.NumberOfDays = &days
+	j.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"es.use-aliases": "TRUE"})
+
+	c := newProductionStrategy(context.Background(), j)
+
+	var found bool
+	for _, cj := range c.CronJobs() {
+		if strings.HasSuffix(cj.Name, "-es-index-cleaner") {
+			found = true
+			// the self-provisioning routine must not drop the rollover mode
+			assert.Contains(t, cj.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "ROLLOVER", Value: "true"})
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestExistingElasticsearchNotProvisioned(t *testing.T) {
+	j := v1.NewJaeger(types.NamespacedName{Name: "TestExistingElasticsearchNotProvisioned"})
+	j.Spec.Storage.Type = v1.JaegerESStorage
+	j.Spec.Storage.Elasticsearch.Existing = &v1.ElasticsearchReference{Name: "elasticsearch", Namespace: "openshift-logging"}
+
+	c := newProductionStrategy(context.Background(), j)
+
+	assert.Len(t, c.Elasticsearches(), 0)
+	for _, dep := range c.Deployments() {
+		assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--es.server-urls=https://elasticsearch.openshift-logging.svc:9200", dep.Name)
+	}
+}
+
+func TestCollectorCanaryForProduction(t *testing.T) {
+	j := v1.NewJaeger(types.NamespacedName{Name: "TestCollectorCanaryForProduction"})
+	j.Spec.Collector.Canary = &v1.JaegerCollectorCanarySpec{Image: "jaegertracing/jaeger-collector:canary"}
+
+	c := newProductionStrategy(context.Background(), j)
+
+	names := []string{}
+	for _, dep := range c.Deployments() {
+		names = append(names, dep.Name)
+	}
+	assert.Contains(t, names, "TestCollectorCanaryForProduction-collector")
+	assert.Contains(t, names, "TestCollectorCanaryForProduction-collector-canary")
+}
+
 func assertEsInjectSecrets(t *testing.T, p corev1.PodSpec) {
 	assert.Equal(t, 1, len(p.Volumes))
 	assert.Equal(t, "certs", p.Volumes[0].Name)
@@ -239,3 +298,26 @@ func assertEsInjectSecrets(t *testing.T, p corev1.PodSpec) {
 	assert.Contains(t, envs, "ES_TLS_KEY")
 	assert.Contains(t, envs, "ES_TLS_CERT")
 }
+
+func TestServiceAccountPerComponentForProduction(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestServiceAccountPerComponentForProduction"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyProduction
+	jaeger.Spec.ServiceAccountPerComponent = true
+
+	c := newProductionStrategy(context.Background(), jaeger)
+
+	accounts := map[string]bool{}
+	for _, sa := range c.Accounts() {
+		accounts[sa.Name] = true
+	}
+	assert.Len(t, accounts, 6)
+
+	for _, dep := range c.Deployments() {
+		assert.True(t, accounts[dep.Spec.Template.Spec.ServiceAccountName], dep.Name)
+	}
+	for _, ds := range c.DaemonSets() {
+		assert.True(t, accounts[ds.Spec.Template.Spec.ServiceAccountName], ds.Name)
+	}
+	assert.True(t, accounts[fmt.Sprintf("%s-collector", jaeger.Name)])
+	assert.True(t, accounts[fmt.Sprintf("%s-query", jaeger.Name)])
+}
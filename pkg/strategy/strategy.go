@@ -8,6 +8,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/api/networking/v1beta1"
 	rbac "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,6 +37,7 @@ type S struct {
 	ingresses                []v1beta1.Ingress
 	kafkas                   []kafkav1beta1.Kafka
 	kafkaUsers               []kafkav1beta1.KafkaUser
+	networkPolicies          []networkingv1.NetworkPolicy
 	routes                   []osv1.Route
 	services                 []corev1.Service
 	secrets                  []corev1.Secret
@@ -135,6 +137,12 @@ func (s S) WithKafkaUsers(k []kafkav1beta1.KafkaUser) S {
 	return s
 }
 
+// WithNetworkPolicies returns the strategy with the given list of network policies
+func (s S) WithNetworkPolicies(n []networkingv1.NetworkPolicy) S {
+	s.networkPolicies = n
+	return s
+}
+
 // WithServices returns the strategy with the given list of routes
 func (s S) WithServices(svcs []corev1.Service) S {
 	s.services = svcs
@@ -207,6 +215,11 @@ func (s S) KafkaUsers() []kafkav1beta1.KafkaUser {
 	return s.kafkaUsers
 }
 
+// NetworkPolicies returns the list of network policies for this strategy
+func (s S) NetworkPolicies() []networkingv1.NetworkPolicy {
+	return s.networkPolicies
+}
+
 // Routes returns the list of routes for this strategy. This might be platform-dependent
 func (s S) Routes() []osv1.Route {
 	return s.routes
@@ -274,6 +287,10 @@ func (s S) All() []runtime.Object {
 		ret = append(ret, o.DeepCopy())
 	}
 
+	for _, o := range s.networkPolicies {
+		ret = append(ret, o.DeepCopy())
+	}
+
 	for _, o := range s.routes {
 		ret = append(ret, o.DeepCopy())
 	}
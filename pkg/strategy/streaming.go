@@ -24,6 +24,7 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/ingress"
 	"github.com/jaegertracing/jaeger-operator/pkg/inject"
 	"github.com/jaegertracing/jaeger-operator/pkg/kafka"
+	"github.com/jaegertracing/jaeger-operator/pkg/networkpolicy"
 	"github.com/jaegertracing/jaeger-operator/pkg/route"
 	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
@@ -74,6 +75,8 @@ func newStreamingStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 
 	_, pfound := jaeger.Spec.Collector.Options.GenericMap()["kafka.producer.brokers"]
 	_, cfound := jaeger.Spec.Ingester.Options.GenericMap()["kafka.consumer.brokers"]
+	pfound = pfound || jaeger.Spec.Collector.KafkaBrokersFrom != nil
+	cfound = cfound || jaeger.Spec.Ingester.KafkaBrokersFrom != nil
 	provisioned := jaeger.Annotations[v1.AnnotationProvisionedKafkaKey] == v1.AnnotationProvisionedKafkaValue
 
 	// we provision a Kafka when no brokers have been set, or, when we are not in the first run,
@@ -109,6 +112,9 @@ func newStreamingStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 		if q := ingress.NewQueryIngress(jaeger).Get(); nil != q {
 			manifest.ingresses = append(manifest.ingresses, *q)
 		}
+		if zi := ingress.NewZipkinIngress(jaeger).Get(); nil != zi {
+			manifest.ingresses = append(manifest.ingresses, *zi)
+		}
 	}
 
 	// add autoscalers
@@ -138,6 +144,7 @@ func newStreamingStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 
 	// prepare the deployments, which may get changed by the elasticsearch routine
 	cDep := collector.Get()
+	canaryDep := collector.Canary()
 	queryDep := inject.OAuthProxy(jaeger, query.Get())
 	if jaeger.Spec.Query.TracingEnabled == nil || *jaeger.Spec.Query.TracingEnabled == true {
 		queryDep = inject.Sidecar(jaeger, queryDep)
@@ -167,6 +174,9 @@ func newStreamingStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 		autoProvisionElasticsearch(&manifest, jaeger, jobs, deps)
 	}
 	manifest.deployments = []appsv1.Deployment{*cDep, *queryDep}
+	if canaryDep != nil {
+		manifest.deployments = append(manifest.deployments, *canaryDep)
+	}
 	if ingesterDep != nil {
 		manifest.deployments = append(manifest.deployments, *ingesterDep)
 	}
@@ -179,6 +189,9 @@ func newStreamingStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 		manifest.cronJobs = append(manifest.cronJobs, esRollover...)
 	}
 
+	// add the network policies, based on the final workloads
+	manifest.networkPolicies = networkpolicy.Get(jaeger, manifest.deployments, manifest.daemonSets, manifest.elasticsearches)
+
 	return manifest
 }
 
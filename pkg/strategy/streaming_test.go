@@ -62,6 +62,17 @@ func TestStreamingNoKafkaProvisioningWhenProducerBrokersSet(t *testing.T) {
 	assert.Len(t, c.Kafkas(), 0)
 }
 
+func TestStreamingNoKafkaProvisioningWhenBrokersFromSet(t *testing.T) {
+	name := "my-instance"
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: name})
+	jaeger.Spec.Ingester.KafkaBrokersFrom = &v1.JaegerKafkaBrokersSource{
+		SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "kafka"}, Key: "brokers"},
+	}
+	c := newStreamingStrategy(context.Background(), jaeger)
+
+	assert.Len(t, c.Kafkas(), 0)
+}
+
 func TestCreateStreamingDeploymentOnOpenShift(t *testing.T) {
 	viper.Set("platform", "openshift")
 	defer viper.Reset()
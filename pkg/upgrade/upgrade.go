@@ -10,10 +10,12 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"go.opentelemetry.io/otel/global"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 // ManagedInstances finds all the Jaeger instances for the current operator and upgrades them, if necessary
@@ -22,12 +24,18 @@ func ManagedInstances(ctx context.Context, c client.Client, reader client.Reader
 	ctx, span := tracer.Start(ctx, "ManagedInstances")
 	defer span.End()
 
+	selector, err := util.InstanceSelector()
+	if err != nil {
+		return tracing.HandleError(err, span)
+	}
+	requirements, _ := selector.Requirements()
+
 	list := &v1.JaegerList{}
 	identity := viper.GetString(v1.ConfigIdentity)
 	opts := []client.ListOption{
-		client.MatchingLabels(map[string]string{
+		client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(map[string]string{
 			v1.LabelOperatedBy: identity,
-		}),
+		}).Add(requirements...)},
 	}
 
 	if watchNamespaces := viper.GetString(v1.ConfigWatchNamespace); watchNamespaces != v1.WatchAllNamespaces {
@@ -57,6 +65,13 @@ func ManagedInstances(ctx context.Context, c client.Client, reader client.Reader
 			}).Debug("skipping CR upgrade as we are not owners")
 			continue
 		}
+		if !selector.Matches(labels.Set(j.Labels)) {
+			log.WithFields(log.Fields{
+				"instance":  j.Name,
+				"namespace": j.Namespace,
+			}).Debug("skipping CR upgrade as the instance doesn't match the instance selector")
+			continue
+		}
 		patch := client.MergeFrom(j.DeepCopy())
 		jaeger, err := ManagedInstance(ctx, c, j, latestVersion)
 		if err != nil {
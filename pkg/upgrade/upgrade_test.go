@@ -145,6 +145,36 @@ func TestSkipForNonOwnedInstances(t *testing.T) {
 	assert.Equal(t, "1.11.0", persisted.Status.Version)
 }
 
+func TestSkipForInstancesNotMatchingInstanceSelector(t *testing.T) {
+	// prepare
+	viper.Set(v1.ConfigIdentity, "the-identity")
+	viper.Set(v1.ConfigInstanceSelector, "team=payments")
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{Name: "my-instance"}
+
+	existing := v1.NewJaeger(nsn)
+	existing.Labels = map[string]string{
+		v1.LabelOperatedBy: "the-identity",
+		"team":             "checkout",
+	}
+	existing.Status.Version = "1.11.0"
+	objs := []runtime.Object{existing}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.SchemeGroupVersion, &v1.Jaeger{})
+	s.AddKnownTypes(v1.SchemeGroupVersion, &v1.JaegerList{})
+	cl := fake.NewFakeClient(objs...)
+
+	// test
+	assert.NoError(t, ManagedInstances(context.Background(), cl, cl, opver.Get().Jaeger))
+
+	// verify
+	persisted := &v1.Jaeger{}
+	assert.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	assert.Equal(t, "1.11.0", persisted.Status.Version)
+}
+
 func TestErrorForInvalidSemVer(t *testing.T) {
 	invalidVersion := "xxx...xx"
 	testUpdates := map[string]upgradeFunction{}
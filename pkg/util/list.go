@@ -0,0 +1,44 @@
+package util
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+// InstanceSelector returns the label selector restricting the Jaeger instances reconciled by this operator
+func InstanceSelector() (labels.Selector, error) {
+	return labels.Parse(viper.GetString(v1.ConfigInstanceSelector))
+}
+
+// ListJaegers returns the Jaeger instances matching the instance selector from all the namespaces the operator is watching
+func ListJaegers(ctx context.Context, reader client.Reader) (*v1.JaegerList, error) {
+	selector, err := InstanceSelector()
+	if err != nil {
+		return nil, err
+	}
+	opts := []client.ListOption{client.MatchingLabelsSelector{Selector: selector}}
+
+	jaegers := &v1.JaegerList{}
+	if viper.GetString(v1.ConfigOperatorScope) != v1.OperatorScopeNamespace {
+		if err := reader.List(ctx, jaegers, opts...); err != nil {
+			return nil, err
+		}
+		return jaegers, nil
+	}
+
+	// the watch namespace might be a comma-separated list of namespaces
+	for _, ns := range strings.Split(viper.GetString(v1.ConfigWatchNamespace), ",") {
+		nsJaegers := &v1.JaegerList{}
+		if err := reader.List(ctx, nsJaegers, append(opts, client.InNamespace(ns))...); err != nil {
+			return nil, err
+		}
+		jaegers.Items = append(jaegers.Items, nsJaegers.Items...)
+	}
+	return jaegers, nil
+}
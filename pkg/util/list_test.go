@@ -0,0 +1,73 @@
+package util
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
+)
+
+func TestListJaegersInWatchedNamespaces(t *testing.T) {
+	// prepare
+	viper.Set(v1.ConfigOperatorScope, v1.OperatorScopeNamespace)
+	viper.Set(v1.ConfigWatchNamespace, "tenant1,tenant2")
+	defer viper.Reset()
+
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.SchemeGroupVersion, &v1.Jaeger{}, &v1.JaegerList{})
+
+	objs := []runtime.Object{
+		v1.NewJaeger(types.NamespacedName{Namespace: "tenant1", Name: "jaeger1"}),
+		v1.NewJaeger(types.NamespacedName{Namespace: "tenant2", Name: "jaeger2"}),
+		v1.NewJaeger(types.NamespacedName{Namespace: "tenant3", Name: "jaeger3"}),
+	}
+	cl := fake.NewFakeClientWithScheme(s, objs...)
+
+	// test
+	jaegers, err := ListJaegers(context.Background(), cl)
+
+	// verify
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"jaeger1", "jaeger2"}, names(jaegers))
+}
+
+func TestListJaegersMatchingInstanceSelector(t *testing.T) {
+	// prepare
+	viper.Set(v1.ConfigInstanceSelector, "team=payments")
+	defer viper.Reset()
+
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.SchemeGroupVersion, &v1.Jaeger{}, &v1.JaegerList{})
+
+	payments := v1.NewJaeger(types.NamespacedName{Namespace: "tenant1", Name: "jaeger1"})
+	payments.Labels = map[string]string{"team": "payments"}
+	objs := []runtime.Object{
+		payments,
+		v1.NewJaeger(types.NamespacedName{Namespace: "tenant1", Name: "jaeger2"}),
+	}
+	cl := fake.NewFakeClientWithScheme(s, objs...)
+
+	// test
+	jaegers, err := ListJaegers(context.Background(), cl)
+
+	// verify
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"jaeger1"}, names(jaegers))
+}
+
+func names(jaegers *v1.JaegerList) []string {
+	names := []string{}
+	for _, j := range jaegers.Items {
+		names = append(names, j.Name)
+	}
+	sort.Strings(names)
+	return names
+}
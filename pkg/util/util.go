@@ -4,11 +4,13 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/viper"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/jaegertracing/jaeger-operator/pkg/apis/jaegertracing/v1"
@@ -47,6 +49,21 @@ func RemoveDuplicatedVolumeMounts(volumeMounts []corev1.VolumeMount) []corev1.Vo
 	return results
 }
 
+// RemoveDuplicatedContainers returns a unique list of Containers based on the container names. Only the first item is kept.
+func RemoveDuplicatedContainers(containers []corev1.Container) []corev1.Container {
+	var results []corev1.Container
+	existing := map[string]bool{}
+
+	for _, container := range containers {
+		if existing[container.Name] {
+			continue
+		}
+		results = append(results, container)
+		existing[container.Name] = true
+	}
+	return results
+}
+
 // RemoveDuplicatedImagePullSecrets returns a unique list of ImagePullSecrets based on ImagePullSecrets names. Only the first item is kept.
 func RemoveDuplicatedImagePullSecrets(imagePullSecrets []corev1.LocalObjectReference) []corev1.LocalObjectReference {
 	var results []corev1.LocalObjectReference
@@ -72,8 +89,20 @@ func Merge(commonSpecs []v1.JaegerCommonSpec) *v1.JaegerCommonSpec {
 	resources := &corev1.ResourceRequirements{}
 	var affinity *corev1.Affinity
 	var tolerations []corev1.Toleration
+	var nodeSelector map[string]string
 	var securityContext *corev1.PodSecurityContext
 	var serviceAccount string
+	var logLevel string
+	var startupProbe *corev1.Probe
+	var capabilities *corev1.Capabilities
+	var allowPrivilegeEscalation *bool
+	var runtimeClassName *string
+	var sidecars []corev1.Container
+	var internalTracing *v1.JaegerInternalTracingSpec
+	var sidecarResources *v1.JaegerSidecarResourcesSpec
+	var env []corev1.EnvVar
+	var terminationMessagePolicy corev1.TerminationMessagePolicy
+	var imagePullPolicy corev1.PullPolicy
 
 	for _, commonSpec := range commonSpecs {
 		// Merge annotations
@@ -103,6 +132,16 @@ func Merge(commonSpecs []v1.JaegerCommonSpec) *v1.JaegerCommonSpec {
 
 		tolerations = append(tolerations, commonSpec.Tolerations...)
 
+		// Merge node selectors, the most specific value winning for each key
+		for k, v := range commonSpec.NodeSelector {
+			if nodeSelector == nil {
+				nodeSelector = map[string]string{}
+			}
+			if _, ok := nodeSelector[k]; !ok {
+				nodeSelector[k] = v
+			}
+		}
+
 		if securityContext == nil {
 			securityContext = commonSpec.SecurityContext
 		}
@@ -110,18 +149,133 @@ func Merge(commonSpecs []v1.JaegerCommonSpec) *v1.JaegerCommonSpec {
 		if serviceAccount == "" {
 			serviceAccount = commonSpec.ServiceAccount
 		}
+
+		if logLevel == "" {
+			logLevel = commonSpec.LogLevel
+		}
+
+		if startupProbe == nil {
+			startupProbe = commonSpec.StartupProbe
+		}
+
+		if capabilities == nil {
+			capabilities = commonSpec.Capabilities
+		}
+
+		if allowPrivilegeEscalation == nil {
+			allowPrivilegeEscalation = commonSpec.AllowPrivilegeEscalation
+		}
+
+		if runtimeClassName == nil {
+			runtimeClassName = commonSpec.RuntimeClassName
+		}
+
+		sidecars = append(sidecars, commonSpec.Sidecars...)
+
+		if internalTracing == nil {
+			internalTracing = commonSpec.InternalTracing
+		}
+
+		if sidecarResources == nil {
+			sidecarResources = commonSpec.SidecarResources
+		}
+
+		// Merge environment variables, the most specific value winning for each name
+		for _, e := range commonSpec.Env {
+			if !hasEnvVar(env, e.Name) {
+				env = append(env, e)
+			}
+		}
+
+		if terminationMessagePolicy == "" {
+			terminationMessagePolicy = commonSpec.TerminationMessagePolicy
+		}
+
+		if imagePullPolicy == "" {
+			imagePullPolicy = commonSpec.ImagePullPolicy
+		}
 	}
 
 	return &v1.JaegerCommonSpec{
-		Annotations:     annotations,
-		Labels:          labels,
-		VolumeMounts:    RemoveDuplicatedVolumeMounts(volumeMounts),
-		Volumes:         RemoveDuplicatedVolumes(volumes),
-		Resources:       *resources,
-		Affinity:        affinity,
-		Tolerations:     tolerations,
-		SecurityContext: securityContext,
-		ServiceAccount:  serviceAccount,
+		Annotations:              annotations,
+		Labels:                   labels,
+		VolumeMounts:             RemoveDuplicatedVolumeMounts(volumeMounts),
+		Volumes:                  RemoveDuplicatedVolumes(volumes),
+		Resources:                *resources,
+		Affinity:                 affinity,
+		Tolerations:              tolerations,
+		NodeSelector:             nodeSelector,
+		SecurityContext:          securityContext,
+		ServiceAccount:           serviceAccount,
+		LogLevel:                 logLevel,
+		StartupProbe:             startupProbe,
+		Capabilities:             capabilities,
+		AllowPrivilegeEscalation: allowPrivilegeEscalation,
+		RuntimeClassName:         runtimeClassName,
+		Sidecars:                 RemoveDuplicatedContainers(sidecars),
+		InternalTracing:          internalTracing,
+		SidecarResources:         sidecarResources,
+		Env:                      env,
+		TerminationMessagePolicy: terminationMessagePolicy,
+		ImagePullPolicy:          imagePullPolicy,
+	}
+}
+
+// TerminationMessagePolicy returns the termination message policy for the main container of a component, falling
+// back to the container's logs when it fails without writing a termination message, unless the common spec says otherwise
+func TerminationMessagePolicy(commonSpec v1.JaegerCommonSpec) corev1.TerminationMessagePolicy {
+	if commonSpec.TerminationMessagePolicy == "" {
+		return corev1.TerminationMessageFallbackToLogsOnError
+	}
+	return commonSpec.TerminationMessagePolicy
+}
+
+// ContainerSecurityContext returns the security context for the main container of a component, dropping all the
+// Linux capabilities and disallowing privilege escalation unless the common spec says otherwise
+func ContainerSecurityContext(commonSpec v1.JaegerCommonSpec) *corev1.SecurityContext {
+	capabilities := commonSpec.Capabilities
+	if capabilities == nil {
+		capabilities = &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}}
+	}
+	allowPrivilegeEscalation := commonSpec.AllowPrivilegeEscalation
+	if allowPrivilegeEscalation == nil {
+		falseVar := false
+		allowPrivilegeEscalation = &falseVar
+	}
+	return &corev1.SecurityContext{Capabilities: capabilities, AllowPrivilegeEscalation: allowPrivilegeEscalation}
+}
+
+// SeccompPodAnnotation is the annotation selecting the seccomp profile of a pod. Clusters supporting the pod's
+// seccompProfile field copy the annotation into it when the pod is created. The Kubernetes API in use has no
+// seccompProfile field yet, so the annotation is the only way to select the profile.
+const SeccompPodAnnotation = "seccomp.security.alpha.kubernetes.io/pod"
+
+// DefaultRunAsUser is the non-root user the Jaeger images are built to run as
+const DefaultRunAsUser = int64(10001)
+
+// PodSecurityDefaults changes the given common spec so that the component's pods comply with the "restricted" Pod
+// Security Standard: unless the common spec has its own security context, the pods must run as the non-root Jaeger
+// user, and unless the annotation has been set, they use the container runtime's default seccomp profile. On
+// OpenShift, the SCCs pick both the user and the seccomp profile, and the restricted one rejects pods asking for
+// a user outside of the namespace's range or for any profile, so only runAsNonRoot is set there.
+func PodSecurityDefaults(commonSpec *v1.JaegerCommonSpec) {
+	openshift := viper.GetString("platform") == v1.FlagPlatformOpenShift
+	if commonSpec.SecurityContext == nil {
+		trueVar := true
+		commonSpec.SecurityContext = &corev1.PodSecurityContext{RunAsNonRoot: &trueVar}
+		if !openshift {
+			user := DefaultRunAsUser
+			commonSpec.SecurityContext.RunAsUser = &user
+		}
+	}
+	if openshift {
+		return
+	}
+	if commonSpec.Annotations == nil {
+		commonSpec.Annotations = map[string]string{}
+	}
+	if _, ok := commonSpec.Annotations[SeccompPodAnnotation]; !ok {
+		commonSpec.Annotations[SeccompPodAnnotation] = "runtime/default"
 	}
 }
 
@@ -147,15 +301,56 @@ func MergeResources(resources *corev1.ResourceRequirements, res corev1.ResourceR
 	}
 }
 
-// AsOwner returns owner reference for jaeger
+// SidecarAgent and SidecarOAuthProxy identify the sidecars injected by the operator
+const (
+	SidecarAgent      = "agent"
+	SidecarOAuthProxy = "oauth-proxy"
+)
+
+// SidecarResources returns the resources for the given operator-injected sidecar. The sidecar resources for its type
+// come first, so that the sidecar can be sized independently of the standalone component, followed by the resources
+// from the sidecar's own spec, the default sidecar resources and the resources from the top-level spec. When none of
+// them is set, the sidecar gets small requests.
+func SidecarResources(sidecar string, own, general v1.JaegerCommonSpec) corev1.ResourceRequirements {
+	resources := &corev1.ResourceRequirements{}
+	sidecarResources := Merge([]v1.JaegerCommonSpec{own, general}).SidecarResources
+	if sidecarResources != nil {
+		switch sidecar {
+		case SidecarAgent:
+			MergeResources(resources, sidecarResources.Agent)
+		case SidecarOAuthProxy:
+			MergeResources(resources, sidecarResources.OAuthProxy)
+		}
+	}
+
+	MergeResources(resources, own.Resources)
+
+	if sidecarResources != nil {
+		MergeResources(resources, sidecarResources.Default)
+	}
+
+	MergeResources(resources, general.Resources)
+
+	if len(resources.Limits) == 0 && len(resources.Requests) == 0 {
+		resources.Requests = corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("10m"),
+			corev1.ResourceMemory: resource.MustParse("32Mi"),
+		}
+	}
+	return *resources
+}
+
+// AsOwner returns the controller owner reference for jaeger, to be set on all the objects the operator creates for it.
+// The owner's deletion is blocked until the garbage collector removes the object.
 func AsOwner(jaeger *v1.Jaeger) metav1.OwnerReference {
-	b := true
+	trueVar := true
 	return metav1.OwnerReference{
-		APIVersion: jaeger.APIVersion,
-		Kind:       jaeger.Kind,
-		Name:       jaeger.Name,
-		UID:        jaeger.UID,
-		Controller: &b,
+		APIVersion:         jaeger.APIVersion,
+		Kind:               jaeger.Kind,
+		Name:               jaeger.Name,
+		UID:                jaeger.UID,
+		Controller:         &trueVar,
+		BlockOwnerDeletion: &trueVar,
 	}
 }
 
@@ -255,8 +450,9 @@ func InitObjectMeta(obj metav1.Object) {
 // include a tag/digest, the Jaeger version will be appended.
 func ImageName(image, param string) string {
 	if image == "" {
-		param := viper.GetString(param)
-		if strings.IndexByte(param, ':') == -1 {
+		param := DefaultImage(param)
+		// a colon in the registry host's port isn't a tag
+		if strings.IndexByte(param[strings.LastIndexByte(param, '/')+1:], ':') == -1 {
 			image = fmt.Sprintf("%s:%s", param, version.Get().Jaeger)
 		} else {
 			image = param
@@ -265,6 +461,28 @@ func ImageName(image, param string) string {
 	return image
 }
 
+// DefaultImage returns the image configured for the operator under the given param, prefixed with the configured image
+// registry unless the image already names a registry
+func DefaultImage(param string) string {
+	image := viper.GetString(param)
+	registry := strings.TrimSuffix(viper.GetString(v1.ConfigImageRegistry), "/")
+	if len(registry) == 0 || len(image) == 0 || hasRegistry(image) {
+		return image
+	}
+	return fmt.Sprintf("%s/%s", registry, image)
+}
+
+// hasRegistry determines whether the given image reference starts with a registry host, following the same rules as
+// the container runtimes: the first path component has to contain a dot or a port, or be "localhost"
+func hasRegistry(image string) bool {
+	i := strings.IndexByte(image, '/')
+	if i == -1 {
+		return false
+	}
+	host := image[:i]
+	return strings.ContainsAny(host, ".:") || host == "localhost"
+}
+
 // RemoveEmptyVars removes empty variables from the input slice.
 func RemoveEmptyVars(envVars []corev1.EnvVar) []corev1.EnvVar {
 	var notEmpty []corev1.EnvVar
@@ -304,3 +522,121 @@ func GenerateProxySecret() (string, error) {
 	return base64Secret, nil
 
 }
+
+// SerializeTags returns the given tags in the key=value,key=value format used by the Jaeger tags flags, sorted
+// by key. The Jaeger components don't support escaping, so the tags must not contain commas nor equals signs,
+// as checked by ValidateTags.
+func SerializeTags(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, tags[k]))
+	}
+	return strings.Join(pairs, ",")
+}
+
+// ParseTags parses tags in the format produced by SerializeTags. Entries without a value are skipped.
+func ParseTags(serialized string) map[string]string {
+	tags := map[string]string{}
+	for _, pair := range strings.Split(serialized, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || len(kv[0]) == 0 {
+			continue
+		}
+		tags[kv[0]] = kv[1]
+	}
+	return tags
+}
+
+// ValidateTags returns an error when a tag can't be rendered in the format of the Jaeger tags flags, which split
+// the list on commas and each entry on the equals sign without supporting any escaping
+func ValidateTags(tags map[string]string) error {
+	for k, v := range tags {
+		if len(strings.TrimSpace(k)) == 0 {
+			return fmt.Errorf("the tag keys must not be empty")
+		}
+		if strings.ContainsAny(k, ",=") {
+			return fmt.Errorf("the tag key %q must not contain commas nor equals signs", k)
+		}
+		if strings.ContainsAny(v, ",=") {
+			return fmt.Errorf("the value of the tag %q must not contain commas nor equals signs", k)
+		}
+	}
+	return nil
+}
+
+// logLevels holds the log levels supported by the Jaeger components
+var logLevels = []string{"debug", "info", "warn", "error"}
+
+// ValidateLogLevel returns an error when the given log level isn't supported by the Jaeger components. An empty level is valid.
+func ValidateLogLevel(level string) error {
+	if level == "" {
+		return nil
+	}
+	for _, l := range logLevels {
+		if level == l {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid log level %q, possible values: %s", level, strings.Join(logLevels, ", "))
+}
+
+// LogArgs returns the log level flag based on the given common spec, unless it has already been set explicitly in
+// the given arguments
+func LogArgs(commonSpec v1.JaegerCommonSpec, args []string) []string {
+	var logArgs []string
+	if len(commonSpec.LogLevel) > 0 && len(FindItem("--log-level=", args)) == 0 {
+		logArgs = append(logArgs, fmt.Sprintf("--log-level=%s", commonSpec.LogLevel))
+	}
+	return logArgs
+}
+
+// CommonEnvVars returns the environment variables from the given common spec, unless they have already been set in
+// the given environment variables
+func CommonEnvVars(commonSpec v1.JaegerCommonSpec, env []corev1.EnvVar) []corev1.EnvVar {
+	var envVars []corev1.EnvVar
+	for _, e := range commonSpec.Env {
+		if !hasEnvVar(env, e.Name) {
+			envVars = append(envVars, e)
+		}
+	}
+	return envVars
+}
+
+func hasEnvVar(env []corev1.EnvVar, name string) bool {
+	for _, e := range env {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// InternalTracingEnvVars returns the environment variables configuring the tracer of a Jaeger component, so that it
+// reports the spans about itself to the endpoint from the given common spec
+func InternalTracingEnvVars(commonSpec v1.JaegerCommonSpec) []corev1.EnvVar {
+	tracing := commonSpec.InternalTracing
+	if tracing == nil {
+		return nil
+	}
+
+	samplerType := tracing.SamplerType
+	samplerParam := tracing.SamplerParam
+	if samplerType == "" {
+		samplerType = "probabilistic"
+		if samplerParam == "" {
+			samplerParam = "0.001"
+		}
+	}
+
+	return RemoveEmptyVars([]corev1.EnvVar{
+		{Name: "JAEGER_ENDPOINT", Value: tracing.Endpoint},
+		{Name: "JAEGER_SAMPLER_TYPE", Value: samplerType},
+		{Name: "JAEGER_SAMPLER_PARAM", Value: samplerParam},
+	})
+}
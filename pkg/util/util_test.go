@@ -286,6 +286,80 @@ func TestMergeTolerations(t *testing.T) {
 	assert.Equal(t, "toleration1", merged.Tolerations[2].Key)
 }
 
+func TestMergeNodeSelector(t *testing.T) {
+	generalSpec := v1.JaegerCommonSpec{
+		NodeSelector: map[string]string{
+			"disktype": "ssd",
+			"zone":     "a",
+		},
+	}
+	specificSpec := v1.JaegerCommonSpec{
+		NodeSelector: map[string]string{
+			"zone": "b", // Override general value
+		},
+	}
+
+	merged := Merge([]v1.JaegerCommonSpec{specificSpec, generalSpec})
+
+	assert.Equal(t, map[string]string{"disktype": "ssd", "zone": "b"}, merged.NodeSelector)
+}
+
+func TestMergeNodeSelectorEmpty(t *testing.T) {
+	merged := Merge([]v1.JaegerCommonSpec{{}, {}})
+	assert.Nil(t, merged.NodeSelector)
+}
+
+func TestSidecarResourcesDefault(t *testing.T) {
+	resources := SidecarResources(SidecarAgent, v1.JaegerCommonSpec{}, v1.JaegerCommonSpec{})
+
+	assert.Empty(t, resources.Limits)
+	assert.Equal(t, resource.MustParse("10m"), resources.Requests[corev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("32Mi"), resources.Requests[corev1.ResourceMemory])
+}
+
+func TestSidecarResourcesPrecedence(t *testing.T) {
+	own := v1.JaegerCommonSpec{
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		},
+	}
+	general := v1.JaegerCommonSpec{
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("4"),
+				corev1.ResourceMemory:           resource.MustParse("4Gi"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+			},
+		},
+		SidecarResources: &v1.JaegerSidecarResourcesSpec{
+			Default: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("200m"),
+					corev1.ResourceMemory: resource.MustParse("128Mi"),
+				},
+			},
+			Agent: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+			},
+		},
+	}
+
+	agent := SidecarResources(SidecarAgent, own, general)
+	assert.Equal(t, resource.MustParse("1"), agent.Limits[corev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("64Mi"), agent.Limits[corev1.ResourceMemory])
+	assert.Equal(t, resource.MustParse("1Gi"), agent.Limits[corev1.ResourceEphemeralStorage])
+	assert.Empty(t, agent.Requests)
+
+	// the sidecar resources for the agent win over the resources of the agent's DaemonSet
+	general.SidecarResources.Agent.Limits[corev1.ResourceCPU] = resource.MustParse("100m")
+	agent = SidecarResources(SidecarAgent, own, general)
+	assert.Equal(t, resource.MustParse("100m"), agent.Limits[corev1.ResourceCPU])
+
+	proxy := SidecarResources(SidecarOAuthProxy, v1.JaegerCommonSpec{}, general)
+	assert.Equal(t, resource.MustParse("200m"), proxy.Limits[corev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("128Mi"), proxy.Limits[corev1.ResourceMemory])
+}
+
 func TestGetEsHostname(t *testing.T) {
 	tests := []struct {
 		underTest map[string]string
@@ -309,7 +383,7 @@ func TestAsOwner(t *testing.T) {
 	j.UID = "boom!"
 	ow := AsOwner(j)
 	trueVar := true
-	assert.Equal(t, metav1.OwnerReference{Name: "joe", Kind: "human", APIVersion: "homosapiens", UID: "boom!", Controller: &trueVar}, ow)
+	assert.Equal(t, metav1.OwnerReference{Name: "joe", Kind: "human", APIVersion: "homosapiens", UID: "boom!", Controller: &trueVar, BlockOwnerDeletion: &trueVar}, ow)
 }
 
 func TestLabels(t *testing.T) {
@@ -463,6 +537,49 @@ func TestImageNameParamWithDigest(t *testing.T) {
 	assert.Equal(t, "org/custom-image@sha256:2a7ef4373262fa5fa3b3eaac86015650f8f3eee65d6e2674df931657873e318e", ImageName("", "test-image"))
 }
 
+func TestImageNameParamRegistryWithPort(t *testing.T) {
+	viper.Set("test-image", "registry.example.com:5000/org/custom-image")
+	defer viper.Reset()
+
+	assert.Equal(t, "registry.example.com:5000/org/custom-image:"+version.Get().Jaeger, ImageName("", "test-image"))
+}
+
+func TestImageNameImageRegistry(t *testing.T) {
+	viper.Set(v1.ConfigImageRegistry, "registry.example.com:5000/mirror/")
+	viper.Set("test-image", "org/custom-image")
+	defer viper.Reset()
+
+	assert.Equal(t, "registry.example.com:5000/mirror/org/custom-image:"+version.Get().Jaeger, ImageName("", "test-image"))
+	assert.Equal(t, "org/actual-image:1.2.3", ImageName("org/actual-image:1.2.3", "test-image"))
+}
+
+func TestDefaultImageRegistry(t *testing.T) {
+	viper.Set(v1.ConfigImageRegistry, "mirror.local")
+	defer viper.Reset()
+
+	for _, tt := range []struct {
+		image    string
+		expected string
+	}{
+		{image: "jaegertracing/jaeger-agent", expected: "mirror.local/jaegertracing/jaeger-agent"},
+		{image: "openshift/oauth-proxy:latest", expected: "mirror.local/openshift/oauth-proxy:latest"},
+		{image: "busybox", expected: "mirror.local/busybox"},
+		{image: "quay.io/jaegertracing/jaeger-agent", expected: "quay.io/jaegertracing/jaeger-agent"},
+		{image: "localhost/jaeger-agent", expected: "localhost/jaeger-agent"},
+		{image: "registry:5000/jaeger-agent", expected: "registry:5000/jaeger-agent"},
+	} {
+		viper.Set("test-image", tt.image)
+		assert.Equal(t, tt.expected, DefaultImage("test-image"))
+	}
+}
+
+func TestDefaultImageNoRegistry(t *testing.T) {
+	viper.Set("test-image", "jaegertracing/jaeger-agent")
+	defer viper.Reset()
+
+	assert.Equal(t, "jaegertracing/jaeger-agent", DefaultImage("test-image"))
+}
+
 func TestImageNameParamDefaultNoTag(t *testing.T) {
 	viper.SetDefault("test-image", "org/default-image")
 	defer viper.Reset()
@@ -569,3 +686,224 @@ func TestReplaceArgument(t *testing.T) {
 	}
 
 }
+
+func TestSerializeTags(t *testing.T) {
+	tests := []struct {
+		tags     map[string]string
+		expected string
+	}{
+		{tags: nil, expected: ""},
+		{tags: map[string]string{"region": "us-east-1"}, expected: "region=us-east-1"},
+		{tags: map[string]string{"region": "us-east-1", "env": "prod"}, expected: "env=prod,region=us-east-1"},
+		{tags: map[string]string{"env": "${ENV:prod}"}, expected: "env=${ENV:prod}"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, SerializeTags(test.tags))
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	tests := []struct {
+		serialized string
+		expected   map[string]string
+	}{
+		{serialized: "", expected: map[string]string{}},
+		{serialized: "env=prod,region=us-east-1", expected: map[string]string{"env": "prod", "region": "us-east-1"}},
+		{serialized: "env=${ENV:prod},invalid", expected: map[string]string{"env": "${ENV:prod}"}},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, ParseTags(test.serialized))
+	}
+
+	tags := map[string]string{"region": "us-east-1", "path": `c:\tmp`, "env": "${ENV:prod}"}
+	assert.Equal(t, tags, ParseTags(SerializeTags(tags)))
+}
+
+func TestValidateTags(t *testing.T) {
+	assert.NoError(t, ValidateTags(nil))
+	assert.NoError(t, ValidateTags(map[string]string{"env": "${ENV:prod}", "region": "us-east-1"}))
+	assert.Error(t, ValidateTags(map[string]string{"zones": "a,b"}))
+	assert.Error(t, ValidateTags(map[string]string{"query": "a=b"}))
+	assert.Error(t, ValidateTags(map[string]string{"a,b": "value"}))
+	assert.Error(t, ValidateTags(map[string]string{"": "value"}))
+}
+
+func TestMergeLogSettings(t *testing.T) {
+	generalSpec := v1.JaegerCommonSpec{LogLevel: "info"}
+	specificSpec := v1.JaegerCommonSpec{LogLevel: "debug"}
+
+	merged := Merge([]v1.JaegerCommonSpec{specificSpec, generalSpec})
+
+	assert.Equal(t, "debug", merged.LogLevel)
+}
+
+func TestValidateLogLevel(t *testing.T) {
+	for _, level := range []string{"", "debug", "info", "warn", "error"} {
+		assert.NoError(t, ValidateLogLevel(level))
+	}
+	for _, level := range []string{"trace", "warning", "INFO", "fatal"} {
+		assert.Error(t, ValidateLogLevel(level))
+	}
+}
+
+func TestLogArgs(t *testing.T) {
+	assert.Empty(t, LogArgs(v1.JaegerCommonSpec{}, nil))
+
+	commonSpec := v1.JaegerCommonSpec{LogLevel: "debug"}
+	assert.Equal(t, []string{"--log-level=debug"}, LogArgs(commonSpec, nil))
+
+	// explicit options take precedence
+	assert.Empty(t, LogArgs(commonSpec, []string{"--log-level=error"}))
+}
+
+func TestMergeStartupProbe(t *testing.T) {
+	generalProbe := &corev1.Probe{FailureThreshold: 10}
+	specificProbe := &corev1.Probe{FailureThreshold: 30}
+
+	merged := Merge([]v1.JaegerCommonSpec{{}, {StartupProbe: generalProbe}})
+	assert.Equal(t, generalProbe, merged.StartupProbe)
+
+	merged = Merge([]v1.JaegerCommonSpec{{StartupProbe: specificProbe}, {StartupProbe: generalProbe}})
+	assert.Equal(t, specificProbe, merged.StartupProbe)
+}
+
+func TestMergeRuntimeClassName(t *testing.T) {
+	general := "runc"
+	specific := "gvisor"
+
+	merged := Merge([]v1.JaegerCommonSpec{{}, {RuntimeClassName: &general}})
+	assert.Equal(t, &general, merged.RuntimeClassName)
+
+	merged = Merge([]v1.JaegerCommonSpec{{RuntimeClassName: &specific}, {RuntimeClassName: &general}})
+	assert.Equal(t, &specific, merged.RuntimeClassName)
+}
+
+func TestMergeSidecars(t *testing.T) {
+	generalSpec := v1.JaegerCommonSpec{Sidecars: []corev1.Container{{Name: "log-shipper", Image: "general"}, {Name: "proxy"}}}
+	specificSpec := v1.JaegerCommonSpec{Sidecars: []corev1.Container{{Name: "log-shipper", Image: "specific"}}}
+
+	merged := Merge([]v1.JaegerCommonSpec{specificSpec, generalSpec})
+
+	assert.Equal(t, []corev1.Container{{Name: "log-shipper", Image: "specific"}, {Name: "proxy"}}, merged.Sidecars)
+}
+
+func TestMergeInternalTracing(t *testing.T) {
+	general := &v1.JaegerInternalTracingSpec{Endpoint: "http://general:14268/api/traces"}
+	specific := &v1.JaegerInternalTracingSpec{Endpoint: "http://specific:14268/api/traces"}
+
+	merged := Merge([]v1.JaegerCommonSpec{{}, {InternalTracing: general}})
+	assert.Equal(t, general, merged.InternalTracing)
+
+	merged = Merge([]v1.JaegerCommonSpec{{InternalTracing: specific}, {InternalTracing: general}})
+	assert.Equal(t, specific, merged.InternalTracing)
+}
+
+func TestMergeEnv(t *testing.T) {
+	generalSpec := v1.JaegerCommonSpec{Env: []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "http://general:3128"}, {Name: "NO_PROXY", Value: ".svc"}}}
+	specificSpec := v1.JaegerCommonSpec{Env: []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "http://specific:3128"}}}
+
+	merged := Merge([]v1.JaegerCommonSpec{specificSpec, generalSpec})
+
+	assert.Equal(t, []corev1.EnvVar{{Name: "HTTP_PROXY", Value: "http://specific:3128"}, {Name: "NO_PROXY", Value: ".svc"}}, merged.Env)
+}
+
+func TestCommonEnvVars(t *testing.T) {
+	assert.Empty(t, CommonEnvVars(v1.JaegerCommonSpec{}, nil))
+
+	commonSpec := v1.JaegerCommonSpec{Env: []corev1.EnvVar{{Name: "HTTPS_PROXY", Value: "http://proxy:3128"}, {Name: "SPAN_STORAGE_TYPE", Value: "memory"}}}
+	env := []corev1.EnvVar{{Name: "SPAN_STORAGE_TYPE", Value: "elasticsearch"}}
+
+	// the variables set by the operator take precedence
+	assert.Equal(t, []corev1.EnvVar{{Name: "HTTPS_PROXY", Value: "http://proxy:3128"}}, CommonEnvVars(commonSpec, env))
+}
+
+func TestInternalTracingEnvVars(t *testing.T) {
+	assert.Empty(t, InternalTracingEnvVars(v1.JaegerCommonSpec{}))
+
+	envs := InternalTracingEnvVars(v1.JaegerCommonSpec{InternalTracing: &v1.JaegerInternalTracingSpec{Endpoint: "http://meta:14268/api/traces"}})
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "JAEGER_ENDPOINT", Value: "http://meta:14268/api/traces"},
+		{Name: "JAEGER_SAMPLER_TYPE", Value: "probabilistic"},
+		{Name: "JAEGER_SAMPLER_PARAM", Value: "0.001"},
+	}, envs)
+
+	envs = InternalTracingEnvVars(v1.JaegerCommonSpec{InternalTracing: &v1.JaegerInternalTracingSpec{
+		Endpoint:     "http://meta:14268/api/traces",
+		SamplerType:  "const",
+		SamplerParam: "1",
+	}})
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "JAEGER_ENDPOINT", Value: "http://meta:14268/api/traces"},
+		{Name: "JAEGER_SAMPLER_TYPE", Value: "const"},
+		{Name: "JAEGER_SAMPLER_PARAM", Value: "1"},
+	}, envs)
+}
+
+func TestContainerSecurityContext(t *testing.T) {
+	falseVar := false
+	trueVar := true
+	assert.Equal(t, &corev1.SecurityContext{
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+		AllowPrivilegeEscalation: &falseVar,
+	}, ContainerSecurityContext(v1.JaegerCommonSpec{}))
+
+	capabilities := &corev1.Capabilities{Add: []corev1.Capability{"NET_ADMIN"}}
+	merged := Merge([]v1.JaegerCommonSpec{{}, {Capabilities: capabilities, AllowPrivilegeEscalation: &trueVar}})
+	assert.Equal(t, &corev1.SecurityContext{
+		Capabilities:             capabilities,
+		AllowPrivilegeEscalation: &trueVar,
+	}, ContainerSecurityContext(*merged))
+}
+
+func TestTerminationMessagePolicy(t *testing.T) {
+	assert.Equal(t, corev1.TerminationMessageFallbackToLogsOnError, TerminationMessagePolicy(v1.JaegerCommonSpec{}))
+
+	merged := Merge([]v1.JaegerCommonSpec{{}, {TerminationMessagePolicy: corev1.TerminationMessageReadFile}})
+	assert.Equal(t, corev1.TerminationMessageReadFile, TerminationMessagePolicy(*merged))
+
+	merged = Merge([]v1.JaegerCommonSpec{{TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError}, {TerminationMessagePolicy: corev1.TerminationMessageReadFile}})
+	assert.Equal(t, corev1.TerminationMessageFallbackToLogsOnError, TerminationMessagePolicy(*merged))
+}
+
+func TestMergeImagePullPolicy(t *testing.T) {
+	merged := Merge([]v1.JaegerCommonSpec{{}, {ImagePullPolicy: corev1.PullAlways}})
+	assert.Equal(t, corev1.PullAlways, merged.ImagePullPolicy)
+
+	merged = Merge([]v1.JaegerCommonSpec{{ImagePullPolicy: corev1.PullIfNotPresent}, {ImagePullPolicy: corev1.PullAlways}})
+	assert.Equal(t, corev1.PullIfNotPresent, merged.ImagePullPolicy)
+}
+
+func TestPodSecurityDefaults(t *testing.T) {
+	commonSpec := Merge([]v1.JaegerCommonSpec{{}})
+	PodSecurityDefaults(commonSpec)
+
+	assert.NotNil(t, commonSpec.SecurityContext)
+	assert.True(t, *commonSpec.SecurityContext.RunAsNonRoot)
+	assert.Equal(t, DefaultRunAsUser, *commonSpec.SecurityContext.RunAsUser)
+	assert.Equal(t, "runtime/default", commonSpec.Annotations[SeccompPodAnnotation])
+}
+
+func TestPodSecurityDefaultsOpenShift(t *testing.T) {
+	viper.Set("platform", v1.FlagPlatformOpenShift)
+	defer viper.Reset()
+
+	commonSpec := Merge([]v1.JaegerCommonSpec{{}})
+	PodSecurityDefaults(commonSpec)
+
+	assert.True(t, *commonSpec.SecurityContext.RunAsNonRoot)
+	assert.Nil(t, commonSpec.SecurityContext.RunAsUser)
+	assert.NotContains(t, commonSpec.Annotations, SeccompPodAnnotation)
+}
+
+func TestPodSecurityDefaultsOverride(t *testing.T) {
+	user := int64(0)
+	securityContext := &corev1.PodSecurityContext{RunAsUser: &user}
+	commonSpec := Merge([]v1.JaegerCommonSpec{{
+		SecurityContext: securityContext,
+		Annotations:     map[string]string{SeccompPodAnnotation: "unconfined"},
+	}})
+	PodSecurityDefaults(commonSpec)
+
+	assert.Equal(t, securityContext, commonSpec.SecurityContext)
+	assert.Equal(t, "unconfined", commonSpec.Annotations[SeccompPodAnnotation])
+}